@@ -0,0 +1,260 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Audit log: client requests (and their outcomes) recorded as JSON lines - one
+// apc.AuditEntry per line - in a separate log file (see nlog.Audit).
+// Which requests get recorded is determined by config.log.audit (apc.Audit* enum);
+// intra-cluster requests are never recorded.
+
+const maxXidLen = 64 // (see auditWriter.Write)
+
+type (
+	// wraps http.ResponseWriter to capture the status, the action (see readActionMsg),
+	// and the ID of the xaction started by the request (if any)
+	auditWriter struct {
+		http.ResponseWriter
+		action string
+		xid    string
+		status int
+		wrote  bool
+	}
+	auditFilter struct {
+		since  time.Time
+		user   string
+		op     string
+		bucket string
+	}
+)
+
+// interface guard
+var _ http.ResponseWriter = (*auditWriter)(nil)
+
+func (aw *auditWriter) WriteHeader(status int) {
+	if !aw.wrote {
+		aw.status, aw.wrote = status, true
+	}
+	aw.ResponseWriter.WriteHeader(status)
+}
+
+func (aw *auditWriter) Write(b []byte) (int, error) {
+	if !aw.wrote {
+		aw.wrote = true
+		// control-plane operations that start xactions respond with the xaction ID
+		if aw.action != "" && len(b) <= maxXidLen && xact.IsValidUUID(string(b)) {
+			aw.xid = string(b)
+		}
+	}
+	return aw.ResponseWriter.Write(b)
+}
+
+// (see http.ResponseController)
+func (aw *auditWriter) Unwrap() http.ResponseWriter { return aw.ResponseWriter }
+
+// (compare with htrun.readActionMsg)
+func auditAction(w http.ResponseWriter, action string) {
+	if aw, ok := w.(*auditWriter); ok {
+		aw.action = action
+	}
+}
+
+// handlers of client requests get wrapped at registration time (see regNetHandlers);
+// whether the request gets audited is decided at runtime
+func (h *htrun) audited(path string, hf func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	switch path {
+	case apc.Health, apc.ObjStream:
+		return hf
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		category := cmn.GCO.Get().Log.Audit
+		if category == "" || !auditable(category, r) || h.auditIntra(r.Header) {
+			hf(w, r)
+			return
+		}
+		aw := &auditWriter{ResponseWriter: w, status: http.StatusOK}
+		hf(aw, r)
+		h.audit(aw, r)
+	}
+}
+
+func auditable(category string, r *http.Request) bool {
+	if category == apc.AuditAll {
+		return true
+	}
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return false
+	}
+	return category == apc.AuditWrites || !strings.HasPrefix(r.URL.Path, apc.URLPathObjects.S)
+}
+
+// intra-cluster requests are not audited - verified against the current Smap,
+// so that clients can't simply set the header to stay out of the audit log
+func (h *htrun) auditIntra(hdr http.Header) bool {
+	if isT2TPut(hdr) {
+		return h.owner.smap.get().GetTarget(hdr.Get(apc.HdrT2TPutterID)) != nil
+	}
+	return hdr.Get(apc.HdrCallerID) != "" && h.isIntraCall(hdr, false /*from primary*/) == nil
+}
+
+func (h *htrun) audit(aw *auditWriter, r *http.Request) {
+	entry := apc.AuditEntry{
+		Time:   time.Now(),
+		Node:   h.si.Name(),
+		Method: r.Method,
+		Action: aw.action,
+		Path:   r.URL.Path,
+		Status: aw.status,
+		Xid:    aw.xid,
+	}
+	entry.ClientIP, _, _ = net.SplitHostPort(r.RemoteAddr)
+	if token, err := tok.ExtractToken(r.Header); err == nil {
+		entry.User = tok.UserID(token, &cmn.GCO.Get().Auth.OIDC)
+	}
+	entry.Bucket, entry.Object = auditBckObj(r)
+	nlog.Audit(cos.MustMarshal(&entry))
+}
+
+// bucket (cname) and object name from the URL path, e.g. /v1/objects/abc/def
+func auditBckObj(r *http.Request) (bname, oname string) {
+	var rest string
+	switch {
+	case strings.HasPrefix(r.URL.Path, apc.URLPathObjects.S+"/"):
+		rest = r.URL.Path[len(apc.URLPathObjects.S)+1:]
+	case strings.HasPrefix(r.URL.Path, apc.URLPathBuckets.S+"/"):
+		rest = r.URL.Path[len(apc.URLPathBuckets.S)+1:]
+	default:
+		return
+	}
+	name, oname, _ := strings.Cut(rest, "/")
+	if name == "" {
+		return
+	}
+	var (
+		query = r.URL.Query()
+		bck   = cmn.Bck{Name: name, Provider: query.Get(apc.QparamProvider)}
+	)
+	if p := apc.NormalizeProvider(bck.Provider); p != "" {
+		bck.Provider = p
+	}
+	bck.Ns = cmn.ParseNsUname(query.Get(apc.QparamNamespace))
+	bname = bck.Cname("")
+	return
+}
+
+//
+// GET /v1/daemon?what=audit
+//
+
+func (h *htrun) sendAuditLog(w http.ResponseWriter, r *http.Request, query url.Values) {
+	filter, err := newAuditFilter(query)
+	if err != nil {
+		h.writeErr(w, r, err)
+		return
+	}
+	nlog.Flush(nlog.ActFlush)
+
+	logdir := cmn.GCO.Get().LogDir
+	dentries, err := os.ReadDir(logdir)
+	if err != nil {
+		h.writeErr(w, r, err)
+		return
+	}
+	finfos := make([]os.FileInfo, 0, 4)
+	for _, dent := range dentries {
+		if !dent.Type().IsRegular() || !strings.Contains(dent.Name(), ".AUDIT.") {
+			continue
+		}
+		if finfo, err := dent.Info(); err == nil && !finfo.ModTime().Before(filter.since) {
+			finfos = append(finfos, finfo)
+		}
+	}
+	sort.Slice(finfos, func(i, j int) bool { return finfos[i].ModTime().Before(finfos[j].ModTime()) })
+
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	for _, finfo := range finfos {
+		if err := filter.copy(w, filepath.Join(logdir, finfo.Name())); err != nil {
+			nlog.Errorf("%s: failed to read audit log %s: %v", h, finfo.Name(), err)
+		}
+	}
+}
+
+func newAuditFilter(query url.Values) (*auditFilter, error) {
+	filter := &auditFilter{
+		user:   query.Get(apc.QparamAuditUser),
+		op:     query.Get(apc.QparamAuditOp),
+		bucket: query.Get(apc.QparamAuditBck),
+	}
+	if s := query.Get(apc.QparamAuditSince); s != "" {
+		since, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, cmn.NewErrFailedTo(nil, "parse", apc.QparamAuditSince+"="+s, err)
+		}
+		filter.since = since
+	}
+	return filter, nil
+}
+
+func (filter *auditFilter) match(entry *apc.AuditEntry) bool {
+	switch {
+	case entry.Time.Before(filter.since):
+		return false
+	case filter.user != "" && entry.User != filter.user:
+		return false
+	case filter.op != "" && entry.Op() != filter.op && entry.Method != filter.op:
+		return false
+	case filter.bucket != "" && entry.Bucket != filter.bucket:
+		return false
+	}
+	return true
+}
+
+// write matching lines
+func (filter *auditFilter) copy(w http.ResponseWriter, fqn string) error {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return err
+	}
+	defer cos.Close(fh)
+	scanner := bufio.NewScanner(fh)
+	for scanner.Scan() {
+		var (
+			entry apc.AuditEntry
+			line  = scanner.Bytes()
+		)
+		if jsoniter.Unmarshal(line, &entry) != nil {
+			continue // (e.g., truncated)
+		}
+		if !filter.match(&entry) {
+			continue
+		}
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+		if _, err := w.Write([]byte{'\n'}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,87 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit", func() {
+	newReq := func(method, path string) *http.Request {
+		return httptest.NewRequest(method, path, http.NoBody)
+	}
+
+	It("should select requests by category", func() {
+		var (
+			destroy = newReq(http.MethodDelete, "/v1/buckets/abc")
+			put     = newReq(http.MethodPut, "/v1/objects/abc/def")
+			get     = newReq(http.MethodGet, "/v1/objects/abc/def")
+			intra   = newReq(http.MethodDelete, "/v1/buckets/abc")
+		)
+		intra.Header.Set(apc.HdrCallerID, "t1")
+
+		Expect(auditable(apc.AuditControl, destroy)).To(BeTrue())
+		Expect(auditable(apc.AuditControl, put)).To(BeFalse())
+		Expect(auditable(apc.AuditWrites, put)).To(BeTrue())
+		Expect(auditable(apc.AuditWrites, get)).To(BeFalse())
+		Expect(auditable(apc.AuditAll, get)).To(BeTrue())
+		// (caller ID alone doesn't make it intra-cluster - see htrun.auditIntra)
+		Expect(auditable(apc.AuditAll, intra)).To(BeTrue())
+	})
+
+	It("should parse bucket and object", func() {
+		bname, oname := auditBckObj(newReq(http.MethodPut, "/v1/objects/abc/d/e/f?provider=s3"))
+		Expect(bname).To(Equal("s3://abc"))
+		Expect(oname).To(Equal("d/e/f"))
+
+		bname, oname = auditBckObj(newReq(http.MethodDelete, "/v1/buckets/abc"))
+		Expect(bname).To(Equal("ais://abc"))
+		Expect(oname).To(BeEmpty())
+
+		bname, _ = auditBckObj(newReq(http.MethodPut, "/v1/cluster"))
+		Expect(bname).To(BeEmpty())
+	})
+
+	It("should capture xaction ID", func() {
+		xid := cos.GenUUID()
+		aw := &auditWriter{ResponseWriter: httptest.NewRecorder(), status: http.StatusOK}
+		auditAction(aw, apc.ActCopyBck)
+		aw.Write([]byte(xid))
+		Expect(aw.xid).To(Equal(xid))
+
+		aw = &auditWriter{ResponseWriter: httptest.NewRecorder(), status: http.StatusOK}
+		aw.WriteHeader(http.StatusNotFound)
+		aw.Write([]byte(xid))
+		Expect(aw.status).To(Equal(http.StatusNotFound))
+		Expect(aw.xid).To(BeEmpty())
+	})
+
+	It("should filter entries", func() {
+		now := time.Now()
+		filter, err := newAuditFilter(url.Values{
+			apc.QparamAuditSince: []string{now.Add(-time.Hour).Format(time.RFC3339Nano)},
+			apc.QparamAuditOp:    []string{apc.ActDestroyBck},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		entry := &apc.AuditEntry{Time: now, Method: http.MethodDelete, Action: apc.ActDestroyBck}
+		Expect(filter.match(entry)).To(BeTrue())
+		entry.Time = now.Add(-2 * time.Hour)
+		Expect(filter.match(entry)).To(BeFalse())
+		entry.Time, entry.Action = now, apc.ActEvictRemoteBck
+		Expect(filter.match(entry)).To(BeFalse())
+
+		_, err = newAuditFilter(url.Values{apc.QparamAuditSince: []string{"yesterday"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
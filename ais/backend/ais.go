@@ -555,7 +555,7 @@ func (m *AISBackendProvider) GetObj(_ ctx, lom *cluster.LOM, owt cmn.OWT) (errCo
 	return extractErrCode(err, remAis.uuid)
 }
 
-func (m *AISBackendProvider) GetObjReader(_ ctx, lom *cluster.LOM) (res cluster.GetReaderResult) {
+func (m *AISBackendProvider) GetObjReader(_ ctx, lom *cluster.LOM, offset, length int64) (res cluster.GetReaderResult) {
 	var (
 		remAis    *remAis
 		op        *cmn.ObjectProps
@@ -576,7 +576,12 @@ func (m *AISBackendProvider) GetObjReader(_ ctx, lom *cluster.LOM) (res cluster.
 	res.ExpCksum = oa.Cksum
 	lom.SetCksum(nil)
 	// reader
-	res.R, res.Err = api.GetObjectReader(remAis.bp, remoteBck, lom.ObjName, nil /*api.GetArgs*/)
+	var args *api.GetArgs
+	if length > 0 {
+		args = &api.GetArgs{Header: cmn.MakeRangeHdr(offset, length)}
+		res.Size = length
+	}
+	res.R, res.Err = api.GetObjectReader(remAis.bp, remoteBck, lom.ObjName, args)
 	res.ErrCode, res.Err = extractErrCode(res.Err, remAis.uuid)
 	return
 }
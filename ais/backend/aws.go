@@ -345,7 +345,7 @@ func (*awsProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode
 //
 
 func (awsp *awsProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (int, error) {
-	res := awsp.GetObjReader(ctx, lom)
+	res := awsp.GetObjReader(ctx, lom, 0, 0)
 	if res.Err != nil {
 		return res.ErrCode, res.Err
 	}
@@ -357,19 +357,29 @@ func (awsp *awsProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.O
 	return 0, err
 }
 
-func (*awsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (res cluster.GetReaderResult) {
+func (*awsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM, offset, length int64) (res cluster.GetReaderResult) {
 	var (
 		obj      *s3.GetObjectOutput
 		cloudBck = lom.Bck().RemoteBck()
+		input    = s3.GetObjectInput{
+			Bucket: aws.String(cloudBck.Name),
+			Key:    aws.String(lom.ObjName),
+		}
 	)
 	svc, _, err := newClient(sessConf{bck: cloudBck}, "[get_object]")
 	if err != nil && superVerbose {
 		nlog.Warningln(err)
 	}
-	obj, err = svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
-		Bucket: aws.String(cloudBck.Name),
-		Key:    aws.String(lom.ObjName),
-	})
+	if length > 0 {
+		input.Range = aws.String(cmn.MakeRangeVal(offset, length))
+		if v, ok := lom.GetCustomKey(cmn.VersionObjMD); ok {
+			input.VersionId = aws.String(v)
+		}
+		if v, ok := lom.GetCustomKey(cmn.ETag); ok {
+			input.IfMatch = aws.String(v)
+		}
+	}
+	obj, err = svc.GetObjectWithContext(ctx, &input)
 	if err != nil {
 		res.ErrCode, res.Err = awsErrorToAISError(err, cloudBck)
 		return
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-storage-blob-go/azblob"
@@ -353,7 +354,7 @@ func (ap *azureProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn
 ////////////////
 
 func (ap *azureProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (int, error) {
-	res := ap.GetObjReader(ctx, lom)
+	res := ap.GetObjReader(ctx, lom, 0, 0)
 	if res.Err != nil {
 		return res.ErrCode, res.Err
 	}
@@ -369,15 +370,21 @@ func (ap *azureProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.O
 // GET OBJ READER //
 ////////////////////
 
-func (ap *azureProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (res cluster.GetReaderResult) {
+func (ap *azureProvider) GetObjReader(ctx context.Context, lom *cluster.LOM, offset, length int64) (res cluster.GetReaderResult) {
 	var (
 		h        = cmn.BackendHelpers.Azure
 		cloudBck = lom.Bck().RemoteBck()
 		cntURL   = ap.s.NewContainerURL(cloudBck.Name)
 		blobURL  = cntURL.NewBlobURL(lom.ObjName)
+		cond     azblob.BlobAccessConditions
 	)
+	if length > 0 {
+		if v, ok := lom.GetCustomKey(cmn.ETag); ok {
+			cond.ModifiedAccessConditions.IfMatch = azblob.ETag(strconv.Quote(v))
+		}
+	}
 	// Get checksum
-	respProps, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, defaultKeyOptions)
+	respProps, err := blobURL.GetProperties(ctx, cond, defaultKeyOptions)
 	if err != nil {
 		res.ErrCode, res.Err = azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return
@@ -388,8 +395,8 @@ func (ap *azureProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (re
 		res.ErrCode = respProps.StatusCode()
 		return
 	}
-	// 0, 0 = read range: the whole object
-	resp, err := blobURL.Download(ctx, 0, 0, azblob.BlobAccessConditions{}, false, defaultKeyOptions)
+	// (0, 0) read range: the whole object
+	resp, err := blobURL.Download(ctx, offset, length, cond, false, defaultKeyOptions)
 	if err != nil {
 		res.ErrCode, res.Err = azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return
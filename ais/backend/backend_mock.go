@@ -56,7 +56,7 @@ func (*mockBP) GetObj(_ ctx, lom *cluster.LOM, _ cmn.OWT) (int, error) {
 	return http.StatusNotFound, cmn.NewErrRemoteBckNotFound(lom.Bucket())
 }
 
-func (*mockBP) GetObjReader(context.Context, *cluster.LOM) (res cluster.GetReaderResult) {
+func (*mockBP) GetObjReader(context.Context, *cluster.LOM, int64, int64) (res cluster.GetReaderResult) {
 	return
 }
 
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 
 	"cloud.google.com/go/storage"
@@ -303,7 +304,7 @@ func (*gcpProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn.ObjA
 //
 
 func (gcpp *gcpProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (int, error) {
-	res := gcpp.GetObjReader(ctx, lom)
+	res := gcpp.GetObjReader(ctx, lom, 0, 0)
 	if res.Err != nil {
 		return res.ErrCode, res.Err
 	}
@@ -315,19 +316,30 @@ func (gcpp *gcpProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.O
 	return 0, err
 }
 
-func (*gcpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (res cluster.GetReaderResult) {
+func (*gcpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM, offset, length int64) (res cluster.GetReaderResult) {
 	var (
 		attrs    *storage.ObjectAttrs
 		rc       *storage.Reader
 		cloudBck = lom.Bck().RemoteBck()
 		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
 	)
+	if length > 0 {
+		if v, ok := lom.GetCustomKey(cmn.VersionObjMD); ok {
+			if gen, err := strconv.ParseInt(v, 10, 64); err == nil {
+				o = o.If(storage.Conditions{GenerationMatch: gen})
+			}
+		}
+	}
 	attrs, res.Err = o.Attrs(ctx)
 	if res.Err != nil {
 		res.ErrCode, res.Err = gcpErrorToAISError(res.Err, cloudBck)
 		return
 	}
-	rc, res.Err = o.NewReader(ctx)
+	if length > 0 {
+		rc, res.Err = o.NewRangeReader(ctx, offset, length)
+	} else {
+		rc, res.Err = o.NewReader(ctx)
+	}
 	if res.Err != nil {
 		return
 	}
@@ -341,6 +353,9 @@ func (*gcpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (res clu
 	}
 	res.ExpCksum = setCustomGs(lom, attrs)
 	res.Size = rc.Attrs.Size
+	if length > 0 {
+		res.Size = rc.Remain()
+	}
 	res.R = rc
 	return
 }
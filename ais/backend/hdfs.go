@@ -231,7 +231,7 @@ func (hp *hdfsProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errC
 //
 
 func (hp *hdfsProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (int, error) {
-	res := hp.GetObjReader(ctx, lom)
+	res := hp.GetObjReader(ctx, lom, 0, 0)
 	if res.Err != nil {
 		return res.ErrCode, res.Err
 	}
@@ -243,7 +243,7 @@ func (hp *hdfsProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OW
 	return 0, err
 }
 
-func (hp *hdfsProvider) GetObjReader(_ context.Context, lom *cluster.LOM) (res cluster.GetReaderResult) {
+func (hp *hdfsProvider) GetObjReader(_ context.Context, lom *cluster.LOM, offset, length int64) (res cluster.GetReaderResult) {
 	filePath := filepath.Join(lom.Bck().Props.Extra.HDFS.RefDirectory, lom.ObjName)
 	fr, err := hp.c.Open(filePath)
 	if err != nil {
@@ -251,6 +251,15 @@ func (hp *hdfsProvider) GetObjReader(_ context.Context, lom *cluster.LOM) (res c
 		return
 	}
 	lom.SetCustomKey(cmn.SourceObjMD, apc.HDFS)
+	if length > 0 {
+		res.Size = length
+		res.R = cos.NewReaderWithArgs(cos.ReaderArgs{
+			R:       io.NewSectionReader(fr, offset, length),
+			DeferCb: func() { fr.Close() },
+			Size:    length,
+		})
+		return
+	}
 	res.Size = fr.Stat().Size()
 	res.R = fr
 	return
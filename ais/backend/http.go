@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
@@ -144,7 +145,7 @@ func (hp *httpProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn.
 }
 
 func (hp *httpProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (int, error) {
-	res := hp.GetObjReader(ctx, lom)
+	res := hp.GetObjReader(ctx, lom, 0, 0)
 	if res.Err != nil {
 		return res.ErrCode, res.Err
 	}
@@ -160,7 +161,7 @@ func (hp *httpProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OW
 	return 0, nil
 }
 
-func (hp *httpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (res cluster.GetReaderResult) {
+func (hp *httpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM, offset, length int64) (res cluster.GetReaderResult) {
 	var (
 		resp *http.Response
 		h    = cmn.BackendHelpers.HTTP
@@ -174,12 +175,26 @@ func (hp *httpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (res
 		nlog.Infof("[HTTP CLOUD][GET] original_url: %q", origURL)
 	}
 
-	resp, res.Err = hp.client(origURL).Get(origURL) //nolint:bodyclose // is closed by the caller
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origURL, http.NoBody)
+	if err != nil {
+		res.Err, res.ErrCode = err, http.StatusInternalServerError
+		return
+	}
+	expCode := http.StatusOK
+	if length > 0 {
+		req.Header.Set(cos.HdrRange, cmn.MakeRangeVal(offset, length))
+		if v, ok := lom.GetCustomKey(cmn.ETag); ok {
+			req.Header.Set(cos.HdrIfMatch, strconv.Quote(v))
+		}
+		expCode = http.StatusPartialContent
+	}
+	resp, res.Err = hp.client(origURL).Do(req) //nolint:bodyclose // is closed by the caller
 	if res.Err != nil {
 		res.ErrCode = http.StatusInternalServerError
 		return
 	}
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode != expCode {
+		cos.Close(resp.Body)
 		res.ErrCode = resp.StatusCode
 		res.Err = fmt.Errorf("error occurred: %v", resp.StatusCode)
 		return
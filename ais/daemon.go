@@ -242,7 +242,7 @@ func newProxy(co *configOwner) *proxy {
 }
 
 func newTarget(co *configOwner) *target {
-	t := &target{backend: make(backends, 8)}
+	t := &target{backend: make(backends, 8), repl: cos.NewSemaphore(maxAsyncRepl)}
 	t.owner.bmd = newBMDOwnerTgt()
 	t.owner.etl = newEtlMDOwnerTgt()
 	t.owner.config = co
@@ -330,7 +330,8 @@ func (g *rungroup) runAll(mainRunner cos.Runner) error {
 ///////////////
 
 const (
-	daemonIDEnv = "AIS_DAEMON_ID"
+	daemonIDEnv      = "AIS_DAEMON_ID"
+	failureDomainEnv = "AIS_FAILURE_DOMAIN"
 )
 
 func envDaemonID(daemonType string) (daemonID string) {
@@ -344,6 +345,17 @@ func envDaemonID(daemonType string) (daemonID string) {
 	return
 }
 
+// failure domain: env takes precedence over local config
+func envFailureDomain(config *cmn.Config) string {
+	if domain := os.Getenv(failureDomainEnv); domain != "" {
+		if !cos.IsAlphaPlus(domain) {
+			cos.ExitLogf("invalid %s=%q: %s", failureDomainEnv, domain, cos.OnlyPlus)
+		}
+		return domain
+	}
+	return config.FailureDomain
+}
+
 func genDaemonID(daemonType string, config *cmn.Config) string {
 	if !config.TestingEnv() {
 		return cos.GenDaemonID()
@@ -21,6 +21,7 @@ type dpq struct {
 	uuid                string // xaction
 	skipVC              string // (skip loading existing object's metadata)
 	archpath, archmime  string // archive
+	archsample          string // ditto
 	isGFN               string // ditto
 	origURL             string // ht://url->
 	appendTy, appendHdl string // APPEND { apc.AppendOp, ... }
@@ -91,6 +92,10 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			if dpq.archmime, err = url.QueryUnescape(value); err != nil {
 				return
 			}
+		case apc.QparamArchSample:
+			if dpq.archsample, err = url.QueryUnescape(value); err != nil {
+				return
+			}
 		case apc.QparamIsGFNRequest:
 			dpq.isGFN = value
 		case apc.QparamOrigURL:
@@ -366,6 +366,9 @@ func (p *proxy) primaryStartup(loadedSmap *smapX, config *cmn.Config, ntargets i
 	if etlMD.Version > 0 {
 		_ = p.metasyncer.sync(revsPair{etlMD, aisMsg})
 	}
+	if sched := p.sched.get(); sched.Version > 0 {
+		_ = p.metasyncer.sync(revsPair{sched, aisMsg})
+	}
 
 	// 11. Clear regpool
 	p.reg.mu.Lock()
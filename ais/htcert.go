@@ -0,0 +1,258 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// TLS certificate hot reload: HTTPS servers obtain the (current) certificate and client CAs
+// via tls.Config.GetCertificate and GetConfigForClient, respectively. The files are periodically
+// checked for changes (and reloaded if changed); in addition, reload can be requested
+// explicitly via apc.ActReloadCert. Failure to reload is logged while the node keeps
+// using the previously loaded certificate.
+// The same applies to the node certificate used for intra-cluster mTLS (see htintra.go).
+
+const (
+	certsCheckIval = 10 * time.Second
+	certsWarnIval  = time.Hour
+	certExpiryWarn = 7 * 24 * time.Hour // start warning (and see stats.CertExpires)
+)
+
+type (
+	certFile struct {
+		mtime time.Time
+		fqn   string
+		size  int64
+	}
+	// certificate, key, and CA files along with the respective TLS settings
+	certSrc struct {
+		crt, key, ca string
+		serverName   string
+		clientAuth   tls.ClientAuthType
+	}
+	certLoader struct {
+		tconf    atomic.Pointer[tls.Config] // current: cert + client CAs
+		statsT   stats.Tracker
+		src      func(config *cmn.Config) *certSrc // public or intra-cluster (see intraCertSrc)
+		metric   string                            // stats.CertExpires or stats.IntraCertExpires
+		crt      certFile
+		key      certFile
+		ca       certFile
+		notAfter atomic.Int64 // (unix nano)
+		warned   time.Time
+		mu       sync.Mutex
+	}
+)
+
+func newCertLoader(config *cmn.Config, statsT stats.Tracker) (*certLoader, error) {
+	cl := &certLoader{statsT: statsT, src: pubCertSrc, metric: stats.CertExpires}
+	return cl, cl.init(config, "tls-certs")
+}
+
+func (cl *certLoader) init(config *cmn.Config, name string) error {
+	if err := cl.load(cl.src(config)); err != nil {
+		return err
+	}
+	hk.Reg(name+hk.NameSuffix, cl.housekeep, certsCheckIval)
+	return nil
+}
+
+func pubCertSrc(config *cmn.Config) *certSrc {
+	conf := &config.Net.HTTP
+	return &certSrc{
+		crt:        conf.Certificate,
+		key:        conf.CertKey,
+		ca:         conf.ClientCA,
+		serverName: conf.ServerNameTLS,
+		clientAuth: tls.ClientAuthType(conf.ClientAuthTLS),
+	}
+}
+
+// the config passed to http.Server
+func (cl *certLoader) serverConf() *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return &cl.tconf.Load().Certificates[0], nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return cl.tconf.Load(), nil
+		},
+	}
+}
+
+func (cl *certLoader) expires() time.Time { return time.Unix(0, cl.notAfter.Load()) }
+
+// (stats.NodeStatus)
+func certExpires() (expires int64) {
+	if g.certs != nil {
+		expires = g.certs.notAfter.Load()
+	}
+	return
+}
+
+// (apc.ActReloadCert)
+func reloadCert() error {
+	if g.certs == nil && g.intra == nil {
+		return errors.New("tls: cannot reload certificate - not using HTTPS")
+	}
+	var (
+		config = cmn.GCO.Get()
+		all    = []*certLoader{g.certs}
+	)
+	if g.intra != nil {
+		all = append(all, g.intra.certs)
+	}
+	for _, cl := range all {
+		if cl == nil {
+			continue
+		}
+		cl.mu.Lock()
+		err := cl.load(cl.src(config))
+		cl.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// (under lock when reloading)
+func (cl *certLoader) load(src *certSrc) error {
+	var (
+		crt, key, ca certFile
+		pool         *x509.CertPool
+	)
+	if err := crt.stat(src.crt); err != nil {
+		return err
+	}
+	if err := key.stat(src.key); err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(src.crt, src.key)
+	if err != nil {
+		return fmt.Errorf("tls: failed to load X509 key pair (%q, %q): %w", src.crt, src.key, err)
+	}
+	if cert.Leaf == nil {
+		if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return fmt.Errorf("tls: failed to parse %q: %w", src.crt, err)
+		}
+	}
+	if src.clientAuth > tls.RequestClientCert {
+		if err := ca.stat(src.ca); err != nil {
+			return err
+		}
+		if pool, err = loadCA(src.ca); err != nil {
+			return err
+		}
+	}
+	tconf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ServerName:   src.serverName,
+		ClientAuth:   src.clientAuth,
+		ClientCAs:    pool,
+	}
+	prev := cl.tconf.Swap(tconf)
+	cl.crt, cl.key, cl.ca = crt, key, ca
+	cl.notAfter.Store(cert.Leaf.NotAfter.UnixNano())
+	if prev != nil {
+		nlog.Infof("tls: reloaded certificate %q (expires %s)", src.crt, cos.FormatTime(cert.Leaf.NotAfter, ""))
+	}
+	cl.checkExpiry(time.Now())
+	return nil
+}
+
+func loadCA(fqn string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if ok := pool.AppendCertsFromPEM(caCert); !ok {
+		return nil, fmt.Errorf("tls: failed to append CA certs from PEM: %q", fqn)
+	}
+	return pool, nil
+}
+
+func (cl *certLoader) housekeep() time.Duration {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	src := cl.src(cmn.GCO.Get())
+	if cl.changed(src) {
+		if err := cl.load(src); err != nil {
+			nlog.Errorf("tls: failed to reload (keeping the current certificate that expires %s): %v",
+				cos.FormatTime(cl.expires(), ""), err)
+		}
+		return certsCheckIval
+	}
+	cl.checkExpiry(time.Now())
+	return certsCheckIval
+}
+
+// (under lock)
+func (cl *certLoader) changed(src *certSrc) bool {
+	if !cl.crt.same(src.crt) || !cl.key.same(src.key) {
+		return true
+	}
+	if src.clientAuth > tls.RequestClientCert {
+		return !cl.ca.same(src.ca)
+	}
+	return cl.ca.fqn != ""
+}
+
+func (cl *certLoader) checkExpiry(now time.Time) {
+	left := cl.expires().Sub(now)
+	cl.statsT.Add(cl.metric, int64(left/time.Second))
+	if left > certExpiryWarn || now.Sub(cl.warned) < certsWarnIval {
+		return
+	}
+	cl.warned = now
+	if left <= 0 {
+		nlog.Errorf("tls: certificate %q expired %v ago", cl.crt.fqn, -left)
+	} else {
+		nlog.Warningf("tls: certificate %q expires in %v", cl.crt.fqn, left.Truncate(time.Minute))
+	}
+}
+
+//////////////
+// certFile //
+//////////////
+
+func (f *certFile) stat(fqn string) error {
+	if fqn == "" {
+		return errors.New("tls: certificate (or key) file not specified")
+	}
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return err
+	}
+	f.fqn, f.mtime, f.size = fqn, finfo.ModTime(), finfo.Size()
+	return nil
+}
+
+// NOTE: comparing size and mtime of the file (with symlinks followed, as in k8s mounted secrets)
+func (f *certFile) same(fqn string) bool {
+	if f.fqn != fqn {
+		return false
+	}
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return true // (e.g., in the middle of being replaced - check next time)
+	}
+	return finfo.ModTime().Equal(f.mtime) && finfo.Size() == f.size
+}
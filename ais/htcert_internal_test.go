@@ -0,0 +1,97 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/stats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TLS certificate reload", func() {
+	var (
+		dir  string
+		conf *certSrc
+	)
+
+	writeCert := func(notAfter time.Time) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(notAfter.Unix()),
+			Subject:      pkix.Name{CommonName: "localhost"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		kder, err := x509.MarshalECPrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+		err = os.WriteFile(conf.crt, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)
+		Expect(err).NotTo(HaveOccurred())
+		err = os.WriteFile(conf.key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder}), 0o600)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "certs")
+		Expect(err).NotTo(HaveOccurred())
+		conf = &certSrc{
+			crt: filepath.Join(dir, "server.crt"),
+			key: filepath.Join(dir, "server.key"),
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should reload changed certificate and keep the current one upon failure", func() {
+		first := time.Now().Add(time.Hour).Truncate(time.Second)
+		writeCert(first)
+
+		cl := &certLoader{statsT: mock.NewStatsTracker(), src: pubCertSrc, metric: stats.CertExpires}
+		Expect(cl.load(conf)).NotTo(HaveOccurred())
+		Expect(cl.expires().Equal(first)).To(BeTrue())
+		Expect(cl.changed(conf)).To(BeFalse())
+
+		tconf := cl.serverConf()
+		cert, err := tconf.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Leaf.NotAfter.Equal(first)).To(BeTrue())
+
+		// renew
+		second := first.Add(30 * 24 * time.Hour)
+		time.Sleep(10 * time.Millisecond) // (mtime)
+		writeCert(second)
+		Expect(cl.changed(conf)).To(BeTrue())
+		Expect(cl.load(conf)).NotTo(HaveOccurred())
+		Expect(cl.expires().Equal(second)).To(BeTrue())
+		cert, err = tconf.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Leaf.NotAfter.Equal(second)).To(BeTrue())
+
+		// corrupt
+		Expect(os.WriteFile(conf.crt, []byte("garbage"), 0o600)).NotTo(HaveOccurred())
+		Expect(cl.changed(conf)).To(BeTrue())
+		Expect(cl.load(conf)).To(HaveOccurred())
+		cert, err = tconf.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Leaf.NotAfter.Equal(second)).To(BeTrue())
+	})
+})
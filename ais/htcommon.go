@@ -7,7 +7,6 @@ package ais
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
@@ -15,7 +14,6 @@ import (
 	"net"
 	"net/http"
 	"net/url"
-	"os"
 	rdebug "runtime/debug"
 	"strings"
 	"sync"
@@ -127,6 +125,7 @@ type (
 	archiveQuery struct {
 		filename string // pathname in archive
 		mime     string // https://developer.mozilla.org/en-US/docs/Web/HTTP/Basics_of_HTTP/MIME_types/Common_types
+		sample   string // WebDataset sample key (to GET all sample's files as TAR)
 	}
 
 	// callResult contains HTTP response.
@@ -214,6 +213,7 @@ type (
 		sync.Mutex
 		s             *http.Server
 		muxers        httpMuxers
+		verifyPeer    func(r *http.Request) error // intra-cluster mTLS only
 		sndRcvBufSize int
 	}
 
@@ -569,10 +569,13 @@ func (server *netServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Config, config *cmn.Config) (err error) {
 	var (
-		httpHandler = server.muxers
-		tag         = "HTTP"
+		httpHandler http.Handler = server.muxers
+		tag                      = "HTTP"
 		retried     bool
 	)
+	if server.verifyPeer != nil {
+		httpHandler = http.HandlerFunc(server.verified)
+	}
 	server.Lock()
 	server.s = &http.Server{
 		Addr:              addr,
@@ -583,15 +586,15 @@ func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Co
 	if timeout, isSet := cmn.ParseReadHeaderTimeout(); isSet { // optional env var
 		server.s.ReadHeaderTimeout = timeout
 	}
-	if server.sndRcvBufSize > 0 && !config.Net.HTTP.UseHTTPS {
+	if server.sndRcvBufSize > 0 && tlsConf == nil {
 		server.s.ConnState = server.connStateListener // setsockopt; see also cmn.NewTransport
 	}
 	server.s.TLSConfig = tlsConf
 	server.Unlock()
 retry:
-	if config.Net.HTTP.UseHTTPS {
+	if tlsConf != nil {
 		tag = "HTTPS"
-		err = server.s.ListenAndServeTLS(config.Net.HTTP.Certificate, config.Net.HTTP.CertKey)
+		err = server.s.ListenAndServeTLS("", "") // (certificates via tlsConf - see certLoader)
 	} else {
 		err = server.s.ListenAndServe()
 	}
@@ -608,23 +611,13 @@ retry:
 	return
 }
 
-func newTLS(conf *cmn.HTTPConf) (tlsConf *tls.Config, err error) {
-	var (
-		pool       *x509.CertPool
-		caCert     []byte
-		clientAuth = tls.ClientAuthType(conf.ClientAuthTLS)
-	)
-	if clientAuth > tls.RequestClientCert {
-		if caCert, err = os.ReadFile(conf.ClientCA); err != nil {
-			return
-		}
-		pool = x509.NewCertPool()
-		if ok := pool.AppendCertsFromPEM(caCert); !ok {
-			return nil, fmt.Errorf("tls: failed to append CA certs from PEM: %q", conf.ClientCA)
-		}
+// (intra-cluster mTLS)
+func (server *netServer) verified(w http.ResponseWriter, r *http.Request) {
+	if err := server.verifyPeer(r); err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
 	}
-	tlsConf = &tls.Config{ServerName: conf.ServerNameTLS, ClientAuth: clientAuth, ClientCAs: pool}
-	return
+	server.muxers.ServeHTTP(w, r)
 }
 
 func (server *netServer) connStateListener(c net.Conn, cs http.ConnState) {
@@ -895,3 +888,21 @@ func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt in
 	}
 	return
 }
+
+// whether EC-encoded objects can survive the loss of any one failure domain (see meta.HrwTargetList)
+func ecDomainLoss(ecConf *cmn.ECConf, smap *smapX) error {
+	domains := smap.Domains()
+	if domains == nil {
+		return nil
+	}
+	var (
+		required = ecConf.RequiredEncodeTargets()
+		maxn     = smap.MaxPerDomain(required)
+	)
+	if maxn <= ecConf.ParitySlices {
+		return nil
+	}
+	return cmn.NewErrSoft(fmt.Sprintf("EC configuration (d=%d, p=%d) cannot survive the loss of a failure domain: "+
+		"up to %d (out of %d) slices and replicas in a single domain, %s domains %v",
+		ecConf.DataSlices, ecConf.ParitySlices, maxn, required, smap, domains))
+}
@@ -0,0 +1,230 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Intra-cluster mTLS (config.net.intra_tls): intra-cluster control and data servers
+// require (and verify) client certificates signed by the configured CA, while all
+// intra-cluster clients - including transport streams - present the node's own certificate.
+// Node certificates identify nodes (not hosts): the subject's CN must be the node ID.
+//
+// Identity is enforced at the request level (see verifyPeer):
+// - when the caller identifies itself (apc.HdrCallerID), the latter must match the CN;
+// - otherwise (e.g., transport streams), the CN must be the ID of a node in the current Smap.
+//
+// The respective client-side tls.Config verifies the server's certificate chain against the same
+// CA, with no hostname verification - instead, when dialing an intra-cluster endpoint of a node in
+// the current Smap, the server certificate's CN must be the ID of that node. Connections to other (public)
+// endpoints (e.g., primary's public URL when joining) are verified as per config.net.http, same as
+// without intra-cluster mTLS; net.http.skip_verify never applies to the endpoints of Smap nodes.
+
+type (
+	intraTLS struct {
+		certs  *certLoader
+		statsT stats.Tracker
+		pub    *tls.Config // (to verify public endpoints and to present public certificate, if configured)
+		nodeID func(addr string) string
+	}
+	// counts encrypted bytes
+	intraConn struct {
+		*tls.Conn
+		statsT stats.Tracker
+	}
+)
+
+// nodeID: given TCP endpoint, returns ID of the node in the current Smap, or empty string
+func newIntraTLS(config *cmn.Config, statsT stats.Tracker, nodeID func(addr string) string) (*intraTLS, error) {
+	it := &intraTLS{statsT: statsT, pub: &tls.Config{}, nodeID: nodeID}
+	if config.Net.HTTP.UseHTTPS {
+		pub, err := cmn.NewTLS(config.Net.HTTP.ToTLS())
+		if err != nil {
+			return nil, err
+		}
+		it.pub = pub
+	}
+	it.certs = &certLoader{statsT: statsT, src: intraCertSrc, metric: stats.IntraCertExpires}
+	return it, it.certs.init(config, "intra-tls-certs")
+}
+
+func intraCertSrc(config *cmn.Config) *certSrc {
+	conf := &config.Net.IntraTLS
+	return &certSrc{crt: conf.Certificate, key: conf.CertKey, ca: conf.CA, clientAuth: tls.RequireAndVerifyClientCert}
+}
+
+// (intra-cluster control and data servers)
+func (it *intraTLS) serverConf() *tls.Config { return it.certs.serverConf() }
+
+// expectedID: ID of the node that's being dialed, if known
+func (it *intraTLS) clientConf(serverName, expectedID string) *tls.Config {
+	return &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true, //nolint:gosec // verifying the peer via VerifyConnection (below)
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			return it.verifyServer(cs, expectedID)
+		},
+		GetClientCertificate: it.clientCert,
+	}
+}
+
+func (it *intraTLS) verifyServer(cs tls.ConnectionState, expectedID string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("intra-tls: no server certificate")
+	}
+	leaf := cs.PeerCertificates[0]
+	opts := x509.VerifyOptions{Roots: it.certs.tconf.Load().ClientCAs, Intermediates: x509.NewCertPool()}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := leaf.Verify(opts)
+	cn := leaf.Subject.CommonName
+	switch {
+	case err == nil && expectedID != "":
+		if cn != expectedID {
+			return fmt.Errorf("intra-tls: %s presented certificate of %q", expectedID, cn)
+		}
+		return nil
+	case err == nil:
+		if cn == "" {
+			return errors.New("intra-tls: server certificate without node ID (CN)")
+		}
+		return nil
+	case expectedID != "":
+		return fmt.Errorf("intra-tls: failed to verify %s: %w", expectedID, err)
+	}
+	// not an intra-cluster endpoint: verify as per config.net.http
+	if it.pub.InsecureSkipVerify {
+		return nil
+	}
+	opts.Roots, opts.DNSName = it.pub.RootCAs, cs.ServerName
+	_, err = leaf.Verify(opts)
+	return err
+}
+
+func (it *intraTLS) clientCert(cri *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	cert := &it.certs.tconf.Load().Certificates[0]
+	if cri.SupportsCertificate(cert) == nil {
+		return cert, nil
+	}
+	for i := range it.pub.Certificates {
+		if cri.SupportsCertificate(&it.pub.Certificates[i]) == nil {
+			return &it.pub.Certificates[i], nil
+		}
+	}
+	return &tls.Certificate{}, nil // none
+}
+
+// wraps plain TCP dial to establish TLS connection (and time the handshake);
+// used as http.Transport.DialTLSContext and, via transport.UseIntraTLS, by transport streams
+func (it *intraTLS) wrap(dial cmn.DialFunc) cmn.DialFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			host = addr
+		}
+		var (
+			expectedID string
+			started    = mono.NanoTime()
+		)
+		if it.nodeID != nil {
+			expectedID = it.nodeID(addr)
+		}
+		tconn := tls.Client(conn, it.clientConf(host, expectedID))
+		if err := tconn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			it.statsT.IncErr(stats.ErrIntraTLSHandshakeCount)
+			return nil, fmt.Errorf("intra-tls: handshake with %s failed: %w", addr, err)
+		}
+		it.statsT.AddMany(
+			cos.NamedVal64{Name: stats.IntraTLSHandshakeCount, Value: 1},
+			cos.NamedVal64{Name: stats.IntraTLSHandshakeLatency, Value: mono.SinceNano(started)},
+		)
+		return &intraConn{Conn: tconn, statsT: it.statsT}, nil
+	}
+}
+
+func (it *intraTLS) client(cargs cmn.TransportArgs) *http.Client {
+	transport := cmn.NewTransport(cargs)
+	transport.DialTLSContext = it.wrap(transport.DialContext)
+	return &http.Client{Transport: transport, Timeout: cargs.Timeout}
+}
+
+// (request level; see netServer.ServeHTTP)
+func (h *htrun) verifyPeer(r *http.Request) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return errors.New("intra-tls: missing client certificate")
+	}
+	cn := r.TLS.PeerCertificates[0].Subject.CommonName
+	if callerID := r.Header.Get(apc.HdrCallerID); callerID != "" {
+		if callerID != cn {
+			return fmt.Errorf("intra-tls: caller %q presented certificate of %q", callerID, cn)
+		}
+		return nil
+	}
+	smap := h.owner.smap.get()
+	if smap.GetNode(cn) == nil {
+		return fmt.Errorf("intra-tls: %q (certificate CN) is not present in %s", cn, smap)
+	}
+	return nil
+}
+
+// returns ID of the node that serves intra-cluster control or data network at a given address
+// (not counting public endpoints that, unless configured separately, also serve intra-cluster)
+func (h *htrun) nodeByAddr(addr string) string {
+	smap := h.owner.smap.get()
+	if smap == nil {
+		return ""
+	}
+	for _, nm := range []meta.NodeMap{smap.Tmap, smap.Pmap} {
+		for _, si := range nm {
+			if addr == si.PubNet.TCPEndpoint() {
+				continue
+			}
+			if addr == si.ControlNet.TCPEndpoint() || addr == si.DataNet.TCPEndpoint() {
+				return si.ID()
+			}
+		}
+	}
+	return ""
+}
+
+///////////////
+// intraConn //
+///////////////
+
+func (c *intraConn) Read(b []byte) (n int, err error) {
+	n, err = c.Conn.Read(b)
+	if n > 0 {
+		c.statsT.Add(stats.IntraTLSRecvSize, int64(n))
+	}
+	return
+}
+
+func (c *intraConn) Write(b []byte) (n int, err error) {
+	n, err = c.Conn.Write(b)
+	if n > 0 {
+		c.statsT.Add(stats.IntraTLSSentSize, int64(n))
+	}
+	return
+}
@@ -0,0 +1,169 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/stats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Intra-cluster mTLS", func() {
+	var dir string
+
+	newCert := func(cn string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(time.Now().UnixNano()),
+			Subject:      pkix.Name{CommonName: cn},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		if parent == nil { // CA
+			tmpl.IsCA, tmpl.BasicConstraintsValid = true, true
+			tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+			parent, parentKey = tmpl, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+		Expect(err).NotTo(HaveOccurred())
+		cert, err := x509.ParseCertificate(der)
+		Expect(err).NotTo(HaveOccurred())
+		return cert, key
+	}
+
+	writePEM := func(fqn, typ string, der []byte) {
+		err := os.WriteFile(fqn, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// CA and node certificate (CN = node ID) => intraTLS
+	newIntra := func(tag, nodeID string) *intraTLS {
+		ca, caKey := newCert(tag+"-ca", nil, nil)
+		cert, key := newCert(nodeID, ca, caKey)
+		kder, err := x509.MarshalECPrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		config := &cmn.Config{}
+		config.Net.IntraTLS = cmn.IntraTLSConf{
+			Certificate: filepath.Join(dir, tag+".crt"),
+			CertKey:     filepath.Join(dir, tag+".key"),
+			CA:          filepath.Join(dir, tag+"-ca.crt"),
+			Enabled:     true,
+		}
+		writePEM(config.Net.IntraTLS.CA, "CERTIFICATE", ca.Raw)
+		writePEM(config.Net.IntraTLS.Certificate, "CERTIFICATE", cert.Raw)
+		writePEM(config.Net.IntraTLS.CertKey, "EC PRIVATE KEY", kder)
+
+		statsT := mock.NewStatsTracker()
+		it := &intraTLS{statsT: statsT, pub: &tls.Config{}}
+		it.certs = &certLoader{statsT: statsT, src: intraCertSrc, metric: stats.IntraCertExpires}
+		Expect(it.certs.load(intraCertSrc(config))).NotTo(HaveOccurred())
+		return it
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "intra-certs")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should establish mTLS between nodes signed by the same CA", func() {
+		var (
+			it     = newIntra("cluster", "t1")
+			server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+			}))
+		)
+		server.TLS = it.serverConf()
+		server.StartTLS()
+		defer server.Close()
+
+		resp, err := it.client(cmn.TransportArgs{Timeout: 5 * time.Second}).Get(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(Equal("t1"))
+
+		// a node with a certificate signed by another CA
+		other := newIntra("other", "t2")
+		_, err = other.client(cmn.TransportArgs{Timeout: 5 * time.Second}).Get(server.URL)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should verify ID of the node being dialed", func() {
+		var (
+			it     = newIntra("cluster", "t1")
+			server = httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		)
+		server.TLS = it.serverConf()
+		server.StartTLS()
+		defer server.Close()
+
+		client := newIntra("cluster2", "t2")
+		client.certs = it.certs // (same CA)
+		client.nodeID = func(string) string { return "t1" }
+		resp, err := client.client(cmn.TransportArgs{Timeout: 5 * time.Second}).Get(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		// expecting another node at this address
+		client.nodeID = func(string) string { return "t3" }
+		_, err = client.client(cmn.TransportArgs{Timeout: 5 * time.Second}).Get(server.URL)
+		Expect(err).To(HaveOccurred())
+
+		// public skip-verify never applies to intra-cluster endpoints
+		other := newIntra("other", "t4")
+		other.pub = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // (test)
+		other.nodeID = func(string) string { return "t1" }
+		_, err = other.client(cmn.TransportArgs{Timeout: 5 * time.Second}).Get(server.URL)
+		Expect(err).To(HaveOccurred())
+		other.nodeID = nil
+		resp, err = other.client(cmn.TransportArgs{Timeout: 5 * time.Second}).Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+	})
+
+	It("should match caller ID with the certificate", func() {
+		var (
+			h      = &htrun{}
+			cert   = newIntra("cluster", "t1").certs.tconf.Load().Certificates[0].Leaf
+			newReq = func(callerID string) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/v1/health", http.NoBody)
+				r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+				r.Header.Set(apc.HdrCallerID, callerID)
+				return r
+			}
+		)
+		Expect(h.verifyPeer(newReq("t1"))).NotTo(HaveOccurred())
+		Expect(h.verifyPeer(newReq("t2"))).To(HaveOccurred())
+		Expect(h.verifyPeer(httptest.NewRequest(http.MethodGet, "/v1/health", http.NoBody))).To(HaveOccurred())
+	})
+})
@@ -188,16 +188,17 @@ func (h *htrun) regNetHandlers(networkHandlers []networkHandler) {
 			path = cos.JoinWords(apc.Version, nh.r)
 		}
 		debug.Assert(nh.net != 0)
+		hf := h.audited(nh.r, nh.h)
 		if nh.net.isSet(accessNetPublic) {
-			handlePub(path, nh.h)
+			handlePub(path, hf)
 			reg = true
 		}
 		if config.HostNet.UseIntraControl && nh.net.isSet(accessNetIntraControl) {
-			handleControl(path, nh.h)
+			handleControl(path, hf)
 			reg = true
 		}
 		if config.HostNet.UseIntraData && nh.net.isSet(accessNetIntraData) {
-			handleData(path, nh.h)
+			handleData(path, hf)
 			reg = true
 		}
 		if reg {
@@ -206,14 +207,14 @@ func (h *htrun) regNetHandlers(networkHandlers []networkHandler) {
 		// none of the above
 		if !config.HostNet.UseIntraControl && !config.HostNet.UseIntraData {
 			// no intra-cluster networks: default to pub net
-			handlePub(path, nh.h)
+			handlePub(path, hf)
 		} else if config.HostNet.UseIntraControl && nh.net.isSet(accessNetIntraData) {
 			// (not configured) data defaults to (configured) control
-			handleControl(path, nh.h)
+			handleControl(path, hf)
 		} else {
 			debug.Assert(config.HostNet.UseIntraData && nh.net.isSet(accessNetIntraControl))
 			// (not configured) control defaults to (configured) data
-			handleData(path, nh.h)
+			handleData(path, hf)
 		}
 	}
 	// common Prometheus
@@ -225,6 +226,13 @@ func (h *htrun) regNetHandlers(networkHandlers []networkHandler) {
 }
 
 func (h *htrun) init(config *cmn.Config) {
+	if config.Net.IntraTLS.Enabled {
+		it, err := newIntraTLS(config, h.statsT, h.nodeByAddr)
+		if err != nil {
+			cos.ExitLog(err)
+		}
+		g.intra = it
+	}
 	initCtrlClient(config)
 	initDataClient(config)
 
@@ -241,11 +249,17 @@ func (h *htrun) init(config *cmn.Config) {
 	if config.HostNet.UseIntraControl {
 		muxers = newMuxers()
 		g.netServ.control = &netServer{muxers: muxers, sndRcvBufSize: 0}
+		if g.intra != nil {
+			g.netServ.control.verifyPeer = h.verifyPeer
+		}
 	}
 	g.netServ.data = g.netServ.control // if not configured, intra-data net is intra-control
 	if config.HostNet.UseIntraData {
 		muxers = newMuxers()
 		g.netServ.data = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf}
+		if g.intra != nil {
+			g.netServ.data.verifyPeer = h.verifyPeer
+		}
 	}
 
 	h.owner.smap = newSmapOwner(config)
@@ -267,7 +281,11 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		dataAddr meta.NetInfo
 		port     = strconv.Itoa(config.HostNet.Port)
 		proto    = config.Net.HTTP.Proto
+		iproto   = proto
 	)
+	if config.Net.IntraTLS.Enabled {
+		iproto = "https"
+	}
 	addrList, err := getLocalIPv4s(config)
 	if err != nil {
 		cos.ExitLogf("failed to get local IP addr list: %v", err)
@@ -301,7 +319,7 @@ func (h *htrun) initSnode(config *cmn.Config) {
 	ctrlAddr = pubAddr
 	if config.HostNet.UseIntraControl {
 		icport := strconv.Itoa(config.HostNet.PortIntraControl)
-		err = initNetInfo(&ctrlAddr, config, addrList, proto, config.HostNet.HostnameIntraControl, icport)
+		err = initNetInfo(&ctrlAddr, config, addrList, iproto, config.HostNet.HostnameIntraControl, icport)
 		if err != nil {
 			cos.ExitLogf("failed to get %s IPv4/hostname: %v", cmn.NetIntraControl, err)
 		}
@@ -314,7 +332,7 @@ func (h *htrun) initSnode(config *cmn.Config) {
 	dataAddr = pubAddr
 	if config.HostNet.UseIntraData {
 		idport := strconv.Itoa(config.HostNet.PortIntraData)
-		err = initNetInfo(&dataAddr, config, addrList, proto, config.HostNet.HostnameIntraData, idport)
+		err = initNetInfo(&dataAddr, config, addrList, iproto, config.HostNet.HostnameIntraData, idport)
 		if err != nil {
 			cos.ExitLogf("failed to get %s IPv4/hostname: %v", cmn.NetIntraData, err)
 		}
@@ -356,6 +374,10 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		PubNet:     pubAddr,
 		ControlNet: ctrlAddr,
 		DataNet:    dataAddr,
+		Domain:     envFailureDomain(config),
+	}
+	if h.si.Domain != "" {
+		nlog.Infoln("failure domain:", h.si.Domain)
 	}
 	if l := len(pubExtra); l > 0 {
 		h.si.PubExtra = make([]meta.NetInfo, l)
@@ -439,24 +461,30 @@ func (h *htrun) setDaemonConfigQuery(w http.ResponseWriter, r *http.Request) {
 
 func (h *htrun) run(config *cmn.Config) error {
 	var (
-		tlsConf *tls.Config
-		logger  = log.New(&nlogWriter{}, "net/http err: ", 0) // a wrapper to log http.Server errors
+		tlsConf      *tls.Config
+		intraTLSConf *tls.Config
+		logger       = log.New(&nlogWriter{}, "net/http err: ", 0) // a wrapper to log http.Server errors
 	)
 	if config.Net.HTTP.UseHTTPS {
-		c, err := newTLS(&config.Net.HTTP)
+		cl, err := newCertLoader(config, h.statsT)
 		if err != nil {
 			cos.ExitLog(err)
 		}
-		tlsConf = c
+		g.certs = cl
+		tlsConf = cl.serverConf()
+	}
+	intraTLSConf = tlsConf
+	if g.intra != nil {
+		intraTLSConf = g.intra.serverConf() // (separately from the public network)
 	}
 	if config.HostNet.UseIntraControl {
 		go func() {
-			_ = g.netServ.control.listen(h.si.ControlNet.TCPEndpoint(), logger, tlsConf, config)
+			_ = g.netServ.control.listen(h.si.ControlNet.TCPEndpoint(), logger, intraTLSConf, config)
 		}()
 	}
 	if config.HostNet.UseIntraData {
 		go func() {
-			_ = g.netServ.data.listen(h.si.DataNet.TCPEndpoint(), logger, tlsConf, config)
+			_ = g.netServ.data.listen(h.si.DataNet.TCPEndpoint(), logger, intraTLSConf, config)
 		}()
 	}
 
@@ -1016,6 +1044,9 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 			h.sendOneLog(w, r, query)
 		}
 		return
+	case apc.WhatAuditLog:
+		h.sendAuditLog(w, r, query)
+		return
 	case apc.WhatNodeStats:
 		statsNode := h.statsT.GetStats()
 		statsNode.Snode = h.si
@@ -2090,7 +2121,9 @@ func (h *htrun) newAmsg(actionMsg *apc.ActMsg, bmd *bucketMD, uuid ...string) *a
 // apc.ActMsg c-tor and reader
 func (*htrun) readActionMsg(w http.ResponseWriter, r *http.Request) (msg *apc.ActMsg, err error) {
 	msg = &apc.ActMsg{}
-	err = cmn.ReadJSON(w, r, msg)
+	if err = cmn.ReadJSON(w, r, msg); err == nil {
+		auditAction(w, msg.Action)
+	}
 	return
 }
 
@@ -22,6 +22,8 @@ type global struct {
 		control *http.Client // http client for intra-cluster comm
 		data    *http.Client // http client to execute target <=> target GET & PUT (object)
 	}
+	certs *certLoader // HTTPS only
+	intra *intraTLS   // intra-cluster mTLS only
 }
 
 var g global
@@ -63,9 +65,12 @@ func initCtrlClient(config *cmn.Config) {
 		WriteBufferSize: defaultControlWriteBufferSize,
 		ReadBufferSize:  defaultControlReadBufferSize,
 	}
-	if config.Net.HTTP.UseHTTPS {
+	switch {
+	case g.intra != nil:
+		g.client.control = g.intra.client(cargs)
+	case config.Net.HTTP.UseHTTPS:
 		g.client.control = cmn.NewIntraClientTLS(cargs, config)
-	} else {
+	default:
 		g.client.control = cmn.NewClient(cargs)
 	}
 }
@@ -84,9 +89,12 @@ func initDataClient(config *cmn.Config) {
 		WriteBufferSize: wbuf,
 		ReadBufferSize:  rbuf,
 	}
-	if config.Net.HTTP.UseHTTPS {
+	switch {
+	case g.intra != nil:
+		g.client.data = g.intra.client(cargs)
+	case config.Net.HTTP.UseHTTPS:
 		g.client.data = cmn.NewIntraClientTLS(cargs, config)
-	} else {
+	default:
 		g.client.data = cmn.NewClient(cargs)
 	}
 }
@@ -82,8 +82,9 @@ const (
 	revsConfTag  = "Conf"
 	revsTokenTag = "token"
 	revsEtlMDTag = "EtlMD"
+	revsSchedTag = "Sched"
 
-	revsMaxTags   = 6         // NOTE
+	revsMaxTags   = 7         // NOTE
 	revsActionTag = "-action" // prefix revs tag
 )
 
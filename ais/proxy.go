@@ -71,6 +71,7 @@ type (
 			mu  sync.RWMutex
 			in  atomic.Bool
 		}
+		sched             schedOwner
 		settingNewPrimary atomic.Bool // primary executing "set new primary" request (state)
 		readyToFastKalive atomic.Bool // primary can accept fast keepalives
 	}
@@ -174,6 +175,7 @@ func (p *proxy) Run() error {
 
 	p.owner.bmd.init() // initialize owner and load BMD
 	p.owner.etl.init() // initialize owner and load EtlMD
+	p.sched.init(config)
 
 	cluster.Pinit()
 
@@ -189,6 +191,7 @@ func (p *proxy) Run() error {
 	p.notifs.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	p.regSched()
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -207,6 +210,7 @@ func (p *proxy) Run() error {
 		{r: apc.Daemon, h: p.daemonHandler, net: accessNetPublicControl},
 		{r: apc.Cluster, h: p.clusterHandler, net: accessNetPublicControl},
 		{r: apc.Tokens, h: p.tokenHandler, net: accessNetPublic},
+		{r: apc.Schedules, h: p.schedHandler, net: accessNetPublicControl},
 
 		{r: apc.Metasync, h: p.metasyncHandler, net: accessNetIntraControl},
 		{r: apc.Health, h: p.healthHandler, net: accessNetPublicControl},
@@ -347,8 +351,9 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 		return
 	}
 	bckArgs.bck, bckArgs.query = apireq.bck, apireq.query
-	bck, err = bckArgs.initAndTry()
 	objName = apireq.items[1]
+	bckArgs.scope.name = objName
+	bck, err = bckArgs.initAndTry()
 
 	apiReqFree(apireq)
 	freeInitBckArgs(bckArgs) // caller does alloc
@@ -601,6 +606,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	}
 	bckArgs := bckInitArgs{p: p, w: w, r: r, msg: msg, perms: apc.AceObjLIST, bck: bck, dpq: dpq}
 	bckArgs.createAIS = false
+	bckArgs.scope = aclScope{name: lsmsg.Prefix, lso: true}
 
 	if lsmsg.IsFlagSet(apc.LsBckPresent) {
 		bckArgs.dontHeadRemote = true
@@ -639,10 +645,11 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 	if len(origURLBck) > 0 {
 		bckArgs.origURLBck = origURLBck[0]
 	}
+	objName := apireq.items[1]
+	bckArgs.scope.name = objName
 	bck, err := bckArgs.initAndTry()
 	freeInitBckArgs(bckArgs)
 
-	objName := apireq.items[1]
 	apiReqFree(apireq)
 	if err != nil {
 		return
@@ -700,6 +707,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		bckArgs.createAIS = false
 	}
 	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
+	bckArgs.scope.name = apireq.items[1]
 	bck, err := bckArgs.initAndTry()
 	freeInitBckArgs(bckArgs)
 	if err != nil {
@@ -862,6 +870,9 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 			p.writeErrf(w, r, fmtNotRemote, bck.Name)
 			return
 		}
+		if !p.multiObjAccess(w, r, bck, msg, apc.AceObjDELETE) {
+			return
+		}
 		if xid, err = p.listrange(r.Method, bck.Name, msg, apireq.query); err != nil {
 			p.writeErr(w, r, err)
 			return
@@ -910,6 +921,7 @@ func (p *proxy) metasyncHandler(w http.ResponseWriter, r *http.Request) {
 		newRMD, msgRMD, errRMD       = p.extractRMD(payload, caller)
 		newEtlMD, msgEtlMD, errEtlMD = p.extractEtlMD(payload, caller)
 		revokedTokens, errTokens     = p.extractRevokedTokenList(payload, caller)
+		newSched, errSched           = p.extractSched(payload, caller)
 	)
 	// 2. apply
 	if errConf == nil && newConf != nil {
@@ -930,12 +942,16 @@ func (p *proxy) metasyncHandler(w http.ResponseWriter, r *http.Request) {
 	if errTokens == nil && revokedTokens != nil {
 		_ = p.authn.updateRevokedList(revokedTokens)
 	}
+	if errSched == nil && newSched != nil {
+		errSched = p.receiveSched(newSched)
+	}
 	// 3. respond
-	if errConf == nil && errSmap == nil && errBMD == nil && errRMD == nil && errTokens == nil && errEtlMD == nil {
+	if errConf == nil && errSmap == nil && errBMD == nil && errRMD == nil && errTokens == nil && errEtlMD == nil &&
+		errSched == nil {
 		return
 	}
 	cii.fill(&p.htrun)
-	retErr := err.message(errConf, errSmap, errBMD, errRMD, errEtlMD, errTokens)
+	retErr := err.message(errConf, errSmap, errBMD, errRMD, errEtlMD, errTokens, errSched)
 	p.writeErr(w, r, retErr, http.StatusConflict)
 }
 
@@ -1080,6 +1096,12 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 			p.writeErr(w, r, err)
 			return
 		}
+		if err := p.checkMultiObjAccess(w, r, bckFrom, &archMsg.ListRange, "", apc.AceGET); err != nil {
+			return
+		}
+		if err := p.checkObjAccess(w, r, bckTo, archMsg.ArchName, apc.AcePUT); err != nil {
+			return
+		}
 		xid, err := p.createArchMultiObj(bckFrom, bckTo, msg)
 		if err == nil {
 			w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xid)))
@@ -1201,6 +1223,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 				return
 			}
+			if err := tcbmsg.Validate(false); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
 		}
 		bckTo, err = newBckFromQuname(query, true /*required*/)
 		if err != nil {
@@ -1226,6 +1252,17 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			nlog.Warningf("%s: dst %s doesn't exist and will be created with the src (%s) props", p, bckTo, bck)
 		}
 
+		// prefix-scoped ACLs (source and destination)
+		if err := p.accessObj(r.Header, bck, apc.AceGET, &aclScope{name: tcbmsg.Prefix, lso: true}); err != nil {
+			p.writeErr(w, r, err, aceErrToCode(err))
+			return
+		}
+		dstPrefix := tcbmsg.Prepend + tcbmsg.Prefix
+		if err := p.accessObj(r.Header, bckTo, apc.AcePUT, &aclScope{name: dstPrefix, lso: true}); err != nil {
+			p.writeErr(w, r, err, aceErrToCode(err))
+			return
+		}
+
 		// start x-tcb or x-tco
 		if v := query.Get(apc.QparamFltPresence); v != "" {
 			fltPresence, _ = strconv.Atoi(v)
@@ -1260,6 +1297,12 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if tcomsg.Samples != nil {
+			if err := tcomsg.TCBMsg.Validate(msg.Action == apc.ActETLObjects); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+		}
 		bckTo = meta.CloneBck(&tcomsg.ToBck)
 
 		if bck.Equal(bckTo, true, true) {
@@ -1286,6 +1329,12 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			}
 		}
 
+		if err := p.checkMultiObjAccess(w, r, bck, &tcomsg.ListRange, "", apc.AceGET); err != nil {
+			return
+		}
+		if err := p.checkMultiObjAccess(w, r, bckTo, &tcomsg.ListRange, tcomsg.Prepend, apc.AcePUT); err != nil {
+			return
+		}
 		nlog.Infof("multi-obj %s %s => %s", msg.Action, bck, bckTo)
 		if xid, err = p.tcobjs(bck, bckTo, msg, tcomsg.TCBMsg.CopyBckMsg.DryRun); err != nil {
 			p.writeErr(w, r, err)
@@ -1305,10 +1354,83 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, fmtNotRemote, bucket)
 			return
 		}
+		if !p.multiObjAccess(w, r, bck, msg, apc.AceGET) {
+			return
+		}
 		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActPinObjects, apc.ActUnpinObjects:
+		if bck.IsAIS() {
+			p.writeErrf(w, r, fmtNotRemote, bucket)
+			return
+		}
+		if !p.multiObjAccess(w, r, bck, msg, apc.AceGET) {
+			return
+		}
+		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActInventory:
+		invMsg := &cmn.InventoryMsg{}
+		if err := cos.MorphMarshal(msg.Value, invMsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		bckTo := meta.CloneBck(&invMsg.ToBck)
+		bckToArgs := bckInitArgs{p: p, w: w, r: r, bck: bckTo, msg: msg, perms: apc.AcePUT, query: query}
+		bckToArgs.createAIS = false
+		if bckTo, err = bckToArgs.initAndTry(); err != nil {
+			return
+		}
+		invMsg.ToBck = *bckTo.Bucket()
+		if err := invMsg.Validate(bck.Bucket()); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		msg.Value = invMsg
+		nlog.Infof("%s: %s => %s", msg.Action, bck, bckTo.Cname(invMsg.Dir))
+		if xid, err = p.inventory(bck, msg); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActSyncBck:
+		var (
+			bckTo   *meta.Bck
+			syncMsg = &apc.SyncBckMsg{}
+			errCode int
+		)
+		if err := cos.MorphMarshal(msg.Value, syncMsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		bckTo, err = newBckFromQuname(query, true /*required*/)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if bck.Equal(bckTo, false, true) {
+			p.writeErrf(w, r, "cannot %s bucket %q onto itself", msg.Action, bck)
+			return
+		}
+		bckTo, errCode, err = p.initBckTo(w, r, query, bckTo)
+		if err != nil {
+			return
+		}
+		if errCode == http.StatusNotFound {
+			// unlike copy-bucket, destination must exist
+			p.writeErr(w, r, cmn.NewErrBckNotFound(bckTo.Bucket()), errCode)
+			return
+		}
+		msg.Value = syncMsg
+		nlog.Infof("%s: %s => %s (delete: %t, dry-run: %t)", msg.Action, bck.Cname(syncMsg.Prefix), bckTo,
+			syncMsg.Delete, syncMsg.DryRun)
+		if xid, err = p.syncBck(bck, bckTo, msg); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	case apc.ActInvalListCache:
 		p.qm.c.invalidate(bck.Bucket())
 		return
@@ -1672,7 +1794,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
-	if msg.Action == apc.ActRenameObject {
+	if msg.Action == apc.ActRenameObject || msg.Action == apc.ActBlobDl {
 		apireq.after = 2
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
@@ -1687,7 +1809,10 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	}
 	switch msg.Action {
 	case apc.ActRenameObject:
-		if err := p.checkAccess(w, r, bck, apc.AceObjMOVE); err != nil {
+		if err := p.checkObjAccess(w, r, bck, apireq.items[1], apc.AceObjMOVE); err != nil {
+			return
+		}
+		if err := p.checkObjAccess(w, r, bck, msg.Name /*new name*/, apc.AceObjMOVE); err != nil {
 			return
 		}
 		if bck.IsRemote() {
@@ -1700,6 +1825,16 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		p.objMv(w, r, bck, apireq.items[1], msg)
 		return
+	case apc.ActBlobDl:
+		if err := p.checkObjAccess(w, r, bck, apireq.items[1], apc.AceGET); err != nil {
+			return
+		}
+		if !bck.IsRemote() {
+			p.writeErrActf(w, r, msg.Action, "expecting remote bucket, got %s", bck)
+			return
+		}
+		p.blobdl(w, r, bck, apireq.items[1], msg)
+		return
 	case apc.ActPromote:
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			return
@@ -2265,6 +2400,35 @@ func (p *proxy) objMv(w http.ResponseWriter, r *http.Request, bck *meta.Bck, obj
 	p.statsT.Inc(stats.RenameCount)
 }
 
+// redirect to the target that "owns" the object (compare with objMv above)
+func (p *proxy) blobdl(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, msg *apc.ActMsg) {
+	started := time.Now()
+	if !p.isValidObjname(w, r, objName) {
+		return
+	}
+	smap := p.owner.smap.get()
+	si, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cmn.FastV(5, cos.SmoduleAIS) {
+		nlog.Infof("%q %s => %s", msg.Action, bck.Cname(objName), si.StringEx())
+	}
+	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
+// (list-range message in the action's value)
+func (p *proxy) multiObjAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg, ace apc.AccessAttrs) bool {
+	lrMsg := &apc.ListRange{}
+	if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return false
+	}
+	return p.checkMultiObjAccess(w, r, bck, lrMsg, "", ace) == nil
+}
+
 func (p *proxy) listrange(method, bucket string, msg *apc.ActMsg, query url.Values) (xid string, err error) {
 	var (
 		smap   = p.owner.smap.get()
@@ -2437,7 +2601,7 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			p.handlePendingRenamedLB(renamedBucket)
 		}
 		fallthrough // fallthrough
-	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog,
+	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog, apc.WhatAuditLog,
 		apc.WhatNodeStats, apc.WhatMetricNames:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 	case apc.WhatSysInfo:
@@ -2479,6 +2643,7 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			BuildTime:      daemon.buildTime,
 			K8sPodName:     os.Getenv(env.AIS.K8sPod),
 			Status:         p._status(smap),
+			CertExpires:    certExpires(),
 		}
 		daeStats := p.statsT.GetStats()
 		msg.Tracker = daeStats.Tracker
@@ -2518,6 +2683,10 @@ func (p *proxy) httpdaeput(w http.ResponseWriter, r *http.Request) {
 		}
 	case apc.ActRotateLogs:
 		nlog.Flush(nlog.ActRotate)
+	case apc.ActReloadCert:
+		if err := reloadCert(); err != nil {
+			p.writeErr(w, r, err)
+		}
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
 		p.statsT.ResetStats(errorsOnly)
@@ -2874,6 +3043,14 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 		if _, err = args.initAndTry(); err != nil {
 			return
 		}
+		if !parsc.EKMBck.IsEmpty() {
+			// order file (EKM) in a bucket
+			ekmBck := meta.CloneBck(&parsc.EKMBck)
+			args := bckInitArgs{p: p, w: w, r: r, bck: ekmBck, perms: apc.AceGET}
+			if _, err = args.initAndTry(); err != nil {
+				return
+			}
+		}
 		if !parsc.OutputBck.Equal(&parsc.InputBck) {
 			bckTo := meta.CloneBck(&parsc.OutputBck)
 			bckTo, errCode, err := p.initBckTo(w, r, nil /*query*/, bckTo)
@@ -2907,6 +3084,12 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 		dsort.PstartHandler(w, r, parsc)
+	case http.MethodPut:
+		if len(apiItems) == 1 && apiItems[0] == apc.Resume {
+			dsort.PresumeHandler(w, r)
+		} else {
+			p.writeErrURL(w, r)
+		}
 	case http.MethodGet:
 		dsort.PgetHandler(w, r)
 	case http.MethodDelete:
@@ -2918,7 +3101,7 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 			p.writeErrURL(w, r)
 		}
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
 	}
 }
 
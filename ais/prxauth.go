@@ -31,7 +31,9 @@ type (
 		// list of invalid tokens(revoked or of deleted users)
 		// Authn sends these tokens to primary for broadcasting
 		revokedTokens map[string]bool
-		version       int64
+		// OIDC issuers' keys
+		jwks    jwksCache
+		version int64
 	}
 )
 
@@ -40,7 +42,9 @@ type (
 /////////////////
 
 func newAuthManager() *authManager {
-	return &authManager{tkList: make(tkList), revokedTokens: make(map[string]bool), version: 1}
+	a := &authManager{tkList: make(tkList), revokedTokens: make(map[string]bool), version: 1}
+	a.jwks.init()
+	return a
 }
 
 // Add tokens to list of invalid ones. After that it cleans up the list
@@ -106,40 +110,47 @@ func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 //   - must not be expired
 //   - must have all mandatory fields: userID, creds, issued, expires
 //
-// Returns decrypted token information if it is valid
-func (a *authManager) validateToken(token string) (tk *tok.Token, err error) {
+// Returns decrypted token information if it is valid.
+// NOTE: decrypting OIDC token may entail fetching issuer's JWKS - done without holding the lock.
+func (a *authManager) validateToken(token, clusterID string) (*tok.Token, error) {
 	a.Lock()
 	if _, ok := a.revokedTokens[token]; ok {
-		tk, err = nil, fmt.Errorf("%v: %s", tok.ErrTokenRevoked, tk)
-	} else {
-		tk, err = a.validateAddRm(token, time.Now())
+		a.Unlock()
+		return nil, tok.ErrTokenRevoked
 	}
+	tk, ok := a.tkList[token]
 	a.Unlock()
-	return
-}
 
-// Decrypts and validates token. Adds it to authManager.token if not found. Removes if expired.
-// Must be called under lock.
-func (a *authManager) validateAddRm(token string, now time.Time) (*tok.Token, error) {
-	tk, ok := a.tkList[token]
 	if !ok || tk == nil {
-		var (
-			err    error
-			secret = cmn.GCO.Get().Auth.Secret
-		)
-		if tk, err = tok.DecryptToken(token, secret); err != nil {
+		var err error
+		if tk, err = decryptToken(token, clusterID, a.jwks.lookup); err != nil {
 			nlog.Errorln(err)
 			return nil, tok.ErrInvalidToken
 		}
-		a.tkList[token] = tk
 	}
-	if tk.Expires.Before(now) {
+
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.revokedTokens[token]; ok { // (revoked in the meantime)
+		return nil, fmt.Errorf("%v: %s", tok.ErrTokenRevoked, tk)
+	}
+	if tk.Expires.Before(time.Now()) {
 		delete(a.tkList, token)
 		return nil, fmt.Errorf("%v: %s", tok.ErrTokenExpired, tk)
 	}
+	a.tkList[token] = tk
 	return tk, nil
 }
 
+// OIDC (external identity provider) or AuthN
+func decryptToken(token, clusterID string, lookup tok.KeyLookup) (*tok.Token, error) {
+	conf := &cmn.GCO.Get().Auth
+	if len(conf.OIDC.Issuers) > 0 && tok.IsOIDC(token) {
+		return tok.DecryptOIDC(token, &conf.OIDC, clusterID, lookup)
+	}
+	return tok.DecryptToken(token, conf.Secret)
+}
+
 ///////////////
 // tokenList //
 ///////////////
@@ -211,7 +222,7 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 	if err != nil {
 		return nil, err
 	}
-	tk, err := p.authn.validateToken(token)
+	tk, err := p.authn.validateToken(token, p.owner.smap.get().UUID)
 	if err != nil {
 		nlog.Errorf("invalid token: %v", err)
 		return nil, err
@@ -236,6 +247,23 @@ func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	return
 }
 
+// same as above for a given named object
+func (p *proxy) checkObjAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, ace apc.AccessAttrs) (err error) {
+	if err = p.accessObj(r.Header, bck, ace, &aclScope{name: objName}); err != nil {
+		p.writeErr(w, r, err, aceErrToCode(err))
+	}
+	return
+}
+
+// same as above for multi-object operations (list, range, or prefix - see apc.ListRange)
+func (p *proxy) checkMultiObjAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lr *apc.ListRange,
+	prepend string, ace apc.AccessAttrs) (err error) {
+	if err = p.accessMultiObj(r.Header, bck, lr, prepend, ace); err != nil {
+		p.writeErr(w, r, err, aceErrToCode(err))
+	}
+	return
+}
+
 func aceErrToCode(err error) (status int) {
 	switch err {
 	case nil:
@@ -248,6 +276,54 @@ func aceErrToCode(err error) (status int) {
 }
 
 func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) error {
+	return p.accessObj(hdr, bck, ace, nil)
+}
+
+// object name or list-objects prefix to check against prefix-scoped bucket ACLs (see tok.CheckObjPermissions)
+type aclScope struct {
+	name string
+	lso  bool
+}
+
+// Multi-object operations are subject to prefix-scoped ACLs as well: each named object gets checked
+// individually, while a range or a prefix (including the entire bucket) is checked the same way as
+// listing the objects under the template's prefix - that is, inclusive of all narrower prefix-scoped
+// ACLs (see tok.CheckLsoPermissions). Destination objects' names (copy, transform) are `prepend`-ed.
+// NOTE: assuming bucket-level access has already been granted (e.g., by `bckInitArgs`).
+func (p *proxy) accessMultiObj(hdr http.Header, bck *meta.Bck, lr *apc.ListRange, prepend string, ace apc.AccessAttrs) error {
+	if !cmn.GCO.Get().Auth.Enabled || p.isIntraCall(hdr, false /*from primary*/) == nil {
+		return nil
+	}
+	tk, err := p.validateToken(hdr)
+	if err != nil {
+		if err == tok.ErrNoToken && bck.IsHTTP() {
+			err = nil
+		}
+		return err
+	}
+	uid := p.owner.smap.Get().UUID
+	if lr.IsList() {
+		for _, objName := range lr.ObjNames {
+			if err := tk.CheckObjPermissions(uid, bck.Bucket(), prepend+objName, ace); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tk.CheckLsoPermissions(uid, bck.Bucket(), prepend+lrPrefix(lr), ace)
+}
+
+// common prefix of all objects in a range (or prefix); empty template - entire bucket
+// (compare with xs.lriterator.rangeOrPref)
+func lrPrefix(lr *apc.ListRange) string {
+	pt, err := cos.NewParsedTemplate(lr.Template)
+	if err != nil {
+		return ""
+	}
+	return pt.Prefix
+}
+
+func (p *proxy) accessObj(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs, scope *aclScope) error {
 	var (
 		tk     *tok.Token
 		bucket *cmn.Bck
@@ -270,7 +346,15 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) erro
 		if bck != nil {
 			bucket = bck.Bucket()
 		}
-		if err := tk.CheckPermissions(uid, bucket, ace); err != nil {
+		switch {
+		case scope == nil || bck == nil:
+			err = tk.CheckPermissions(uid, bucket, ace)
+		case scope.lso:
+			err = tk.CheckLsoPermissions(uid, bucket, scope.name, ace)
+		default:
+			err = tk.CheckObjPermissions(uid, bucket, scope.name, ace)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -37,6 +37,7 @@ type bckInitArgs struct {
 
 	reqBody []byte          // request body of original request
 	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
+	scope   aclScope        // object name or list-objects prefix (prefix-scoped ACLs)
 
 	// 5 user or caller-provided control flags followed by
 	// 3 result flags
@@ -181,7 +182,11 @@ func (args *bckInitArgs) _requiresPermission(perm apc.AccessAttrs) bool {
 }
 
 func (args *bckInitArgs) access(bck *meta.Bck) (errCode int, err error) {
-	err = args.p.access(args.r.Header, bck, args.perms)
+	var scope *aclScope
+	if args.scope.name != "" || args.scope.lso {
+		scope = &args.scope
+	}
+	err = args.p.accessObj(args.r.Header, bck, args.perms, scope)
 	errCode = aceErrToCode(err)
 	return
 }
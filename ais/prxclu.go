@@ -683,6 +683,11 @@ func (p *proxy) kalive(nsi, osi *meta.Snode) bool {
 		nlog.Warningf("%s: renewing registration %s (info changed!)", p, nsi.StringEx())
 		return true // NOTE: update cluster map
 	}
+	if !osi.SamePlacement(nsi) {
+		nlog.Warningf("%s: renewing registration %s (failure domain %q => %q, weight %d => %d)", p, nsi.StringEx(),
+			osi.Domain, nsi.Domain, osi.Weight, nsi.Weight)
+		return true
+	}
 
 	p.keepalive.heardFrom(nsi.ID())
 	return false
@@ -692,7 +697,7 @@ func (p *proxy) rereg(nsi, osi *meta.Snode) bool {
 	if !p.NodeStarted() {
 		return true
 	}
-	if osi.Eq(nsi) {
+	if osi.Eq(nsi) && osi.SamePlacement(nsi) {
 		nlog.Infof("%s: %s is already *in*", p, nsi.StringEx())
 		return false
 	}
@@ -859,6 +864,9 @@ func (p *proxy) _joinedFinal(ctx *smapModifier, clone *smapX) {
 	if etlMD != nil && etlMD.version() > 0 {
 		pairs = append(pairs, revsPair{etlMD, aisMsg})
 	}
+	if sched := p.sched.get(); sched.version() > 0 {
+		pairs = append(pairs, revsPair{sched, aisMsg})
+	}
 
 	reb := ctx.rmdCtx != nil && ctx.rmdCtx.rebID != ""
 	if !reb {
@@ -966,6 +974,8 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 		p.resetCluCfgPersistent(w, r, msg)
 	case apc.ActRotateLogs:
 		p.rotateLogs(w, r, msg)
+	case apc.ActReloadCert:
+		p.reloadCert(w, r, msg)
 
 	case apc.ActShutdownCluster:
 		args := allocBcArgs()
@@ -1101,6 +1111,18 @@ func (p *proxy) rotateLogs(w http.ResponseWriter, r *http.Request, msg *apc.ActM
 	freeBcArgs(args)
 }
 
+func (p *proxy) reloadCert(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	if err := reloadCert(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	body := cos.MustMarshal(msg)
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: body}
+	p.bcastAllNodes(w, r, args)
+	freeBcArgs(args)
+}
+
 func (p *proxy) setCluCfgTransient(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToSet, msg *apc.ActMsg) {
 	if err := p.owner.config.setDaemonConfig(toUpdate, true /* transient */); err != nil {
 		p.writeErr(w, r, err)
@@ -2101,6 +2123,10 @@ func mustRebalance(ctx *smapModifier, cur *smapX) bool {
 		if !tsi.InMaintOrDecomm() && prev.GetActiveNode(tsi.ID()) == nil {
 			return true
 		}
+		// moved to a different failure domain or changed weight (see meta/hrw.go)
+		if osi := prev.GetActiveNode(tsi.ID()); osi != nil && !tsi.InMaintOrDecomm() && !osi.SamePlacement(tsi) {
+			return true
+		}
 	}
 	for _, tsi := range prev.Tmap {
 		// removed an active one or deactivated previously active
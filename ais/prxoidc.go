@@ -0,0 +1,181 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// OIDC issuers' JSON Web Key Sets: fetched on demand, cached, and refreshed
+// periodically (see cmn.OIDCIssuerConf) or upon encountering unknown key ID.
+// Fetching is done outside the cache lock, one fetch per issuer at a time (callers
+// that need the same issuer's keys wait for the fetch in progress).
+
+const (
+	jwksTimeout    = 10 * time.Second
+	jwksMinRefetch = 30 * time.Second // rate-limit refetching upon unknown key ID
+	jwksMaxSize    = cos.MiB
+)
+
+type (
+	jwksEntry struct {
+		keys    map[string]crypto.PublicKey
+		src     string // (to detect config change)
+		fetched time.Time
+	}
+	jwksFetch struct {
+		done chan struct{}
+		err  error
+	}
+	jwksCache struct {
+		client   *http.Client
+		entries  map[string]*jwksEntry // by issuer
+		inflight map[string]*jwksFetch // ditto
+		mu       sync.Mutex
+	}
+)
+
+func (c *jwksCache) init() {
+	c.entries = make(map[string]*jwksEntry, 2)
+	c.inflight = make(map[string]*jwksFetch, 2)
+	c.client = cmn.NewClient(cmn.TransportArgs{Timeout: jwksTimeout, UseHTTPProxyEnv: true})
+}
+
+// implements tok.KeyLookup
+func (c *jwksCache) lookup(iss, kid string) (crypto.PublicKey, error) {
+	ic := cmn.GCO.Get().Auth.OIDC.Issuer(iss)
+	if ic == nil {
+		return nil, fmt.Errorf("unknown issuer %q", iss)
+	}
+	now := time.Now()
+	entry, err := c.entry(ic, now, false)
+	if err != nil {
+		return nil, err
+	}
+	if key := entry.find(kid); key != nil {
+		return key, nil
+	}
+	// (key rotation)
+	if now.Sub(entry.fetched) > jwksMinRefetch {
+		if entry, err = c.entry(ic, now, true); err != nil {
+			return nil, err
+		}
+		if key := entry.find(kid); key != nil {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%v: issuer %q has no signing key %q", tok.ErrInvalidToken, iss, kid)
+}
+
+// return cached entry or (re)fetch it
+func (c *jwksCache) entry(ic *cmn.OIDCIssuerConf, now time.Time, refetch bool) (*jwksEntry, error) {
+	c.mu.Lock()
+	entry := c.entries[ic.Issuer]
+	stale := entry == nil || entry.src != ic.JWKS || now.Sub(entry.fetched) > ic.Refresh()
+	if !stale && (!refetch || now.Sub(entry.fetched) <= jwksMinRefetch) {
+		c.mu.Unlock()
+		return entry, nil
+	}
+	// single-flight
+	f, ok := c.inflight[ic.Issuer]
+	if !ok {
+		f = &jwksFetch{done: make(chan struct{})}
+		c.inflight[ic.Issuer] = f
+	}
+	c.mu.Unlock()
+
+	if ok {
+		<-f.done
+	} else {
+		f.err = c.fetch(ic, now)
+		c.mu.Lock()
+		delete(c.inflight, ic.Issuer)
+		c.mu.Unlock()
+		close(f.done)
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	c.mu.Lock()
+	entry = c.entries[ic.Issuer]
+	c.mu.Unlock()
+	return entry, nil
+}
+
+// (not holding the lock)
+func (c *jwksCache) fetch(ic *cmn.OIDCIssuerConf, now time.Time) error {
+	var (
+		b   []byte
+		err error
+	)
+	if cos.IsHTTP(ic.JWKS) || cos.IsHTTPS(ic.JWKS) {
+		b, err = c.get(ic.JWKS)
+	} else {
+		b, err = os.ReadFile(ic.JWKS)
+	}
+	if err == nil {
+		var keys map[string]crypto.PublicKey
+		if keys, err = tok.ParseJWKS(b); err == nil {
+			c.mu.Lock()
+			c.entries[ic.Issuer] = &jwksEntry{keys: keys, src: ic.JWKS, fetched: now}
+			c.mu.Unlock()
+			nlog.Infof("JWKS %q: loaded %d key(s) for issuer %q", ic.JWKS, len(keys), ic.Issuer)
+			return nil
+		}
+	}
+	err = fmt.Errorf("failed to load JWKS %q (issuer %q): %v", ic.JWKS, ic.Issuer, err)
+	nlog.Errorln(err)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry := c.entries[ic.Issuer]; entry != nil && entry.src == ic.JWKS {
+		// keep using the keys we have, retry later (replacing the entry - readers may hold the old one)
+		c.entries[ic.Issuer] = &jwksEntry{keys: entry.keys, src: entry.src, fetched: now.Add(jwksMinRefetch - ic.Refresh())}
+		return nil
+	}
+	return err
+}
+
+func (c *jwksCache) get(url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, jwksMaxSize))
+}
+
+func (e *jwksEntry) find(kid string) crypto.PublicKey {
+	if key, ok := e.keys[kid]; ok {
+		return key
+	}
+	if kid == "" && len(e.keys) == 1 {
+		for _, key := range e.keys {
+			return key
+		}
+	}
+	return nil
+}
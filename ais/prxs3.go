@@ -22,7 +22,9 @@ import (
 	jsoniter "github.com/json-iterator/go"
 )
 
-// TODO: `checkAccess` permissions (see ais/proxy.go)
+// Object-level handlers (including list-objects and multi-object delete) check bucket ACL and,
+// when AuthN is enabled, user permissions and prefix-scoped ACLs - see accessS3.
+// TODO: bucket-level `checkAccess` permissions (see ais/proxy.go)
 
 var (
 	errS3Req = errors.New("invalid s3 request")
@@ -79,6 +81,10 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.getBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamObjectLock) {
+				p.getBckObjLockS3(w, r, apiItems[0])
+				return
+			}
 			// only bucket name - list objects in the bucket
 			p.listObjectsS3(w, r, config, apiItems[0])
 			return
@@ -97,6 +103,10 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamObjectLock) {
+				p.putBckObjLockS3(w, r, apiItems[0])
+				return
+			}
 			p.putBckS3(w, r, apiItems[0])
 			return
 		}
@@ -204,12 +214,12 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	smap := p.owner.smap.get()
 	objName := s3.ObjName(parts)
+	if err := p.accessS3(r.Header, bck, apc.AcePUT, &aclScope{name: objName}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	si, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -228,8 +238,8 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.accessS3(r.Header, bck, apc.AceObjDELETE, nil); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	decoder := xml.NewDecoder(r.Body)
@@ -249,6 +259,10 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	for _, obj := range objList.Object {
 		lrMsg.ObjNames = append(lrMsg.ObjNames, obj.Key)
 	}
+	if err := p.accessMultiObj(r.Header, bck, lrMsg, "", apc.AceObjDELETE); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	msg.Value = lrMsg
 
 	// marshal+unmarshal to convince `p.listrange` to treat `listMsg` as `map[string]interface`
@@ -322,6 +336,10 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, config *cm
 
 	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsVersion)
 	s3.FillMsgFromS3Query(r.URL.Query(), lsmsg)
+	if err := p.accessS3(r.Header, bck, apc.AceObjLIST, &aclScope{name: lsmsg.Prefix, lso: true}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 
 	var (
 		lst        *cmn.LsoResult
@@ -376,8 +394,9 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Co
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bckSrc.Allow(apc.AceGET); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	objName := strings.Trim(parts[1], "/")
+	if err := p.accessS3(r.Header, bckSrc, apc.AceGET, &aclScope{name: objName}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	// dst
@@ -390,11 +409,14 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Co
 		si   *meta.Snode
 		smap = p.owner.smap.get()
 	)
-	if err = bckDst.Allow(apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	if err = p.accessS3(r.Header, bckDst, apc.AcePUT, &aclScope{name: s3.ObjName(items)}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
-	objName := strings.Trim(parts[1], "/")
 	si, err = smap.HrwName2T(bckSrc.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -422,15 +444,19 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, config *c
 		si     *meta.Snode
 		smap   = p.owner.smap.get()
 	)
-	if err = bck.Allow(apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
 	objName := s3.ObjName(items)
+	if err = p.accessS3(r.Header, bck, apc.AcePUT, &aclScope{name: objName}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	if err = p.allowBypassGovernance(r.Header, bck); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	si, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -457,11 +483,11 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Con
 		netPub string
 		smap   = p.owner.smap.get()
 	)
-	if err = bck.Allow(apc.AceGET); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	if listMultipart {
+		if err = p.accessS3(r.Header, bck, apc.AceGET, nil); err != nil {
+			s3.WriteErr(w, r, err, aceErrToCode(err))
+			return
+		}
 		p.listMultipart(w, r, bck, q)
 		return
 	}
@@ -470,6 +496,10 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Con
 		return
 	}
 	objName := s3.ObjName(items)
+	if err = p.accessS3(r.Header, bck, apc.AceGET, &aclScope{name: objName}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	si, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -542,8 +572,8 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Co
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AceObjHEAD); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.accessS3(r.Header, bck, apc.AceObjHEAD, &aclScope{name: objName}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	smap := p.owner.smap.get()
@@ -571,15 +601,19 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Con
 		si   *meta.Snode
 		smap = p.owner.smap.get()
 	)
-	if err = bck.Allow(apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
 	objName := s3.ObjName(items)
+	if err = p.accessS3(r.Header, bck, apc.AceObjDELETE, &aclScope{name: objName}); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	if err = p.allowBypassGovernance(r.Header, bck); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	si, err = smap.HrwName2T(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -608,6 +642,81 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
+// GET /s3/<bucket-name>?object-lock
+func (p *proxy) getBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if !bck.Props.ObjLock.Enabled {
+		err := cos.NewErrNotFound("%s: object lock configuration", bck)
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	resp := s3.NewObjectLockConfiguration(&bck.Props.ObjLock)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?object-lock
+func (p *proxy) putBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	lconf := &s3.ObjectLockConfiguration{}
+	if err := decoder.Decode(lconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	toSet, err := lconf.ToProps()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	propsToUpdate := cmn.BpropsToSet{ObjLock: toSet}
+	// make and validate new props
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+	}
+}
+
+// bucket ACL and, when AuthN is enabled, user's permissions including prefix-scoped ACLs
+// (unlike native API, read-only access is not implied when AuthN is disabled)
+func (p *proxy) accessS3(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs, scope *aclScope) error {
+	if err := bck.Allow(ace); err != nil {
+		return err
+	}
+	if !cmn.GCO.Get().Auth.Enabled {
+		return nil
+	}
+	return p.accessObj(hdr, bck, ace, scope)
+}
+
+// bypassing governance retention (via S3 header) requires admin permissions:
+// bucket ACL and, when AuthN is enabled, the user's (see accessS3)
+func (p *proxy) allowBypassGovernance(hdr http.Header, bck *meta.Bck) error {
+	if !s3.BypassGovernance(hdr) {
+		return nil
+	}
+	return p.accessS3(hdr, bck, apc.AceAdmin, nil)
+}
+
 // GET /s3/<bucket-name>?lifecycle|cors|policy|acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
@@ -0,0 +1,98 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestAllowBypassGovernance(tt *testing.T) {
+	const (
+		secret    = "bypass-governance-secret"
+		clusterID = "bypass-governance-cluster"
+	)
+	config := cmn.GCO.BeginUpdate()
+	auth := config.Auth
+	config.Auth.Enabled, config.Auth.Secret = true, secret
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth = auth
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	p := &proxy{authn: newAuthManager()}
+	p.owner.smap = newSmapOwner(cmn.GCO.Get())
+	p.owner.smap.put(&smapX{Smap: meta.Smap{Version: 1, UUID: clusterID}})
+
+	var (
+		bck     = meta.NewBck("locked", apc.AWS, cmn.NsGlobal, &cmn.Bprops{Access: apc.AccessAll})
+		tokBck  = cmn.Bck{Name: bck.Name, Provider: bck.Provider, Ns: cmn.Ns{UUID: clusterID}}
+		expires = time.Now().Add(time.Hour)
+		issue   = func(bckPerm, cluPerm apc.AccessAttrs) string {
+			var (
+				bckACLs = []*authn.BckACL{{Bck: tokBck, Access: bckPerm}}
+				cluACLs = []*authn.CluACL{{ID: clusterID, Access: cluPerm}}
+			)
+			token, err := tok.IssueJWT(expires, "user", bckACLs, cluACLs, secret)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			return token
+		}
+	)
+	admin, err := tok.IssueAdminJWT(expires, "admin", secret)
+	if err != nil {
+		tt.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		bypass bool
+		code   int // expected (0: allowed)
+	}{
+		{name: "no bypass, no token", bypass: false},
+		{name: "no token", bypass: true, code: http.StatusUnauthorized},
+		{name: "invalid token", token: "invalid", bypass: true, code: http.StatusUnauthorized},
+		{name: "read-write", token: issue(apc.AccessRW, apc.AccessRO), bypass: true, code: http.StatusForbidden},
+		{name: "full bucket access", token: issue(apc.AccessAll, apc.AccessRO), bypass: true, code: http.StatusForbidden},
+		{name: "admin permission", token: issue(apc.AccessRW, apc.AccessAll), bypass: true},
+		{name: "cluster admin", token: admin, bypass: true},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(tt *testing.T) {
+			hdr := http.Header{}
+			if test.token != "" {
+				hdr.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+test.token)
+			}
+			if test.bypass {
+				hdr.Set(cos.S3HdrBypassGovernanceRet, "true")
+			}
+			err := p.allowBypassGovernance(hdr, bck)
+			if code := aceErrToCode(err); code != test.code {
+				tt.Errorf("expected %d, got %d (err: %v)", test.code, code, err)
+			}
+		})
+	}
+
+	// bucket ACL applies as well
+	bck.Props.Access = apc.AccessRW
+	hdr := http.Header{}
+	hdr.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+admin)
+	hdr.Set(cos.S3HdrBypassGovernanceRet, "true")
+	if err := p.allowBypassGovernance(hdr, bck); err == nil {
+		tt.Error("expected bucket ACL to deny bypassing governance")
+	}
+}
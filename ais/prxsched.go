@@ -0,0 +1,387 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Scheduled jobs: the primary proxy periodically (every `schedInterval`) checks cron-like
+// schedules (see xact.Schedule) and starts those that are due. Schedules, including
+// the most recent run status, are replicated (metasync) across all proxies and persisted
+// under their respective config directories - targets ignore this metadata.
+
+const schedInterval = 20 * time.Second
+
+type (
+	schedMD struct {
+		xact.Schedules
+	}
+	schedOwner struct {
+		sched   ratomic.Pointer[schedMD]
+		running map[string]struct{} // names of the schedules that are currently being started
+		fpath   string
+		sync.Mutex
+	}
+)
+
+// interface guard
+var _ revs = (*schedMD)(nil)
+
+/////////////
+// schedMD //
+/////////////
+
+func newSchedMD() *schedMD {
+	return &schedMD{xact.Schedules{Jobs: make(map[string]*xact.Schedule, 4)}}
+}
+
+// as revs
+func (*schedMD) tag() string       { return revsSchedTag }
+func (s *schedMD) version() int64  { return s.Version }
+func (*schedMD) jit(p *proxy) revs { return p.sched.get() }
+func (*schedMD) sgl() *memsys.SGL  { return nil }
+
+func (s *schedMD) marshal() []byte {
+	sgl := memsys.PageMM().NewSGL(0)
+	err := jsp.Encode(sgl, s, jsp.CCSign(cmn.MetaverSched))
+	debug.AssertNoErr(err)
+	b := sgl.ReadAll()
+	sgl.Free()
+	return b
+}
+
+func (*schedMD) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverSched) }
+
+// shallow copy: modifiers must replace (not update in place) the affected schedules
+func (s *schedMD) clone() *schedMD {
+	dst := &schedMD{xact.Schedules{Jobs: make(map[string]*xact.Schedule, len(s.Jobs)+1), Version: s.Version}}
+	for name, job := range s.Jobs {
+		dst.Jobs[name] = job
+	}
+	return dst
+}
+
+////////////////
+// schedOwner //
+////////////////
+
+func (so *schedOwner) init(config *cmn.Config) {
+	so.fpath = filepath.Join(config.ConfigDir, fname.Sched)
+	so.running = make(map[string]struct{}, 4)
+	sched := newSchedMD()
+	if _, err := jsp.LoadMeta(so.fpath, sched); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorf("failed to load %s from %s, err: %v", sched, so.fpath, err)
+		}
+		sched = newSchedMD()
+	}
+	so.put(sched)
+}
+
+func (so *schedOwner) get() *schedMD      { return so.sched.Load() }
+func (so *schedOwner) put(sched *schedMD) { so.sched.Store(sched) }
+
+// under lock
+func (so *schedOwner) putPersist(sched *schedMD) error {
+	if err := jsp.SaveMeta(so.fpath, sched, nil); err != nil {
+		return err
+	}
+	so.put(sched)
+	return nil
+}
+
+func (so *schedOwner) modify(pre func(clone *schedMD) error) (*schedMD, error) {
+	so.Lock()
+	defer so.Unlock()
+	clone := so.get().clone()
+	if err := pre(clone); err != nil {
+		return nil, err
+	}
+	clone.Version++
+	return clone, so.putPersist(clone)
+}
+
+func (so *schedOwner) begin(name string) bool {
+	so.Lock()
+	_, ok := so.running[name]
+	if !ok {
+		so.running[name] = struct{}{}
+	}
+	so.Unlock()
+	return !ok
+}
+
+func (so *schedOwner) end(name string) {
+	so.Lock()
+	delete(so.running, name)
+	so.Unlock()
+}
+
+//
+// metasync Rx
+//
+
+func (p *proxy) extractSched(payload msPayload, caller string) (newSched *schedMD, err error) {
+	b, ok := payload[revsSchedTag]
+	if !ok {
+		return
+	}
+	newSched = newSchedMD()
+	if _, err1 := jsp.Decode(io.NopCloser(bytes.NewBuffer(b)), newSched, newSched.JspOpts(), "extractSched"); err1 != nil {
+		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "new schedules", cos.BHead(b), err1)
+		return nil, err
+	}
+	sched := p.sched.get()
+	if cmn.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(p.String(), "metasync from", caller+":", sched.String(), "=>", newSched.String())
+	}
+	if newSched.version() <= sched.version() {
+		if newSched.version() < sched.version() {
+			err = newErrDowngrade(p.si, sched.String(), newSched.String())
+		}
+		newSched = nil
+	}
+	return
+}
+
+func (p *proxy) receiveSched(newSched *schedMD) (err error) {
+	p.sched.Lock()
+	sched := p.sched.get()
+	if newSched.version() > sched.version() {
+		err = p.sched.putPersist(newSched)
+	} else if newSched.version() < sched.version() {
+		err = newErrDowngrade(p.si, sched.String(), newSched.String())
+	}
+	p.sched.Unlock()
+	return
+}
+
+//
+// REST API: [METHOD] /v1/schedules[/<name>]
+//
+
+func (p *proxy) schedHandler(w http.ResponseWriter, r *http.Request) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	apiItems, err := p.parseURL(w, r, apc.URLPathSchedules.L, 0, false)
+	if err != nil {
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		p.getSched(w, r, apiItems)
+	case http.MethodPut:
+		p.addSched(w, r)
+	case http.MethodDelete:
+		p.rmSched(w, r, apiItems)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPut)
+	}
+}
+
+func (p *proxy) getSched(w http.ResponseWriter, r *http.Request, apiItems []string) {
+	sched := p.sched.get()
+	if len(apiItems) == 0 {
+		p.writeJSON(w, r, &sched.Schedules, "get-schedules")
+		return
+	}
+	job, ok := sched.Jobs[apiItems[0]]
+	if !ok {
+		p.writeErrStatusf(w, r, http.StatusNotFound, "schedule %q does not exist", apiItems[0])
+		return
+	}
+	p.writeJSON(w, r, job, "get-schedule")
+}
+
+func (p *proxy) addSched(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if p.forwardCP(w, r, nil, "add schedule", body) {
+		return
+	}
+	job := &xact.Schedule{}
+	if err := jsoniter.Unmarshal(body, job); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrUnmarshal, p, "schedule", cos.BHead(body), err)
+		return
+	}
+	if err := job.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	sched, err := p.sched.modify(func(clone *schedMD) error {
+		if prev, ok := clone.Jobs[job.Name]; ok {
+			job.Created, job.Status = prev.Created, prev.Status // update in place
+		} else {
+			job.Created, job.Status = time.Now().UnixNano(), xact.SchedStatus{}
+		}
+		clone.Jobs[job.Name] = job
+		return nil
+	})
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	_ = p.metasyncer.sync(revsPair{sched, p.newAmsgActVal(apc.ActAddSchedule, job.Name)})
+}
+
+func (p *proxy) rmSched(w http.ResponseWriter, r *http.Request, apiItems []string) {
+	if len(apiItems) == 0 {
+		p.writeErrURL(w, r)
+		return
+	}
+	if p.forwardCP(w, r, nil, "remove schedule") {
+		return
+	}
+	name := apiItems[0]
+	sched, err := p.sched.modify(func(clone *schedMD) error {
+		if _, ok := clone.Jobs[name]; !ok {
+			return cos.NewErrNotFound("%s: schedule %q", p, name)
+		}
+		delete(clone.Jobs, name)
+		return nil
+	})
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			p.writeErr(w, r, err, http.StatusNotFound)
+		} else {
+			p.writeErr(w, r, err)
+		}
+		return
+	}
+	_ = p.metasyncer.sync(revsPair{sched, p.newAmsgActVal(apc.ActRmSchedule, name)})
+}
+
+//
+// primary: run due schedules (housekeeping callback)
+//
+
+func (p *proxy) regSched() { hk.Reg("sched"+hk.NameSuffix, p.runSched, schedInterval) }
+
+func (p *proxy) runSched() time.Duration {
+	smap := p.owner.smap.get()
+	if !smap.isPrimary(p.si) || !p.ClusterStarted() || p.settingNewPrimary.Load() {
+		return schedInterval
+	}
+	now := time.Now().UnixNano()
+	for _, job := range p.sched.get().Jobs {
+		if job.Disabled {
+			continue
+		}
+		next, err := job.Next()
+		if err != nil || next > now {
+			continue
+		}
+		if p.sched.begin(job.Name) {
+			go p.startSched(job, now)
+		}
+	}
+	return schedInterval
+}
+
+func (p *proxy) startSched(job *xact.Schedule, started int64) {
+	defer p.sched.end(job.Name)
+
+	xid, err := p._startSched(job)
+	if err != nil {
+		nlog.Errorln(p.String(), "failed to start", job.String()+":", err)
+	} else {
+		nlog.Infoln(p.String(), "started", job.String(), "xid", xid)
+	}
+	sched, errM := p.sched.modify(func(clone *schedMD) error {
+		prev, ok := clone.Jobs[job.Name]
+		if !ok {
+			return cos.NewErrNotFound("%s: schedule %q", p, job.Name) // removed in the meantime
+		}
+		updated := *prev
+		updated.Status.LastRun, updated.Status.Xid, updated.Status.Err = started, xid, ""
+		updated.Status.Runs++
+		if err != nil {
+			updated.Status.Err = err.Error()
+		}
+		clone.Jobs[job.Name] = &updated
+		return nil
+	})
+	if errM != nil {
+		if !cos.IsErrNotFound(errM) {
+			nlog.Errorln(errM)
+		}
+		return
+	}
+	_ = p.metasyncer.sync(revsPair{sched, p.newAmsgActVal(apc.ActRunSchedule, job.Name)})
+}
+
+// start the scheduled job via the respective public API (calling self)
+func (p *proxy) _startSched(job *xact.Schedule) (xid string, err error) {
+	var (
+		smap  = p.owner.smap.get()
+		cargs = allocCargs()
+		req   = cmn.HreqArgs{Base: p.si.URL(cmn.NetPublic), Method: http.MethodPost}
+	)
+	switch {
+	case job.Args != nil:
+		req.Method, req.Path = http.MethodPut, apc.URLPathClu.S
+		req.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActXactStart, Value: job.Args})
+		if !job.Args.Bck.IsEmpty() {
+			req.Query = job.Args.Bck.NewQuery()
+		}
+	case job.Action.Action == apc.ActDsort:
+		req.Path, req.Body = apc.URLPathdSort.S, cos.MustMarshal(job.Action.Value)
+	case job.Action.Action == apc.ActDownload:
+		req.Path, req.Body = apc.URLPathDownload.S, cos.MustMarshal(job.Action.Value)
+	default:
+		req.Path = apc.URLPathBuckets.Join(job.Bck.Name)
+		req.Body = cos.MustMarshal(job.Action)
+		req.Query = job.Bck.NewQuery()
+		for k, v := range job.Query {
+			req.Query[k] = v
+		}
+	}
+	req.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	{
+		cargs.si = p.si
+		cargs.req = req
+		cargs.timeout = apc.LongTimeout
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		err = res.toErr()
+	} else if job.Action != nil && job.Action.Action == apc.ActDownload {
+		resp := dload.DlPostResp{}
+		if err = jsoniter.Unmarshal(res.bytes, &resp); err == nil {
+			xid = resp.ID
+		}
+	} else {
+		xid = string(res.bytes)
+	}
+	freeCR(res)
+	return
+}
@@ -374,6 +374,7 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 			bargs.hdr = remoteBckProps
 		}
 		nprops = defaultBckProps(bargs)
+		nprops.ObjLock = bprops.ObjLock // (object lock cannot be reset)
 	default:
 		return "", fmt.Errorf(fmtErrInvaldAction, msg.Action, []string{apc.ActSetBprops, apc.ActResetBprops})
 	}
@@ -714,6 +715,9 @@ func (p *proxy) ecEncode(bck *meta.Bck, msg *apc.ActMsg) (xid string, err error)
 			cmn.ErrNotEnoughTargets, dataSlices, paritySlices, bck, dataSlices+paritySlices+1, numTs, smap)
 		return
 	}
+	if errDomain := ecDomainLoss(&cmn.ECConf{DataSlices: dataSlices, ParitySlices: paritySlices}, smap); errDomain != nil {
+		nlog.Warningln(bck.String()+":", errDomain)
+	}
 	if !nlp.TryLock(cmn.Rom.CplaneOperation() / 2) {
 		err = cmn.NewErrBusy("bucket", bck, "")
 		return
@@ -807,6 +811,45 @@ func (p *proxy) createArchMultiObj(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (x
 	return strings.Join(all, xact.UUIDSepa), nil
 }
 
+// inventory: { begin -- IC -- commit }
+// (begin to make sure that all targets are ready to receive - see t.inventory)
+func (p *proxy) inventory(bck *meta.Bck, msg *apc.ActMsg) (xid string, err error) {
+	c := p.prepTxnClient(msg, bck, false /*waitmsync*/)
+	if err = c.begin(bck); err != nil {
+		return
+	}
+	nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bck.Bucket())
+	nl.SetOwner(equalIC)
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+
+	xid, _, err = c.commit(bck, c.cmtTout(false /*waitmsync*/))
+	debug.Assertf(xid == "" || xid == c.uuid, "committed %q vs generated %q", xid, c.uuid)
+	if err != nil {
+		c.bcastAbort(bck, err) // cleanup txn
+	}
+	return
+}
+
+// sync bucket: { begin -- IC -- commit }
+// (ditto - see t.syncBck)
+func (p *proxy) syncBck(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (xid string, err error) {
+	c := p.prepTxnClient(msg, bckFrom, false /*waitmsync*/)
+	_ = bckTo.AddUnameToQuery(c.req.Query, apc.QparamBckTo)
+	if err = c.begin(bckFrom); err != nil {
+		return
+	}
+	nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bckFrom.Bucket(), bckTo.Bucket())
+	nl.SetOwner(equalIC)
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+
+	xid, _, err = c.commit(bckFrom, c.cmtTout(false /*waitmsync*/))
+	debug.Assertf(xid == "" || xid == c.uuid, "committed %q vs generated %q", xid, c.uuid)
+	if err != nil {
+		c.bcastAbort(bckFrom, err) // cleanup txn
+	}
+	return
+}
+
 func (p *proxy) beginRmTarget(si *meta.Snode, msg *apc.ActMsg) error {
 	debug.Assert(si.IsTarget(), si.StringEx())
 	c := p.prepTxnClient(msg, nil, false /*waitmsync*/)
@@ -1091,6 +1134,10 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet,
 			nprops.EC.ParitySlices = 1
 		}
 	}
+	if bprops.ObjLock.Enabled && !nprops.ObjLock.Enabled {
+		err = fmt.Errorf("%s: once enabled, object lock (%s) cannot be disabled", p.si, bck)
+		return
+	}
 	if !bprops.Mirror.Enabled && nprops.Mirror.Enabled {
 		if nprops.Mirror.Copies == 1 {
 			nprops.Mirror.Copies = max(cfg.Mirror.Copies, 2)
@@ -1098,6 +1145,9 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet,
 	} else if nprops.Mirror.Copies == 1 {
 		nprops.Mirror.Enabled = false
 	}
+	if nprops.Repl.Enabled && nprops.Repl.Copies == 0 {
+		nprops.Repl.Copies = max(cfg.Repl.Copies, 2)
+	}
 	if provider := nprops.BackendBck.Provider; nprops.BackendBck.Name != "" {
 		nprops.BackendBck.Provider, err = cmn.NormalizeProvider(provider)
 		if err != nil {
@@ -1112,6 +1162,9 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet,
 		return
 	}
 	err = nprops.Validate(targetCnt)
+	if err == nil && reec {
+		err = ecDomainLoss(&nprops.EC, p.owner.smap.get())
+	}
 	if cmn.IsErrSoft(err) && propsToUpdate.Force {
 		nlog.Warningf("Ignoring soft error: %v", err)
 		err = nil
@@ -18,6 +18,11 @@ const (
 	QparamStartAfter        = "start-after"
 	QparamDelimiter         = "delimiter"
 
+	// object lock
+	QparamObjectLock = "object-lock"
+	QparamRetention  = "retention"
+	QparamLegalHold  = "legal-hold"
+
 	// multipart
 	QparamMptUploads        = "uploads"
 	QparamMptUploadID       = "uploadId"
@@ -44,6 +44,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, errCode int) {
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case cmn.IsErrObjLocked(err):
+		out.Code = "AccessDenied"
 	default:
 		out.Code = in.TypeCode
 	}
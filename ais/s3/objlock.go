@@ -0,0 +1,191 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// S3 object lock (see cmn.ObjLockConf):
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+
+type (
+	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ObjectLockConfiguration.html
+	ObjectLockConfiguration struct {
+		Enabled string          `xml:"ObjectLockEnabled,omitempty"`
+		Rule    *ObjectLockRule `xml:"Rule,omitempty"`
+	}
+	ObjectLockRule struct {
+		DefaultRetention DefaultRetention `xml:"DefaultRetention"`
+	}
+	DefaultRetention struct {
+		Mode  string `xml:"Mode"`
+		Days  int    `xml:"Days,omitempty"`
+		Years int    `xml:"Years,omitempty"`
+	}
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ObjectLockRetention.html
+	Retention struct {
+		Mode            string `xml:"Mode,omitempty"`
+		RetainUntilDate string `xml:"RetainUntilDate,omitempty"`
+	}
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ObjectLockLegalHold.html
+	LegalHold struct {
+		Status string `xml:"Status"`
+	}
+)
+
+const (
+	objLockEnabled = "Enabled"
+	legalHoldOn    = "ON"
+	legalHoldOff   = "OFF"
+
+	day = 24 * time.Hour
+)
+
+/////////////////////////////
+// ObjectLockConfiguration //
+/////////////////////////////
+
+func NewObjectLockConfiguration(conf *cmn.ObjLockConf) *ObjectLockConfiguration {
+	r := &ObjectLockConfiguration{}
+	if !conf.Enabled {
+		return r
+	}
+	r.Enabled = objLockEnabled
+	if conf.Mode != "" {
+		days := (conf.Retention.D() + day - 1) / day // (rounding up)
+		r.Rule = &ObjectLockRule{DefaultRetention{Mode: strings.ToUpper(conf.Mode), Days: int(days)}}
+	}
+	return r
+}
+
+func (r *ObjectLockConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *ObjectLockConfiguration) ToProps() (*cmn.ObjLockConfToSet, error) {
+	if r.Enabled != objLockEnabled {
+		return nil, fmt.Errorf("invalid ObjectLockEnabled %q (expecting %q)", r.Enabled, objLockEnabled)
+	}
+	var (
+		enabled   = true
+		mode      string
+		retention cos.Duration
+	)
+	if r.Rule != nil {
+		dr := &r.Rule.DefaultRetention
+		if mode = strings.ToLower(dr.Mode); !apc.IsValidRetention(mode) {
+			return nil, fmt.Errorf("invalid default retention mode %q", dr.Mode)
+		}
+		if (dr.Days > 0) == (dr.Years > 0) {
+			return nil, errors.New("default retention requires either Days or Years (but not both)")
+		}
+		retention = cos.Duration(time.Duration(dr.Days)*day + time.Duration(dr.Years)*365*day)
+	}
+	return &cmn.ObjLockConfToSet{Enabled: &enabled, Mode: &mode, Retention: &retention}, nil
+}
+
+///////////////
+// Retention //
+///////////////
+
+func NewRetention(mode string, until int64) *Retention {
+	if mode == "" {
+		return &Retention{}
+	}
+	return &Retention{Mode: strings.ToUpper(mode), RetainUntilDate: cos.FormatNanoTime(until, time.RFC3339)}
+}
+
+func (r *Retention) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// empty mode: remove retention
+func (r *Retention) Parse() (mode string, until int64, err error) {
+	return parseRetention(r.Mode, r.RetainUntilDate)
+}
+
+func parseRetention(s3mode, date string) (mode string, until int64, err error) {
+	if s3mode == "" && date == "" {
+		return
+	}
+	if mode = strings.ToLower(s3mode); !apc.IsValidRetention(mode) {
+		err = fmt.Errorf("invalid retention mode %q", s3mode)
+		return
+	}
+	tm, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		err = fmt.Errorf("invalid retain-until date %q: %v", date, err)
+		return
+	}
+	until = tm.UnixNano()
+	return
+}
+
+///////////////
+// LegalHold //
+///////////////
+
+func NewLegalHold(on bool) *LegalHold {
+	if on {
+		return &LegalHold{Status: legalHoldOn}
+	}
+	return &LegalHold{Status: legalHoldOff}
+}
+
+func (r *LegalHold) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *LegalHold) On() (bool, error) { return parseLegalHold(r.Status) }
+
+func parseLegalHold(status string) (bool, error) {
+	switch status {
+	case legalHoldOn:
+		return true, nil
+	case legalHoldOff:
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid legal hold status %q (expecting %q or %q)", status, legalHoldOn, legalHoldOff)
+	}
+}
+
+/////////////
+// headers //
+/////////////
+
+// PUT object with object lock headers
+func ObjLockFromHeader(hdr http.Header) (mode string, until int64, hold bool, err error) {
+	if mode, until, err = parseRetention(hdr.Get(cos.S3HdrObjLockMode), hdr.Get(cos.S3HdrObjLockRetainUntil)); err != nil {
+		return
+	}
+	if status := hdr.Get(cos.S3HdrObjLockLegalHold); status != "" {
+		hold, err = parseLegalHold(status)
+	}
+	return
+}
+
+func BypassGovernance(hdr http.Header) bool {
+	return cos.IsParseBool(hdr.Get(cos.S3HdrBypassGovernanceRet))
+}
@@ -0,0 +1,92 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestObjectLockConfiguration(t *testing.T) {
+	conf := &cmn.ObjLockConf{Enabled: true, Mode: apc.RetentionCompliance, Retention: cos.Duration(36 * time.Hour)}
+	lconf := NewObjectLockConfiguration(conf)
+	if lconf.Rule == nil || lconf.Rule.DefaultRetention.Mode != "COMPLIANCE" || lconf.Rule.DefaultRetention.Days != 2 {
+		t.Fatalf("unexpected %+v", lconf)
+	}
+
+	body := `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+		`<Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`
+	lconf = &ObjectLockConfiguration{}
+	if err := xml.Unmarshal([]byte(body), lconf); err != nil {
+		t.Fatal(err)
+	}
+	toSet, err := lconf.ToProps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !*toSet.Enabled || *toSet.Mode != apc.RetentionGovernance || toSet.Retention.D() != 30*24*time.Hour {
+		t.Fatalf("unexpected %+v", toSet)
+	}
+
+	for _, body := range []string{
+		`<ObjectLockConfiguration></ObjectLockConfiguration>`,
+		`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+			`<Rule><DefaultRetention><Mode>FOREVER</Mode><Days>1</Days></DefaultRetention></Rule></ObjectLockConfiguration>`,
+		`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+			`<Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>1</Days><Years>1</Years></DefaultRetention></Rule></ObjectLockConfiguration>`,
+	} {
+		lconf = &ObjectLockConfiguration{}
+		if err := xml.Unmarshal([]byte(body), lconf); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := lconf.ToProps(); err == nil {
+			t.Fatalf("expected error: %s", body)
+		}
+	}
+}
+
+func TestRetentionAndLegalHold(t *testing.T) {
+	until := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC).UnixNano()
+	ret := NewRetention(apc.RetentionGovernance, until)
+	mode, u, err := ret.Parse()
+	if err != nil || mode != apc.RetentionGovernance || u != until {
+		t.Fatalf("retention: %q, %d, %v", mode, u, err)
+	}
+	if mode, u, err := NewRetention("", 0).Parse(); err != nil || mode != "" || u != 0 {
+		t.Fatalf("empty retention: %q, %d, %v", mode, u, err)
+	}
+	if _, _, err := (&Retention{Mode: "GOVERNANCE", RetainUntilDate: "tomorrow"}).Parse(); err == nil {
+		t.Fatal("expected invalid date error")
+	}
+
+	if on, err := NewLegalHold(true).On(); err != nil || !on {
+		t.Fatalf("legal hold: %t, %v", on, err)
+	}
+	if _, err := (&LegalHold{Status: "on"}).On(); err == nil {
+		t.Fatal("expected invalid status error")
+	}
+
+	hdr := http.Header{}
+	hdr.Set(cos.S3HdrObjLockMode, "COMPLIANCE")
+	hdr.Set(cos.S3HdrObjLockRetainUntil, ret.RetainUntilDate)
+	hdr.Set(cos.S3HdrObjLockLegalHold, "ON")
+	mode, u, hold, err := ObjLockFromHeader(hdr)
+	if err != nil || mode != apc.RetentionCompliance || u != until || !hold {
+		t.Fatalf("header: %q, %d, %t, %v", mode, u, hold, err)
+	}
+	if BypassGovernance(hdr) {
+		t.Fatal("unexpected bypass")
+	}
+	hdr.Set(cos.S3HdrBypassGovernanceRet, "true")
+	if !BypassGovernance(hdr) {
+		t.Fatal("expected bypass")
+	}
+}
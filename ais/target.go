@@ -70,6 +70,8 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		ra           readahead
+		repl         *cos.Semaphore // bounds the number of in-flight asynchronous replications
 	}
 )
 
@@ -194,9 +196,13 @@ func (t *target) init(config *cmn.Config) {
 	memsys.Init(t.SID(), t.SID(), config)
 
 	// new fs, check and add mountpaths
-	newVol := volume.Init(t, config, daemon.cli.target.allowSharedDisksAndNoDisks,
+	newVol, reweighted := volume.Init(t, config, daemon.cli.target.allowSharedDisksAndNoDisks,
 		daemon.cli.target.useLoopbackDevs, daemon.cli.target.startWithLostMountpath)
+	if reweighted && !newVol {
+		daemon.resilver.required, daemon.resilver.reason = true, "mountpath weights changed"
+	}
 	fs.ComputeDiskSize()
+	t.si.Weight = tweight(config)
 
 	t.initHostIP(config)
 	daemon.rg.add(t)
@@ -227,6 +233,21 @@ func (t *target) init(config *cmn.Config) {
 	s3.Init() // s3 multipart
 }
 
+// weighted HRW: explicitly configured or else the sum of mountpath weights (see meta.Smap.IsWeighted)
+func tweight(config *cmn.Config) (weight uint64) {
+	if !config.HRW.Enabled {
+		return 0
+	}
+	if config.HRW.Target > 0 {
+		return config.HRW.Target
+	}
+	for _, mi := range fs.GetAvail() {
+		weight += mi.Weight
+	}
+	nlog.Infoln("weighted HRW: target weight", weight)
+	return weight
+}
+
 func (t *target) initHostIP(config *cmn.Config) {
 	hostIP := os.Getenv("AIS_HOST_IP")
 	if hostIP == "" {
@@ -296,6 +317,9 @@ func (t *target) Run() error {
 	}
 	config := cmn.GCO.Get()
 	t.htrun.init(config)
+	if g.intra != nil {
+		transport.UseIntraTLS(g.intra.wrap)
+	}
 
 	tstats := t.statsT.(*stats.Trunner)
 
@@ -377,6 +401,8 @@ func (t *target) Run() error {
 
 	ec.Init(t)
 	mirror.Init()
+	t.regTiering()
+	t.ra.init(t)
 
 	xreg.RegWithHK()
 
@@ -691,6 +717,18 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 			filename = rel
 		}
 	}
+	sample := dpq.archsample // apc.QparamArchSample
+	if sample != "" {
+		if filename != "" {
+			t.writeErrf(w, r, "%s: archived file %q and sample %q are mutually exclusive", lom.Cname(), filename, sample)
+			return lom
+		}
+		if strings.HasPrefix(sample, lom.ObjName) {
+			if rel, err := filepath.Rel(lom.ObjName, sample); err == nil {
+				sample = rel
+			}
+		}
+	}
 	// GET context
 	goi := allocGOI()
 	{
@@ -709,6 +747,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.archive = archiveQuery{
 			filename: filename,
 			mime:     dpq.archmime, // apc.QparamArchmime
+			sample:   sample,
 		}
 		goi.isGFN = cos.IsParseBool(dpq.isGFN) // query.Get(apc.QparamIsGFNRequest)
 		// goi.chunked = config.Net.HTTP.Chunked NOTE: disabled - no need
@@ -862,7 +901,8 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 	if !t.isValidObjname(w, r, objName) {
 		return
 	}
-	if isRedirect(apireq.query) == "" {
+	replica := cos.IsParseBool(apireq.query.Get(apc.QparamIsReplica))
+	if isRedirect(apireq.query) == "" && !replica {
 		t.writeErrf(w, r, "%s: %s(obj) is expected to be redirected", t.si, r.Method)
 		return
 	}
@@ -875,7 +915,16 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	errCode, err := t.DeleteObject(lom, evict)
+	var (
+		errCode int
+		err     error
+		force   = cos.IsParseBool(apireq.query.Get(apc.QparamForce)) // evict pinned
+	)
+	if replica {
+		errCode, err = t.delLocal(lom, evict, force, s3.BypassGovernance(r.Header))
+	} else {
+		errCode, err = t.delObject(lom, evict, force, false /*bypass governance*/)
+	}
 	if err == nil {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -895,7 +944,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActRenameObject {
+	if msg.Action != apc.ActRenameObject && msg.Action != apc.ActBlobDl {
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -912,6 +961,16 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 	err = lom.InitBck(apireq.bck.Bucket())
+	if err == nil && msg.Action == apc.ActBlobDl {
+		xid, errCode, err := t.blobdl(lom, msg)
+		if err != nil {
+			t.writeErr(w, r, err, errCode)
+		} else {
+			w.Write([]byte(xid))
+		}
+		cluster.FreeLOM(lom)
+		return
+	}
 	if err == nil {
 		err = t.objMv(lom, msg)
 	}
@@ -919,7 +978,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		t.statsT.Inc(stats.RenameCount)
 	} else {
 		t.statsT.IncErr(stats.RenameCount)
-		t.writeErr(w, r, err)
+		t.writeErr(w, r, err, errLockedCode(err))
 	}
 	cluster.FreeLOM(lom)
 }
@@ -1250,6 +1309,11 @@ func (t *target) putApndArch(r *http.Request, lom *cluster.LOM, started int64, d
 		}
 		a.put = true
 	} else {
+		if lom.Bprops().ObjLock.Enabled {
+			if err := lom.CheckLocked(false); err != nil {
+				return errLockedCode(err), err
+			}
+		}
 		a.put = (flags == 0)
 	}
 	if s := r.Header.Get(cos.HdrContentLength); s != "" {
@@ -1264,10 +1328,25 @@ func (t *target) putApndArch(r *http.Request, lom *cluster.LOM, started int64, d
 	return a.do()
 }
 
-func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (int, error) {
+	return t.delObject(lom, evict, true /*force*/, false /*bypass governance*/)
+}
+
+// (see also: lom.CheckLocked)
+// force: evict pinned objects as well
+func (t *target) delObject(lom *cluster.LOM, evict, force, bypassGovernance bool) (int, error) {
+	code, err := t.delLocal(lom, evict, force, bypassGovernance)
+	// replicated bucket: same for the replicas (including those of a locally missing object)
+	if lom.Bprops().Repl.Enabled && (err == nil || code == http.StatusNotFound) {
+		t.delRepl(lom, evict, bypassGovernance)
+	}
+	return code, err
+}
+
+func (t *target) delLocal(lom *cluster.LOM, evict, force, bypassGovernance bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(lom, evict, force, bypassGovernance)
 	lom.Unlock(true)
 
 	// special corner-case retry (quote):
@@ -1282,13 +1361,13 @@ func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (code int, err error
 	}
 	if err == nil {
 		t.statsT.Inc(stats.DeleteCount)
-	} else {
+	} else if !cmn.IsErrObjPinned(err) {
 		t.statsT.IncErr(stats.DeleteCount) // TODO: count GET/PUT/DELETE remote errors separately..
 	}
 	return
 }
 
-func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(lom *cluster.LOM, evict, force, bypassGovernance bool) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
@@ -1296,6 +1375,12 @@ func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
 	)
 	delFromBackend = lom.Bck().IsRemote() && !evict
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+		if err := lom.CheckLocked(bypassGovernance); err != nil {
+			return http.StatusForbidden, err, false
+		}
+		if evict && !force && lom.IsPinned() {
+			return http.StatusConflict, cmn.NewErrObjPinned(lom.Cname()), false
+		}
 		delFromAIS = true
 	} else if !cmn.IsObjNotExist(err) {
 		return 0, err, false
@@ -1346,6 +1431,20 @@ func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if lom.Bprops().ObjLock.Enabled {
+		if err := t.checkLocked(lom); err != nil {
+			return err
+		}
+		dst := cluster.AllocLOM(msg.Name)
+		err := dst.InitBck(lom.Bucket())
+		if err == nil {
+			err = t.checkLocked(dst) // (cannot be overwritten either)
+		}
+		cluster.FreeLOM(dst)
+		if err != nil {
+			return err
+		}
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coi := allocCOI()
@@ -1356,8 +1455,11 @@ func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
 		coi.finalize = true
 	}
 	_, err := coi.copyObject(lom, msg.Name /* new object name */)
-	slab.Free(buf)
 	freeCOI(coi)
+	if err == nil && lom.Bprops().Repl.Enabled {
+		t.mvRepl(lom, msg.Name, buf)
+	}
+	slab.Free(buf)
 	if err != nil {
 		return err
 	}
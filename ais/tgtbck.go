@@ -387,6 +387,10 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			nlp := newBckNLP(apireq.bck)
 			nlp.Lock()
 			defer nlp.Unlock()
+			if err := t.checkBckLocked(apireq.bck); err != nil {
+				t.writeErr(w, r, err, errLockedCode(err))
+				return
+			}
 
 			cluster.UncacheBck(apireq.bck)
 			err := fs.DestroyBucket(msg.Action, apireq.bck.Bucket(), apireq.bck.Props.BID)
@@ -412,7 +416,8 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 				return
 			}
 		}
-		rns := xreg.RenewEvictDelete(msg.UUID, t, msg.Action /*xaction kind*/, apireq.bck, lrMsg)
+		force := cos.IsParseBool(apireq.query.Get(apc.QparamForce)) // evict pinned
+		rns := xreg.RenewEvictDelete(msg.UUID, t, msg.Action /*xaction kind*/, apireq.bck, lrMsg, force)
 		if rns.Err != nil {
 			t.writeErr(w, r, rns.Err)
 			return
@@ -473,6 +478,37 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		rns := xreg.RenewPrefetch(msg.UUID, t, apireq.bck, lrMsg)
 		xctn := rns.Entry.Get()
 		go xctn.Run(nil)
+	case apc.ActPinObjects, apc.ActUnpinObjects:
+		lrMsg := &apc.ListRange{}
+		if !apireq.bck.IsRemote() {
+			t.writeErrf(w, r, "%s: expecting remote bucket, got %s, action=%s",
+				t.si, apireq.bck, msg.Action)
+			return
+		}
+		if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		for _, name := range lrMsg.ObjNames {
+			if !t.isValidObjname(w, r, name) {
+				return
+			}
+		}
+		rns := xreg.RenewPinUnpin(msg.UUID, t, msg.Action /*xaction kind*/, apireq.bck, lrMsg)
+		if rns.Err != nil {
+			t.writeErr(w, r, rns.Err)
+			return
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{
+				When: cluster.UponTerm,
+				Dsts: []string{equalIC},
+				F:    t.notifyTerm,
+			},
+			Xact: xctn,
+		})
+		go xctn.Run(nil)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -0,0 +1,118 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// cold GET of a large remote object (see bucket props `blob_download`):
+// start blob download and transmit the downloaded prefix as it grows;
+// the write lock is handed over to (and eventually released by) the xaction
+func (goi *getOI) coldBlob(res *cluster.GetReaderResult) (int, error) {
+	var (
+		t, lom = goi.t, goi.lom
+		args   = &xreg.BlobArgs{Lom: lom, Res: res}
+	)
+	rns := xreg.RenewBlobDl(t, cos.GenUUID(), args)
+	if rns.Err != nil {
+		cos.Close(res.R)
+		lom.Unlock(true)
+		nlog.Infoln(ftcg+"(blob)", lom.Cname(), rns.Err)
+		return http.StatusInternalServerError, rns.Err
+	}
+	xctn := rns.Entry.Get().(*xs.XactBlobDl)
+	rd, err := xctn.NewReader()
+	go xctn.Run(nil)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// transmit
+	var (
+		written   int64
+		oa        = *lom.ObjAttrs()
+		whdr      = goi.w.Header()
+		buf, slab = t.gmm.AllocSize(min(res.Size, memsys.MaxPageSlabSize))
+	)
+	oa.Size, oa.Cksum = res.Size, res.ExpCksum // (the checksum is yet to be computed and validated)
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	cmn.ToHeader(&oa, whdr)
+
+	written, err = cos.CopyBuffer(goi.w, rd, buf)
+	slab.Free(buf)
+	cos.Close(rd)
+	if err != nil {
+		nlog.Errorln(ftcg+"(blob)", lom.Cname(), xctn.Name(), err)
+		if written == 0 {
+			whdr.Del(cos.HdrContentLength)
+			return http.StatusInternalServerError, err
+		}
+		return 0, errSendingResp
+	}
+	goi.t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.GetColdCount, Value: 1},
+		cos.NamedVal64{Name: stats.GetColdSize, Value: res.Size},
+		cos.NamedVal64{Name: stats.GetColdRwLatency, Value: mono.SinceNano(goi.ltime)},
+	)
+	goi.stats(written)
+	return 0, nil
+}
+
+// POST /v1/objects/bucket-name/object-name { apc.ActBlobDl }
+// (compare with goi.coldBlob)
+func (t *target) blobdl(lom *cluster.LOM, msg *apc.ActMsg) (xid string, errCode int, err error) {
+	args := &xreg.BlobArgs{Lom: lom, Msg: &apc.BlobMsg{}}
+	if msg.Value != nil {
+		if err = cos.MorphMarshal(msg.Value, args.Msg); err != nil {
+			err = fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, msg.Action, msg.Value, err)
+			return "", http.StatusBadRequest, err
+		}
+	}
+	conf := cmn.BlobDlConf{ChunkSize: cos.SizeIEC(args.Msg.ChunkSize), NumWorkers: args.Msg.NumWorkers}
+	if err = conf.Validate(); err != nil {
+		return "", http.StatusBadRequest, err
+	}
+	if !lom.Bck().IsRemote() {
+		return "", http.StatusBadRequest, fmt.Errorf("%s: expecting remote bucket, got %s", msg.Action, lom.Bck())
+	}
+
+	if !lom.TryLock(true) {
+		return "", http.StatusConflict, cmn.NewErrBusy("object", lom, "")
+	}
+	if err = lom.Load(true /*cache it*/, true /*locked*/); err == nil {
+		lom.Unlock(true)
+		return "", 0, nil // (already present)
+	}
+	lom.SetCustomMD(nil)
+	res := t.Backend(lom.Bck()).GetObjReader(context.Background(), lom, 0, 0)
+	if res.Err != nil {
+		lom.Unlock(true)
+		return "", res.ErrCode, res.Err
+	}
+	args.Res = &res
+	rns := xreg.RenewBlobDl(t, cos.GenUUID(), args)
+	if rns.Err != nil {
+		cos.Close(res.R)
+		lom.Unlock(true)
+		return "", http.StatusInternalServerError, rns.Err
+	}
+	xctn := rns.Entry.Get()
+	go xctn.Run(nil)
+	return xctn.ID(), 0, nil
+}
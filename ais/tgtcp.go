@@ -168,6 +168,10 @@ func (t *target) daeputJSON(w http.ResponseWriter, r *http.Request) {
 		}
 	case apc.ActRotateLogs:
 		nlog.Flush(nlog.ActRotate)
+	case apc.ActReloadCert:
+		if err := reloadCert(); err != nil {
+			t.writeErr(w, r, err)
+		}
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
 		t.statsT.ResetStats(errorsOnly)
@@ -286,7 +290,7 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	)
 	switch getWhat {
 	case apc.WhatNodeConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
-		apc.WhatSnode, apc.WhatLog, apc.WhatNodeStats, apc.WhatMetricNames:
+		apc.WhatSnode, apc.WhatLog, apc.WhatAuditLog, apc.WhatNodeStats, apc.WhatMetricNames:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	case apc.WhatSysInfo:
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
@@ -313,6 +317,7 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			BuildTime:      daemon.buildTime,
 			K8sPodName:     os.Getenv(env.AIS.K8sPod),
 			Status:         t._status(smap),
+			CertExpires:    certExpires(),
 		}
 		// stats and capacity
 		daeStats := t.statsT.GetStats()
@@ -1127,6 +1132,26 @@ func (t *target) enable() error {
 // checks with a given target to see if it has the object.
 // target acts as a client - compare with api.HeadObject
 func (t *target) headt2t(lom *cluster.LOM, tsi *meta.Snode, smap *smapX) (ok bool) {
+	res := t._headt2t(lom, tsi, smap)
+	ok = res.err == nil
+	freeCR(res)
+	return
+}
+
+// same as above, returning the object's attributes (size, checksum, version, custom)
+func (t *target) headt2tAttrs(lom *cluster.LOM, tsi *meta.Snode, smap *smapX) (oa *cmn.ObjAttrs, errCode int, err error) {
+	res := t._headt2t(lom, tsi, smap)
+	if res.err != nil {
+		errCode, err = res.status, res.toErr()
+	} else {
+		oa = &cmn.ObjAttrs{}
+		oa.Cksum = oa.FromHeader(res.header)
+	}
+	freeCR(res)
+	return
+}
+
+func (t *target) _headt2t(lom *cluster.LOM, tsi *meta.Snode, smap *smapX) *callResult {
 	q := lom.Bck().NewQuery()
 	q.Set(apc.QparamSilent, "true")
 	q.Set(apc.QparamFltPresence, strconv.Itoa(apc.FltPresent))
@@ -1146,10 +1171,8 @@ func (t *target) headt2t(lom *cluster.LOM, tsi *meta.Snode, smap *smapX) (ok boo
 		cargs.timeout = cmn.Rom.CplaneOperation()
 	}
 	res := t.call(cargs, smap)
-	ok = res.err == nil
 	freeCargs(cargs)
-	freeCR(res)
-	return
+	return res
 }
 
 // headObjBcast broadcasts to all targets to find out if anyone has the specified object.
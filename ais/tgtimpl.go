@@ -86,14 +86,14 @@ func (t *target) PutObject(lom *cluster.LOM, params *cluster.PutObjectParams) er
 	return err
 }
 
-func (t *target) FinalizeObj(lom *cluster.LOM, workFQN string, xctn cluster.Xact) (errCode int, err error) {
+func (t *target) FinalizeObj(lom *cluster.LOM, workFQN string, xctn cluster.Xact, owt cmn.OWT) (errCode int, err error) {
 	poi := allocPOI()
 	{
 		poi.t = t
 		poi.atime = time.Now().UnixNano()
 		poi.lom = lom
 		poi.workFQN = workFQN
-		poi.owt = cmn.OwtFinalize
+		poi.owt = owt
 		poi.xctn = xctn
 	}
 	errCode, err = poi.finalize()
@@ -101,8 +101,8 @@ func (t *target) FinalizeObj(lom *cluster.LOM, workFQN string, xctn cluster.Xact
 	return
 }
 
-func (t *target) EvictObject(lom *cluster.LOM) (errCode int, err error) {
-	errCode, err = t.DeleteObject(lom, true /*evict*/)
+func (t *target) EvictObject(lom *cluster.LOM, force bool) (errCode int, err error) {
+	errCode, err = t.delObject(lom, true /*evict*/, force, false /*bypass governance*/)
 	return
 }
 
@@ -110,6 +110,10 @@ func (t *target) HeadObjT2T(lom *cluster.LOM, si *meta.Snode) bool {
 	return t.headt2t(lom, si, t.owner.smap.get())
 }
 
+func (t *target) HeadObjAttrsT2T(lom *cluster.LOM, si *meta.Snode) (*cmn.ObjAttrs, int, error) {
+	return t.headt2tAttrs(lom, si, t.owner.smap.get())
+}
+
 // CopyObject:
 // - either creates a full replica of the source object (the `lom` argument)
 // - or transforms the object
@@ -126,6 +130,9 @@ func (t *target) HeadObjT2T(lom *cluster.LOM, si *meta.Snode) bool {
 //     the AIS cluster (by performing a cold GET if need be).
 //   - if the dst is cloud, we perform a regular PUT logic thus also making sure that the new
 //     replica gets created in the cloud bucket of _this_ AIS cluster.
+//
+// Without data mover (`params.DM` nil) the object is PUT directly to its destination target
+// (and, if need be, to the remote destination bucket) - see also `OwtFinalize`.
 func (t *target) CopyObject(lom *cluster.LOM, params *cluster.CopyObjectParams, dryRun bool) (size int64, err error) {
 	objNameTo := lom.ObjName
 	coi := allocCOI()
@@ -133,6 +140,9 @@ func (t *target) CopyObject(lom *cluster.LOM, params *cluster.CopyObjectParams,
 		coi.CopyObjectParams = *params
 		coi.t = t
 		coi.owt = cmn.OwtMigrate
+		if params.DM == nil {
+			coi.owt = cmn.OwtFinalize
+		}
 		coi.finalize = false
 		coi.dryRun = dryRun
 	}
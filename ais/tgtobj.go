@@ -29,6 +29,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
@@ -176,6 +177,18 @@ func (poi *putOI) putObject() (errCode int, err error) {
 		}
 	}
 
+	// object lock: fail fast (user PUTs only; see also poi.fini)
+	if poi.owt == cmn.OwtPut && !poi.t2t && poi.lom.Bprops().ObjLock.Enabled {
+		if err = poi.t.objLockPrecheck(poi.lom); err != nil {
+			cos.DrainReader(poi.r)
+			if poi.restful {
+				poi.t.statsT.IncErr(stats.PutCount)
+			}
+			errCode = errLockedCode(err)
+			return
+		}
+	}
+
 	buf, slab, lmfh, erw := poi.write()
 	poi._cleanup(buf, slab, lmfh, erw)
 	if erw != nil {
@@ -186,6 +199,10 @@ func (poi *putOI) putObject() (errCode int, err error) {
 	if errCode, err = poi.finalize(); err != nil {
 		goto rerr
 	}
+	// cross-target replication (user PUTs only; replicas are t2t)
+	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t && poi.lom.Bprops().Repl.Enabled {
+		poi.t.putRepl(poi.lom)
+	}
 
 	// stats
 	if !poi.t2t {
@@ -266,9 +283,26 @@ func (poi *putOI) finalize() (errCode int, err error) {
 // poi.workFQN => LOM
 func (poi *putOI) fini() (errCode int, err error) {
 	var (
-		lom = poi.lom
-		bck = lom.Bck()
+		lom  = poi.lom
+		bck  = lom.Bck()
+		worm = poi.worm()
 	)
+	// object lock: check (and apply default retention) under the same write lock
+	// that also covers put-remote and rename
+	if worm {
+		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime)
+		nlp, erl := poi.t.rlockBck(bck)
+		if erl != nil {
+			return http.StatusConflict, erl
+		}
+		defer nlp.Unlock()
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		if err = poi.t.objLockPut(lom, poi.atime); err != nil {
+			return errLockedCode(err), err
+		}
+	}
+
 	// put remote
 	if bck.IsRemote() && (poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote) {
 		errCode, err = poi.putRemote()
@@ -304,8 +338,10 @@ func (poi *putOI) fini() (errCode int, err error) {
 	default:
 		// expecting valid atime passed with `poi`
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime)
-		lom.Lock(true)
-		defer lom.Unlock(true)
+		if !worm {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+		}
 		lom.SetAtimeUnix(poi.atime)
 	}
 
@@ -323,6 +359,12 @@ func (poi *putOI) fini() (errCode int, err error) {
 		}
 	}
 
+	// start of the eviction-free period (see apc.EvictTTL); not transferred by rebalance
+	// (migrated objects get evicted in the order of their access times)
+	if poi.owt != cmn.OwtMigrate && lom.Bprops().LRU.Policy == apc.EvictTTL {
+		lom.SetColdGetUnix(time.Now().UnixNano())
+	}
+
 	// done
 	if err = lom.RenameFrom(poi.workFQN); err != nil {
 		return
@@ -339,6 +381,22 @@ func (poi *putOI) fini() (errCode int, err error) {
 	return
 }
 
+// object lock applies to all writes except rebalance, EC, and get-from-neighbor
+// that merely relocate (or restore) the same object
+func (poi *putOI) worm() bool {
+	if !poi.lom.Bprops().ObjLock.Enabled {
+		return false
+	}
+	switch poi.owt {
+	case cmn.OwtPut, cmn.OwtPromote, cmn.OwtFinalize:
+		return true
+	case cmn.OwtMigrate:
+		return poi.t2t // copy, rename, replica
+	default:
+		return false
+	}
+}
+
 // via backend.PutObj()
 func (poi *putOI) putRemote() (errCode int, err error) {
 	var (
@@ -618,7 +676,7 @@ do:
 		goi.lom.SetCustomMD(nil)
 
 		// backend: read remote
-		res = goi.t.Backend(goi.lom.Bck()).GetObjReader(goi.ctx, goi.lom)
+		res = goi.t.Backend(goi.lom.Bck()).GetObjReader(goi.ctx, goi.lom, 0, 0)
 		if res.Err != nil {
 			goi.lom.Unlock(true)
 			goi.unlocked = true
@@ -629,8 +687,16 @@ do:
 		}
 		goi.cold = true
 
+		// large object: chunked (blob) download
+		if bdl := &goi.lom.Bprops().BlobDl; bdl.Threshold > 0 && res.Size >= int64(bdl.Threshold) &&
+			goi.ranges.Range == "" && goi.archive.filename == "" && goi.archive.sample == "" {
+			errCode, err = goi.coldBlob(&res)
+			goi.unlocked = true // always
+			return
+		}
+
 		// fast path limitations: read archived; compute more checksums (TODO: reduce)
-		fast = fast && goi.archive.filename == "" &&
+		fast = fast && goi.archive.filename == "" && goi.archive.sample == "" &&
 			(ckconf.Type == cos.ChecksumNone || (!ckconf.ValidateColdGet && !ckconf.EnableReadRange))
 
 		// fast path
@@ -767,7 +833,7 @@ validate:
 	}
 
 	nlog.Warningln(err)
-	redundant := lom.HasCopies() || lom.Bprops().EC.Enabled
+	redundant := lom.HasCopies() || lom.Bprops().EC.Enabled || lom.Bprops().Repl.Enabled
 	//
 	// return err if there's no redundancy OR already recovered once (and failed)
 	//
@@ -797,14 +863,14 @@ validate:
 			goto validate
 		}
 	}
-	if lom.Bprops().EC.Enabled {
+	if lom.Bprops().EC.Enabled || lom.Bprops().Repl.Enabled {
 		retried = true
 		goi.lom.Unlock(false)
 		cos.RemoveFile(lom.FQN)
 		_, code, err = goi.restoreFromAny(true /*skipLomRestore*/)
 		goi.lom.Lock(false)
 		if err == nil {
-			nlog.Warningf("%s: recovered corrupted %s from EC slices", goi.t, lom)
+			nlog.Warningf("%s: recovered corrupted %s from EC slices or replicas", goi.t, lom)
 			code = 0
 			goto validate
 		}
@@ -821,7 +887,8 @@ validate:
 // 1) local copies (other FSes on this target)
 // 2) other targets (when resilvering or rebalancing is running (aka GFN))
 // 3) other targets if the bucket erasure coded
-// 4) Cloud
+// 4) other targets if the bucket is replicated (see cmn.ReplConf)
+// 5) Cloud
 func (goi *getOI) restoreFromAny(skipLomRestore bool) (doubleCheck bool, errCode int, err error) {
 	var (
 		tsi   *meta.Snode
@@ -881,6 +948,19 @@ gfn:
 		}
 	}
 
+	// replicated bucket: GET from the most recent replica (e.g., when the original main target
+	// is down or in maintenance), and keep the copy
+	if gfnNode == nil && goi.lom.Bprops().Repl.Enabled {
+		tsi, errR := goi.t.findRepl(goi.ctx, goi.lom, smap)
+		switch {
+		case errR != nil:
+			nlog.Warningln(errR)
+		case tsi != nil && goi.getFromNeighbor(goi.lom, tsi):
+			nlog.Infof("%s: restored %s from replica at %s", tname, goi.lom, tsi)
+			return
+		}
+	}
+
 	// restore from existing EC slices, if possible
 	ecErr := ec.ECM.RestoreObject(goi.lom)
 	if ecErr == nil {
@@ -998,6 +1078,11 @@ func (goi *getOI) finalize() (errCode int, err error) {
 			errCode = http.StatusRequestedRangeNotSatisfiable
 			goto ret
 		}
+		if goi.archive.sample != "" {
+			err = cmn.NewErrUnsupp("range-read archived sample", goi.archive.sample)
+			errCode = http.StatusRequestedRangeNotSatisfiable
+			goto ret
+		}
 	}
 	errCode, err = goi.fini(fqn, lmfh, hdr, hrng)
 ret:
@@ -1045,6 +1130,18 @@ func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange
 		hdr.Del(apc.HdrObjCksumType)
 		hdr.Set(apc.HdrArchmime, mime)
 		hdr.Set(apc.HdrArchpath, goi.archive.filename)
+	case goi.archive.sample != "": // WebDataset sample
+		var rc io.ReadCloser
+		if rc, errCode, err = goi.sample(lmfh); err != nil {
+			return
+		}
+		defer func() {
+			rc.Close()
+		}()
+		reader, size = rc, cos.ContentLengthUnknown
+		hdr.Del(apc.HdrObjCksumVal)
+		hdr.Del(apc.HdrObjCksumType)
+		hdr.Set(apc.HdrArchmime, archive.ExtTar)
 	case hrng != nil: // range
 		ckconf := goi.lom.CksumConf()
 		cksumRange := ckconf.Type != cos.ChecksumNone && ckconf.EnableReadRange
@@ -1071,14 +1168,52 @@ func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange
 		size = goi.lom.SizeBytes()
 	}
 
-	hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	bufsize := int64(64 * cos.KiB)
+	if size != cos.ContentLengthUnknown {
+		hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+		bufsize = min(size, bufsize)
+	}
 	hdr.Set(cos.HdrContentType, cos.ContentBinary)
-	buf, slab := goi.t.gmm.AllocSize(min(size, 64*cos.KiB))
+	buf, slab := goi.t.gmm.AllocSize(bufsize)
 	err = goi.transmit(reader, buf, fqn)
 	slab.Free(buf)
 	return
 }
 
+// stream all files of the requested sample (as TAR); the first pass (that does not read
+// archived content) makes sure the sample exists prior to sending anything
+func (goi *getOI) sample(lmfh *os.File) (io.ReadCloser, int, error) {
+	mime, err := archive.MimeFile(lmfh, goi.t.smm, goi.archive.mime, goi.lom.ObjName)
+	if err != nil {
+		return nil, 0, err
+	}
+	ar, err := archive.NewReader(mime, lmfh, goi.lom.SizeBytes())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open %s: %w", goi.lom.Cname(), err)
+	}
+	found, err := shard.HasSample(ar, goi.archive.sample)
+	if err != nil {
+		return nil, 0, cmn.NewErrFailedTo(goi.t, "find sample "+goi.archive.sample+" in", goi.lom, err)
+	}
+	if !found {
+		return nil, http.StatusNotFound, cos.NewErrNotFound("sample %q in archive %q", goi.archive.sample, goi.lom.Cname())
+	}
+	if _, err = lmfh.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, err
+	}
+	if ar, err = archive.NewReader(mime, lmfh, goi.lom.SizeBytes()); err != nil {
+		return nil, 0, fmt.Errorf("failed to open %s: %w", goi.lom.Cname(), err)
+	}
+	pr, pw := io.Pipe()
+	go func(key string) {
+		aw := archive.NewWriter(archive.ExtTar, pw, nil /*cksum*/, nil /*opts*/)
+		_, err := shard.WriteSample(ar, aw, key)
+		aw.Fini()
+		pw.CloseWithError(err)
+	}(goi.archive.sample)
+	return pr, 0, nil
+}
+
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
 	written, err := cos.CopyBuffer(goi.w, r, buf)
 	if err != nil {
@@ -1101,8 +1236,14 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
 			return errSendingResp
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
+		if goi.lom.Bprops().LRU.Policy == apc.EvictLFU || cmn.GCO.Get().Tiering.Enabled {
+			goi.lom.IncHits()
+		}
 		goi.lom.Recache()
 	}
+	if !goi.isGFN && goi.lom.Bprops().Readahead.Enabled {
+		goi.t.ra.get(goi.lom, goi.cold)
+	}
 	//
 	// stats
 	//
@@ -1199,6 +1340,13 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, errCode int, err error) {
 		workFQN = a.hdl.workFQN
 	)
 	if workFQN == "" {
+		// object lock: fail fast (flush is checked again, under write lock - see poi.fini)
+		if a.lom.Bprops().ObjLock.Enabled {
+			if err = a.t.objLockPrecheck(a.lom); err != nil {
+				errCode = errLockedCode(err)
+				return
+			}
+		}
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
@@ -1365,6 +1513,11 @@ func (coi *copyOI) copyObject(lom *cluster.LOM, objNameTo string) (size int64, e
 			if lom.EqCksum(dst.Checksum()) {
 				return
 			}
+			if dst.Bprops().ObjLock.Enabled {
+				if err = dst.CheckLocked(false); err != nil {
+					return
+				}
+			}
 		} else if cmn.IsErrBucketNought(err) {
 			return
 		}
@@ -1451,7 +1604,7 @@ func (coi *copyOI) putReader(lom, dst *cluster.LOM) (size int64, err error) {
 		if coi.DM != nil {
 			params.OWT = coi.DM.OWT()
 		} else {
-			params.OWT = cmn.OwtMigrate
+			params.OWT = coi.owt
 		}
 		params.Atime = lom.Atime()
 	}
@@ -1753,6 +1906,11 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		debug.AssertNoErr(err)
 		debug.Assertf(finfo.Size() == size, "%d != %d", finfo.Size(), size)
 	})
+	if a.lom.Bprops().ObjLock.Enabled {
+		if err := a.lom.SetDefRetention(a.started); err != nil {
+			return err
+		}
+	}
 	// done
 	if err := a.lom.RenameFrom(fqn); err != nil {
 		return err
@@ -266,3 +266,49 @@ func BenchmarkObjGetDiscard(b *testing.B) {
 		})
 	}
 }
+
+func TestEvictPinned(tt *testing.T) {
+	bck := meta.NewBck("evict-pinned", apc.AWS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+	if err := t.owner.bmd.putPersist(bmd, nil); err != nil {
+		tt.Fatal(err)
+	}
+	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+
+	lom := cluster.AllocLOM("pinned")
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		tt.Fatal(err)
+	}
+	if err := cos.CreateDir(lom.Mountpath().MakePathCT(lom.Bucket(), fs.ObjectType)); err != nil {
+		tt.Fatal(err)
+	}
+	if err := os.WriteFile(lom.FQN, []byte("pinned"), cos.PermRWR); err != nil {
+		tt.Fatal(err)
+	}
+	defer os.Remove(lom.FQN)
+	lom.SetSize(int64(len("pinned")))
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	lom.SetPinned(true)
+	if err := lom.Persist(); err != nil {
+		tt.Fatal(err)
+	}
+
+	// not forced: skipped
+	code, err := t.EvictObject(lom, false /*force*/)
+	if !cmn.IsErrObjPinned(err) || code != http.StatusConflict {
+		tt.Fatalf("expected pinned error (%d), got %v(%d)", http.StatusConflict, err, code)
+	}
+	if err := cos.Stat(lom.FQN); err != nil {
+		tt.Fatalf("expected %s to remain: %v", lom, err)
+	}
+
+	// forced
+	if _, err := t.EvictObject(lom, true /*force*/); err != nil {
+		tt.Fatal(err)
+	}
+	if err := cos.Stat(lom.FQN); !os.IsNotExist(err) {
+		tt.Fatalf("expected %s to be evicted, err: %v", lom, err)
+	}
+}
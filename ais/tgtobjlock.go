@@ -0,0 +1,118 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Object lock (WORM), target side (see cmn.ObjLockConf and cluster/lretain.go):
+// - delete and evict: t.delobj;
+// - rename: t.objMv;
+// - PUT (including multipart), promote, append, copy, download, dsort: t.objLockPut
+//   under write lock (see poi.fini), with t.objLockPrecheck to fail early;
+// - APPEND to archive (shard): t.putApndArch and xs.XactArch;
+// - destroy and evict bucket: t.checkBckLocked (begin phase) under exclusive bucket lock,
+//   while new object locks (above and S3 API) are set under shared bucket lock (t.rlockBck);
+// - S3 API: tgts3lock.go
+
+func errLockedCode(err error) int {
+	if cmn.IsErrObjLocked(err) {
+		return http.StatusForbidden
+	}
+	return 0
+}
+
+// load (if exists) and check
+func (t *target) checkLocked(lom *cluster.LOM) error {
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return lom.CheckLocked(false)
+}
+
+// (new version of) object `lom` is about to be written - caller must hold the write lock:
+// - existing object, if locked, cannot be overwritten;
+// - new object gets the bucket's default retention unless already specified (e.g., via S3 headers)
+func (*target) objLockPut(lom *cluster.LOM, now int64) error {
+	if err := lom.CheckLockedPut(); err != nil {
+		return err
+	}
+	return lom.SetDefRetention(now)
+}
+
+// shared bucket lock for the duration of setting a new object lock: blocks while
+// destroying (evicting) the bucket is pending (see t.destroyBucket)
+func (*target) rlockBck(bck *meta.Bck) (cluster.NLP, error) {
+	nlp := newBckNLP(bck)
+	if !nlp.TryRLock(cmn.Rom.CplaneOperation()) {
+		return nil, cmn.NewErrBusy("bucket", bck, "")
+	}
+	return nlp, nil
+}
+
+// fail fast - before receiving the object (to be checked again - see objLockPut)
+func (t *target) objLockPrecheck(lom *cluster.LOM) error {
+	existing := cluster.AllocLOM(lom.ObjName)
+	err := existing.InitBck(lom.Bucket())
+	if err == nil {
+		err = t.checkLocked(existing)
+	}
+	cluster.FreeLOM(existing)
+	return err
+}
+
+// destroying (or evicting) bucket with object lock enabled requires that none of its
+// (locally stored) objects is currently locked
+func (t *target) checkBckLocked(bck *meta.Bck) error {
+	if !bck.Props.ObjLock.Enabled {
+		return nil
+	}
+	var (
+		locked *cluster.LOM
+		avail  = fs.GetAvail()
+	)
+	for _, mi := range avail {
+		opts := &fs.WalkOpts{
+			Mi:  mi,
+			Bck: bck.Clone(),
+			CTs: []string{fs.ObjectType},
+			Callback: func(fqn string, de fs.DirEntry) error {
+				if de.IsDir() {
+					return nil
+				}
+				lom := cluster.AllocLOM("")
+				if lom.InitFQN(fqn, bck.Bucket()) != nil || lom.Load(false /*cache it*/, false /*locked*/) != nil {
+					cluster.FreeLOM(lom)
+					return nil
+				}
+				if lom.CheckLocked(false) != nil {
+					locked = lom
+					return cmn.NewErrAborted(bck.Cname(""), "check-locked", nil) // stop walking
+				}
+				cluster.FreeLOM(lom)
+				return nil
+			},
+		}
+		err := fs.Walk(opts)
+		if locked != nil {
+			err = cmn.NewErrObjLocked(bck.Cname(""), "contains locked objects, e.g. "+locked.ObjName)
+			cluster.FreeLOM(locked)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
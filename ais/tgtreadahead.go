@@ -0,0 +1,180 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Readahead (bucket property 'readahead'):
+// - a sequence is defined by a numbered object name, e.g. "shard-000123.tar",
+//   where the (last) number varies while prefix ("shard-") and suffix (".tar") don't;
+// - given HRW distribution, each target only observes its own share of a sequence -
+//   consecutive GETs are therefore considered sequential if the number increases
+//   by no more than the configured readahead count;
+// - upon sequential GET, the target runs a prefetch xaction (xact/xs/multiobj.go)
+//   for the next `count` names (range template) that, in turn, cold-GETs only
+//   those objects that this target owns (and skips those that don't exist).
+
+const (
+	raIdleTime   = 10 * time.Minute // forget sequence that hasn't been accessed that long
+	raMaxSeqs    = 4096             // max number of tracked sequences (per target)
+	raMaxDigits  = 18               // fits int64
+	raNameSuffix = ".readahead"
+)
+
+type (
+	raSeq struct {
+		last  int64 // number of the most recently read object
+		ahead int64 // prefetched (or being prefetched) up to and including
+		atime int64 // mono.NanoTime
+	}
+	readahead struct {
+		t    *target
+		seqs map[string]*raSeq // key: bucket + name pattern
+		mu   sync.Mutex
+	}
+)
+
+func (ra *readahead) init(t *target) {
+	ra.t = t
+	ra.seqs = make(map[string]*raSeq, 16)
+	hk.Reg(apc.ActPrefetchObjects+raNameSuffix+hk.NameSuffix, ra.housekeep, raIdleTime)
+}
+
+// is called upon successful GET from a readahead-enabled bucket
+func (ra *readahead) get(lom *cluster.LOM, cold bool) {
+	bck := lom.Bck()
+	if !bck.IsRemote() {
+		return
+	}
+	prefix, suffix, num, width, ok := raParse(lom.ObjName)
+	if !ok {
+		return
+	}
+	var (
+		count = int64(lom.Bprops().Readahead.Num())
+		key   = bck.MakeUname(prefix) + "\x00" + suffix
+	)
+	if start, end := ra.next(key, num, count, cold); end > 0 {
+		template := raTemplate(prefix, suffix, start, end, width)
+		go ra.prefetch(meta.CloneBck(bck.Bucket()), template)
+	}
+}
+
+// track the sequence and return the range to prefetch, if any (end == 0 otherwise)
+func (ra *readahead) next(key string, num, count int64, cold bool) (start, end int64) {
+	now := mono.NanoTime()
+	ra.mu.Lock()
+	seq, ok := ra.seqs[key]
+	if !ok {
+		if len(ra.seqs) < raMaxSeqs {
+			ra.seqs[key] = &raSeq{last: num, atime: now}
+		}
+		ra.mu.Unlock()
+		return
+	}
+	seq.atime = now
+	switch {
+	case num < seq.last:
+		seq.last, seq.ahead = num, 0 // backwards - start over
+		ra.mu.Unlock()
+		return
+	case num == seq.last:
+		ra.mu.Unlock() // same object again
+		return
+	case num-seq.last > count:
+		seq.last = num // skipped ahead - not sequential (yet)
+		ra.mu.Unlock()
+		return
+	}
+	hit := !cold && num <= seq.ahead
+	seq.last = num
+	if seq.ahead < num+count/2 {
+		start, end = max(seq.ahead, num)+1, num+count
+		seq.ahead = end
+	}
+	ra.mu.Unlock()
+
+	switch {
+	case cold:
+		ra.t.statsT.Inc(stats.ReadaheadMissCount)
+	case hit:
+		ra.t.statsT.Inc(stats.ReadaheadHitCount)
+	}
+	return
+}
+
+func (ra *readahead) prefetch(bck *meta.Bck, template string) {
+	msg := &apc.ListRange{Template: template}
+	rns := xreg.RenewPrefetch(cos.GenUUID(), ra.t, bck, msg)
+	if rns.Err != nil {
+		nlog.Errorf("%s: failed to read ahead %s: %v", ra.t, bck.Cname(template), rns.Err)
+		return
+	}
+	xctn := rns.Entry.Get()
+	if cmn.FastV(4, cos.SmoduleAIS) {
+		nlog.Infof("%s: %s %s", ra.t, xctn, bck.Cname(template))
+	}
+	xctn.Run(nil)
+}
+
+func (ra *readahead) housekeep() time.Duration {
+	now := mono.NanoTime()
+	ra.mu.Lock()
+	for key, seq := range ra.seqs {
+		if time.Duration(now-seq.atime) > raIdleTime {
+			delete(ra.seqs, key)
+		}
+	}
+	ra.mu.Unlock()
+	return raIdleTime
+}
+
+// split object name into prefix, (last) number, and suffix, e.g.:
+// "a/b/shard-000123.tar" => ("a/b/shard-", 123, ".tar", width 6)
+func raParse(objName string) (prefix, suffix string, num int64, width int, ok bool) {
+	end := strings.LastIndexAny(objName, "0123456789")
+	if end < 0 {
+		return
+	}
+	begin := end
+	for begin > 0 && objName[begin-1] >= '0' && objName[begin-1] <= '9' {
+		begin--
+	}
+	width = end - begin + 1
+	if width > raMaxDigits {
+		return
+	}
+	prefix, suffix = objName[:begin], objName[end+1:]
+	if strings.ContainsAny(prefix, "{}") || strings.ContainsAny(suffix, "{}") {
+		return // cannot be expressed as a range template
+	}
+	for i := begin; i <= end; i++ {
+		num = num*10 + int64(objName[i]-'0')
+	}
+	ok = true
+	return
+}
+
+// e.g., ("shard-", ".tar", 124, 155, 6) => "shard-{000124..000155}.tar"
+// (see cos.ParseBashTemplate)
+func raTemplate(prefix, suffix string, start, end int64, width int) string {
+	return fmt.Sprintf("%s{%0*d..%0*d}%s", prefix, width, start, width, end, suffix)
+}
@@ -0,0 +1,128 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// counts readahead hits and misses
+type raStatsTracker struct {
+	mock.StatsTracker
+	hits, misses int
+}
+
+func (st *raStatsTracker) Inc(name string) {
+	switch name {
+	case stats.ReadaheadHitCount:
+		st.hits++
+	case stats.ReadaheadMissCount:
+		st.misses++
+	}
+}
+
+type raStep struct {
+	num        int64
+	cold       bool
+	start, end int64 // expected range to prefetch (zeros: none)
+	hits, miss int   // expected counters (cumulative)
+}
+
+var _ = Describe("Readahead", func() {
+	DescribeTable("parse numbered object name",
+		func(objName, prefix, suffix string, num int64, width int) {
+			p, s, n, w, ok := raParse(objName)
+			Expect(ok).To(BeTrue())
+			Expect(p).To(Equal(prefix))
+			Expect(s).To(Equal(suffix))
+			Expect(n).To(Equal(num))
+			Expect(w).To(Equal(width))
+		},
+		Entry("zero-padded", "shard-000123.tar", "shard-", ".tar", int64(123), 6),
+		Entry("last number", "dir7/part-2-0042.tgz", "dir7/part-2-", ".tgz", int64(42), 4),
+		Entry("no suffix", "img100", "img", "", int64(100), 3),
+		Entry("no prefix", "9.jpg", "", ".jpg", int64(9), 1),
+	)
+
+	DescribeTable("skip",
+		func(objName string) {
+			_, _, _, _, ok := raParse(objName)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("no number", "shard.tar"),
+		Entry("too many digits", "shard-1234567890123456789.tar"),
+		Entry("braces", "{shard}-0001.tar"),
+	)
+
+	It("should generate the next object names", func() {
+		prefix, suffix, num, width, ok := raParse("a/shard-000998.tar")
+		Expect(ok).To(BeTrue())
+
+		template := raTemplate(prefix, suffix, num+1, num+3, width)
+		Expect(template).To(Equal("a/shard-{000999..001001}.tar"))
+
+		pt, err := cos.NewParsedTemplate(template)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pt.ToSlice()).To(Equal([]string{"a/shard-000999.tar", "a/shard-001000.tar", "a/shard-001001.tar"}))
+	})
+
+	It("should extend the template width when running out of digits", func() {
+		pt, err := cos.NewParsedTemplate(raTemplate("x-", "", 98, 101, 2))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pt.ToSlice()).To(Equal([]string{"x-98", "x-99", "x-100", "x-101"}))
+	})
+
+	DescribeTable("sequence of GETs",
+		func(steps ...raStep) {
+			var (
+				st     = &raStatsTracker{}
+				ra     = &readahead{t: t, seqs: make(map[string]*raSeq, 1)}
+				statsT = t.statsT
+			)
+			t.statsT = st
+			defer func() { t.statsT = statsT }()
+			for i, step := range steps {
+				start, end := ra.next("bck/shard-\x00.tar", step.num, 8, step.cold)
+				Expect(start).To(Equal(step.start), "step %d", i)
+				Expect(end).To(Equal(step.end), "step %d", i)
+				Expect(st.hits).To(Equal(step.hits), "step %d", i)
+				Expect(st.misses).To(Equal(step.miss), "step %d", i)
+			}
+		},
+		Entry("sequential",
+			raStep{num: 10, cold: true},
+			raStep{num: 11, cold: true, start: 12, end: 19, miss: 1},
+			raStep{num: 12, hits: 1, miss: 1},
+			raStep{num: 16, start: 20, end: 24, hits: 2, miss: 1},
+			raStep{num: 20, hits: 3, miss: 1},
+			raStep{num: 21, start: 25, end: 29, hits: 4, miss: 1},
+		),
+		Entry("same object again",
+			raStep{num: 10, cold: true},
+			raStep{num: 11, cold: true, start: 12, end: 19, miss: 1},
+			raStep{num: 11, miss: 1},
+			raStep{num: 12, hits: 1, miss: 1},
+		),
+		Entry("skip ahead",
+			raStep{num: 10, cold: true},
+			raStep{num: 11, cold: true, start: 12, end: 19, miss: 1},
+			raStep{num: 30, cold: true, miss: 1},
+			raStep{num: 31, cold: true, start: 32, end: 39, miss: 2},
+			raStep{num: 32, hits: 1, miss: 2},
+		),
+		Entry("backwards",
+			raStep{num: 10, cold: true},
+			raStep{num: 11, cold: true, start: 12, end: 19, miss: 1},
+			raStep{num: 5, miss: 1},
+			raStep{num: 6, start: 7, end: 14, miss: 1},
+			raStep{num: 7, hits: 1, miss: 1},
+		),
+	)
+})
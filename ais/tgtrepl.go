@@ -0,0 +1,313 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+//
+// cross-target replication (see cmn.ReplConf)
+//
+
+// max number of PUTs replicating in the background; beyond that, PUTs replicate synchronously
+// (back-pressure)
+const maxAsyncRepl = 256
+
+// replica targets: the object's main (HRW) target followed by the next `copies - 1` HRW targets
+// (none when the bucket is not replicated or there's a single active target)
+func replTargets(lom *cluster.LOM, smap *smapX) (meta.Nodes, error) {
+	rconf := &lom.Bprops().Repl
+	if !rconf.Enabled {
+		return nil, nil
+	}
+	copies := min(rconf.Copies, smap.CountActiveTs())
+	if copies < 2 {
+		return nil, nil
+	}
+	return smap.HrwTargetList(lom.Uname(), copies)
+}
+
+// put replicas in the background unless configured to replicate synchronously
+// or there are already too many in-flight background replications (see maxAsyncRepl);
+// either way, the object is already committed locally (and a failure to replicate
+// does not fail the PUT - rebalance restores the replica count)
+func (t *target) putRepl(lom *cluster.LOM) {
+	sis, err := replTargets(lom, t.owner.smap.get())
+	if err != nil {
+		t.statsT.IncErr(stats.ErrPutReplCount)
+		nlog.Errorln(t.String()+":", lom.Cname(), err)
+		return
+	}
+	if len(sis) == 0 {
+		return
+	}
+	if lom.Bprops().Repl.Sync {
+		t._repl(lom, sis)
+		return
+	}
+	select {
+	case <-t.repl.TryAcquire():
+		clone := lom.CloneMD(lom.FQN)
+		go func() {
+			t._repl(clone, sis)
+			cluster.FreeLOM(clone)
+			t.repl.Release()
+		}()
+	default:
+		t._repl(lom, sis)
+	}
+}
+
+func (t *target) _repl(lom *cluster.LOM, sis meta.Nodes) {
+	for _, tsi := range sis {
+		if tsi.ID() == t.SID() {
+			continue
+		}
+		if err := t.putReplT2T(lom, tsi, lom.ObjName); err != nil {
+			t.statsT.IncErr(stats.ErrPutReplCount)
+			nlog.Errorln(err)
+		}
+	}
+}
+
+// PUT a copy of the local object => `tsi` (intra-cluster, never replicated any further)
+func (t *target) PutObjT2T(lom *cluster.LOM, tsi *meta.Snode) error {
+	return t.putReplT2T(lom, tsi, lom.ObjName)
+}
+
+func (t *target) putReplT2T(lom *cluster.LOM, tsi *meta.Snode, objNameTo string) error {
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	reader, err := lom.NewDeferROC() // unlocks upon close
+	if err != nil {
+		return err
+	}
+	var (
+		coi   = &copyOI{t: t}
+		sargs = allocSnda()
+	)
+	{
+		sargs.reader = reader
+		sargs.objAttrs = lom
+		sargs.tsi = tsi
+		sargs.bckTo = lom.Bck()
+		sargs.objNameTo = objNameTo
+		sargs.owt = cmn.OwtMigrate
+	}
+	err = coi.put(sargs)
+	freeSnda(sargs)
+	return err
+}
+
+// DELETE (evict) the object's replicas, if any
+func (t *target) delRepl(lom *cluster.LOM, evict, bypassGovernance bool) {
+	smap := t.owner.smap.get()
+	sis, err := replTargets(lom, smap)
+	if err != nil {
+		nlog.Errorln(t.String()+":", lom.Cname(), err)
+		return
+	}
+	var (
+		msg   = apc.ActMsg{Action: apc.ActDeleteObjects}
+		query = lom.Bck().NewQuery()
+		hdr   = http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		}
+	)
+	if evict {
+		msg.Action = apc.ActEvictObjects
+		query.Set(apc.QparamForce, "true") // (the main target has already decided)
+	}
+	if bypassGovernance {
+		hdr.Set(cos.S3HdrBypassGovernanceRet, "true")
+	}
+	query.Set(apc.QparamIsReplica, "true")
+	for _, tsi := range sis {
+		if tsi.ID() == t.SID() {
+			continue
+		}
+		cargs := allocCargs()
+		{
+			cargs.si = tsi
+			cargs.req = cmn.HreqArgs{
+				Method: http.MethodDelete,
+				Base:   tsi.URL(cmn.NetIntraControl),
+				Path:   apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+				Query:  query,
+				Header: hdr,
+				Body:   cos.MustMarshal(&msg),
+			}
+			cargs.timeout = cmn.Rom.CplaneOperation()
+		}
+		res := t.call(cargs, smap)
+		freeCargs(cargs)
+		if res.err != nil && res.status != http.StatusNotFound {
+			t.statsT.IncErr(stats.ErrDelReplCount)
+			nlog.Errorf("%s: failed to %s replica %s at %s: %v", t, msg.Action, lom.Cname(), tsi, res.err)
+		}
+		freeCR(res)
+	}
+}
+
+// rename: replicate the new object (its main target already has it) and
+// delete the replicas of the old one
+func (t *target) mvRepl(lom *cluster.LOM, objNameTo string, buf []byte) {
+	smap := t.owner.smap.get()
+	dst := cluster.AllocLOM(objNameTo)
+	defer cluster.FreeLOM(dst)
+	if err := dst.InitBck(lom.Bucket()); err != nil {
+		nlog.Errorln(err)
+		return
+	}
+	sis, err := replTargets(dst, smap)
+	if err != nil {
+		nlog.Errorln(t.String()+":", dst.Cname(), err)
+	}
+	for i, tsi := range sis {
+		if i == 0 {
+			continue // main target (see objMv)
+		}
+		if tsi.ID() != t.SID() {
+			err = t.putReplT2T(lom, tsi, objNameTo)
+		} else {
+			err = t.copyRepl(lom, dst, buf)
+		}
+		if err != nil {
+			t.statsT.IncErr(stats.ErrPutReplCount)
+			nlog.Errorln(err)
+		}
+	}
+	t.delRepl(lom, false /*evict*/, false /*bypass governance*/)
+}
+
+// this target is one of the new name's replica targets (and not the main one)
+func (*target) copyRepl(lom, dst *cluster.LOM, buf []byte) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	dst.Lock(true)
+	defer dst.Unlock(true)
+	clone, err := lom.Copy2FQN(dst.FQN, buf)
+	if err == nil {
+		cluster.FreeLOM(clone)
+	}
+	return err
+}
+
+// find the replica to restore from: with remote buckets, the one that matches the remote object;
+// otherwise, the most recent one - replicas that fell behind (e.g., were offline at the time of the
+// last PUT) are never used
+// - first, query the object's replica (HRW) targets;
+// - only if none of them has it (e.g., the cluster has changed since the last PUT), query all targets
+func (t *target) findRepl(ctx context.Context, lom *cluster.LOM, smap *smapX) (*meta.Snode, error) {
+	var remote *cmn.ObjAttrs
+	if lom.Bck().IsRemote() {
+		oa, _, err := t.Backend(lom.Bck()).HeadObj(ctx, lom)
+		if err != nil {
+			return nil, err
+		}
+		remote = oa
+	}
+	args := allocBcArgs()
+	defer freeBcArgs(args)
+	q := lom.Bck().NewQuery()
+	q.Set(apc.QparamSilent, "true")
+	q.Set(apc.QparamFltPresence, strconv.Itoa(apc.FltPresent))
+	args.req = cmn.HreqArgs{
+		Method: http.MethodHead,
+		Header: http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		},
+		Path:  apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+		Query: q,
+	}
+	args.ignoreMaintenance = true
+	args.smap = smap
+	args.network = cmn.NetIntraControl
+	args.timeout = cmn.Rom.CplaneOperation()
+
+	sis, err := replTargets(lom, smap)
+	if err != nil {
+		return nil, err
+	}
+	for _, tsi := range sis {
+		if tsi.ID() != t.SID() {
+			args.selected = append(args.selected, tsi)
+		}
+	}
+	if len(args.selected) > 0 {
+		args.nodeCount = len(args.selected)
+		results := t.bcastSelected(args)
+		tsi, err := t._findRepl(lom, results, remote)
+		freeBcastRes(results)
+		if tsi != nil || err != nil {
+			return tsi, err
+		}
+		args.selected, args.nodeCount = nil, 0
+	}
+
+	args.to = cluster.Targets
+	results := t.bcastGroup(args)
+	tsi, err := t._findRepl(lom, results, remote)
+	freeBcastRes(results)
+	return tsi, err
+}
+
+func (t *target) _findRepl(lom *cluster.LOM, results sliceResults, remote *cmn.ObjAttrs) (*meta.Snode, error) {
+	var (
+		tsi    *meta.Snode
+		latest *cmn.ObjAttrs
+	)
+	for _, res := range results {
+		if res.err != nil || res.si.ID() == t.SID() {
+			continue
+		}
+		oa := &cmn.ObjAttrs{}
+		oa.Cksum = oa.FromHeader(res.header)
+		switch {
+		case remote != nil:
+			if oa.Equal(remote) {
+				return res.si, nil
+			}
+		case latest == nil:
+			tsi, latest = res.si, oa
+		case lom.VersionConf().Enabled:
+			if verGreater(oa.Ver, latest.Ver) {
+				tsi, latest = res.si, oa
+			}
+		case !latest.SameReplica(oa):
+			return nil, fmt.Errorf("%s: replicas of %s at %s and %s differ (unversioned)", t, lom.Cname(), tsi, res.si)
+		}
+	}
+	return tsi, nil
+}
+
+func verGreater(a, b string) bool {
+	va, erra := strconv.ParseInt(a, 10, 64)
+	vb, errb := strconv.ParseInt(b, 10, 64)
+	if erra != nil || errb != nil {
+		return false
+	}
+	return va > vb
+}
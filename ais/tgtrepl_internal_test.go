@@ -0,0 +1,223 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testReplBucket = "repl"
+	testReplCopies = 3
+	numReplTargets = 6
+)
+
+// fake target: responds to HEAD(object) iff it has the object; accepts PUTs
+type replTarget struct {
+	srv  *httptest.Server
+	si   *meta.Snode
+	mu   sync.Mutex
+	reqs map[string]int // method => count
+	has  bool
+}
+
+func (rt *replTarget) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt.mu.Lock()
+	rt.reqs[r.Method]++
+	has := rt.has
+	rt.mu.Unlock()
+	cos.DrainReader(r.Body)
+	if r.Method == http.MethodHead && !has {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (rt *replTarget) count(method string) int {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return rt.reqs[method]
+}
+
+var _ = Describe("Replication", func() {
+	var (
+		fakes map[string]*replTarget
+		smap  *smapX
+		lom   *cluster.LOM
+		sis   meta.Nodes
+	)
+
+	setBprops := func(sync bool) {
+		bck := meta.NewBck(testReplBucket, apc.AIS, cmn.NsGlobal)
+		bmd := t.owner.bmd.get().clone()
+		bmd.del(bck)
+		bmd.add(bck, &cmn.Bprops{
+			Cksum: cmn.CksumConf{Type: cos.ChecksumNone},
+			Repl:  cmn.ReplConf{Enabled: true, Copies: testReplCopies, Sync: sync},
+		})
+		Expect(t.owner.bmd.putPersist(bmd, nil)).NotTo(HaveOccurred())
+		fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	}
+
+	initLOM := func() {
+		Expect(lom.InitBck(&cmn.Bck{Name: testReplBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		setBprops(false)
+		fakes = make(map[string]*replTarget, numReplTargets)
+		smap = newSmap()
+		for i := 0; i < numReplTargets; i++ {
+			rt := &replTarget{reqs: make(map[string]int, 2)}
+			rt.srv = httptest.NewServer(rt)
+			ni := serverTCPAddr(rt.srv.URL)
+			rt.si = newSnode("t"+strconv.Itoa(i), apc.Target, ni, ni, ni)
+			smap.addTarget(rt.si)
+			fakes[rt.si.ID()] = rt
+		}
+		g.client.data = &http.Client{}
+		g.client.control = &http.Client{}
+		config := cmn.GCO.BeginUpdate()
+		config.Timeout.SendFile = cos.Duration(10 * time.Second)
+		cmn.GCO.CommitUpdate(config)
+		if t.keepalive == nil {
+			t.keepalive = newTalive(t, t.statsT, atomic.NewBool(true))
+		}
+
+		lom = cluster.AllocLOM("dir/obj")
+		initLOM()
+		var err error
+		sis, err = replTargets(lom, smap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sis).To(HaveLen(testReplCopies))
+	})
+
+	AfterEach(func() {
+		for _, rt := range fakes {
+			rt.srv.Close()
+		}
+		os.Remove(lom.FQN)
+		cluster.FreeLOM(lom)
+	})
+
+	isReplTarget := func(id string) bool {
+		for _, si := range sis {
+			if si.ID() == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	createObj := func() {
+		Expect(cos.CreateDir(lom.Mountpath().MakePathCT(lom.Bucket(), fs.ObjectType))).NotTo(HaveOccurred())
+		fh, err := cos.CreateFile(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = io.WriteString(fh, "replicated")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+		lom.SetSize(int64(len("replicated")))
+		lom.SetAtimeUnix(1)
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+	}
+
+	It("should query replica targets first", func() {
+		fakes[sis[testReplCopies-1].ID()].has = true
+		tsi, err := t.findRepl(context.Background(), lom, smap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tsi).NotTo(BeNil())
+		Expect(tsi.ID()).To(Equal(sis[testReplCopies-1].ID()))
+		for id, rt := range fakes {
+			if isReplTarget(id) {
+				Expect(rt.count(http.MethodHead)).To(Equal(1), id)
+			} else {
+				Expect(rt.count(http.MethodHead)).To(BeZero(), id)
+			}
+		}
+	})
+
+	It("should query all targets when replica targets do not have it", func() {
+		var other string
+		for id, rt := range fakes {
+			if !isReplTarget(id) {
+				rt.has, other = true, id
+				break
+			}
+		}
+		tsi, err := t.findRepl(context.Background(), lom, smap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tsi).NotTo(BeNil())
+		Expect(tsi.ID()).To(Equal(other))
+		for id, rt := range fakes {
+			if isReplTarget(id) {
+				Expect(rt.count(http.MethodHead)).To(Equal(2), id)
+			} else {
+				Expect(rt.count(http.MethodHead)).To(Equal(1), id)
+			}
+		}
+	})
+
+	It("should not find missing replica", func() {
+		tsi, err := t.findRepl(context.Background(), lom, smap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tsi).To(BeNil())
+	})
+
+	It("should put replicas synchronously", func() {
+		setBprops(true)
+		initLOM()
+		t.owner.smap.put(smap)
+		createObj()
+		t.putRepl(lom)
+		for id, rt := range fakes {
+			if isReplTarget(id) {
+				Expect(rt.count(http.MethodPut)).To(Equal(1), id)
+			} else {
+				Expect(rt.count(http.MethodPut)).To(BeZero(), id)
+			}
+		}
+	})
+
+	It("should replicate synchronously when too many in flight", func() {
+		t.owner.smap.put(smap)
+		createObj()
+		repl := t.repl
+		t.repl = cos.NewSemaphore(0) // all taken
+		defer func() { t.repl = repl }()
+		t.putRepl(lom)
+		for id, rt := range fakes {
+			if isReplTarget(id) {
+				Expect(rt.count(http.MethodPut)).To(Equal(1), id)
+			}
+		}
+	})
+
+	It("should replicate in the background", func() {
+		t.owner.smap.put(smap)
+		createObj()
+		t.putRepl(lom)
+		for id, rt := range fakes {
+			if isReplTarget(id) {
+				Eventually(func() int { return rt.count(http.MethodPut) }).Should(Equal(1), id)
+			}
+		}
+	})
+})
@@ -56,7 +56,7 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 }
 
 // PUT /s3/<bucket-name>/<object-name>
-// [switch] mpt | put | copy
+// [switch] mpt | object lock | put | copy
 func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.Config, items []string) {
 	cs := fs.Cap()
 	if cs.IsOOS() {
@@ -82,6 +82,12 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 			}
 			t.putMptPart(w, r, items, q, bck)
 		}
+	case q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold):
+		if len(items) < 2 {
+			s3.WriteErr(w, r, errS3Obj, 0)
+			return
+		}
+		t.putObjLockS3(w, r, bck, s3.ObjName(items), q)
 	case r.Header.Get(cos.S3HdrObjSrc) == "":
 		t.putObjS3(w, r, items, bck)
 	default:
@@ -186,6 +192,20 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, items []string
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
+	// object lock headers, if any
+	mode, until, hold, err := s3.ObjLockFromHeader(r.Header)
+	if err == nil && (mode != "" || hold) {
+		if !bck.Props.ObjLock.Enabled {
+			err = fmt.Errorf("bucket %s: object lock is not enabled", bck)
+		} else if err = lom.SetRetention(mode, until, false); err == nil {
+			lom.SetLegalHold(hold)
+		}
+	}
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+
 	// TODO: dual checksumming, e.g. lom.SetCustom(apc.AWS, ...)
 
 	dpq := dpqAlloc()
@@ -234,6 +254,10 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Co
 		return
 	}
 	objName := s3.ObjName(items)
+	if q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold) {
+		t.getObjLockS3(w, r, bck, objName, q)
+		return
+	}
 	if q.Has(s3.QparamMptPartNo) {
 		if config.FastV(5, cos.SmoduleS3) {
 			nlog.Infoln("getMptPart", bck.String(), objName, q)
@@ -330,6 +354,15 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	lastModified := cos.FormatNanoTime(op.Atime, cos.RFC1123GMT)
 	hdr.Set(cos.S3LastModified, lastModified)
 
+	if exists && bck.Props.ObjLock.Enabled {
+		if mode, until := lom.Retention(); mode != "" {
+			ret := s3.NewRetention(mode, until)
+			hdr.Set(cos.S3HdrObjLockMode, ret.Mode)
+			hdr.Set(cos.S3HdrObjLockRetainUntil, ret.RetainUntilDate)
+		}
+		hdr.Set(cos.S3HdrObjLockLegalHold, s3.NewLegalHold(lom.LegalHold()).Status)
+	}
+
 	// TODO: lom.Checksum() via apc.HeaderPrefix+apc.HdrObjCksumType/Val via
 	// s3 obj Metadata map[string]*string
 }
@@ -352,12 +385,15 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	errCode, err = t.DeleteObject(lom, false)
+	errCode, err = t.delObject(lom, false /*evict*/, false /*force*/, s3.BypassGovernance(r.Header))
 	if err != nil {
 		name := lom.Cname()
-		if errCode == http.StatusNotFound {
+		switch errCode {
+		case http.StatusNotFound:
 			s3.WriteErr(w, r, cos.NewErrNotFound("%s: %s", t.si, name), http.StatusNotFound)
-		} else {
+		case http.StatusForbidden:
+			s3.WriteErr(w, r, err, errCode)
+		default:
 			s3.WriteErr(w, r, fmt.Errorf("error deleting %s: %v", name, err), errCode)
 		}
 		return
@@ -0,0 +1,118 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// S3 object lock: per-object retention and legal hold (see also tgtobjlock.go)
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectRetention.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLegalHold.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+
+// GET /s3/<bucket-name>/<object-name>?retention|legal-hold
+func (t *target) getObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, q url.Values) {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, errObjLoadCode(err))
+		return
+	}
+	sgl := t.gmm.NewSGL(0)
+	if q.Has(s3.QparamRetention) {
+		s3.NewRetention(lom.Retention()).MustMarshal(sgl)
+	} else {
+		s3.NewLegalHold(lom.LegalHold()).MustMarshal(sgl)
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?retention|legal-hold
+func (t *target) putObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, q url.Values) {
+	if !bck.Props.ObjLock.Enabled {
+		s3.WriteErr(w, r, fmt.Errorf("bucket %s: object lock is not enabled", bck), 0)
+		return
+	}
+	var (
+		retention = q.Has(s3.QparamRetention)
+		decoder   = xml.NewDecoder(r.Body)
+		ret       s3.Retention
+		hold      s3.LegalHold
+		err       error
+	)
+	if retention {
+		err = decoder.Decode(&ret)
+	} else {
+		err = decoder.Decode(&hold)
+	}
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+
+	nlp, err := t.rlockBck(bck)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusConflict)
+		return
+	}
+	defer nlp.Unlock()
+
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, errObjLoadCode(err))
+		return
+	}
+	if retention {
+		var (
+			mode  string
+			until int64
+		)
+		if mode, until, err = ret.Parse(); err == nil {
+			err = lom.SetRetention(mode, until, s3.BypassGovernance(r.Header))
+		}
+	} else {
+		var on bool
+		if on, err = hold.On(); err == nil {
+			lom.SetLegalHold(on)
+		}
+	}
+	if err == nil {
+		err = lom.Persist()
+	}
+	if err != nil {
+		s3.WriteErr(w, r, err, errLockedCode(err))
+	}
+}
+
+func errObjLoadCode(err error) int {
+	if cmn.IsObjNotExist(err) {
+		return http.StatusNotFound
+	}
+	return 0
+}
@@ -194,6 +194,12 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if lom.Bprops().ObjLock.Enabled {
+		if err := t.objLockPrecheck(lom); err != nil { // (and again, under lock, when finalizing)
+			s3.WriteErr(w, r, err, errLockedCode(err))
+			return
+		}
+	}
 
 	// steps 1-...
 	var (
@@ -263,7 +269,10 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	lom.SetSize(size)
 	lom.SetCustomKey(cmn.ETag, objETag)
 	lom.SetCksum(actualMD5.Cksum.Clone())
-	t.FinalizeObj(lom, objWorkfile, nil) // locks inside
+	if errCode, err := t.FinalizeObj(lom, objWorkfile, nil, cmn.OwtFinalize); err != nil { // locks inside
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
 
 	// 6. mpt state => xattr
 	exists := s3.FinishUpload(uploadID, lom.FQN, false /*aborted*/)
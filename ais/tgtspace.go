@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/space"
@@ -28,6 +29,9 @@ const (
 	// - note that an API call (e.g. CLI) will go through anyway
 	// - compare with cmn/cos/oom.go
 	minAutoDetectInterval = 10 * time.Minute
+
+	// when disabled: check config.Tiering that often
+	tieringCheckIval = time.Minute
 )
 
 var (
@@ -141,3 +145,48 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 	})
 	return space.RunCleanup(&ini)
 }
+
+//
+// storage tiering (see mirror/tier.go): runs periodically when enabled and there are
+// both fast and slow mountpaths (see config.Tier)
+//
+
+func (t *target) regTiering() { hk.Reg(apc.ActTiering+hk.NameSuffix, t.tieringHK, tieringCheckIval) }
+
+func (t *target) tieringHK() time.Duration {
+	config := cmn.GCO.Get()
+	if !config.Tiering.Enabled {
+		return tieringCheckIval
+	}
+	if t.ClusterStarted() && fs.HasTiers() {
+		go t.runTiering("" /*uuid*/, nil /*wg*/)
+	}
+	return config.Tiering.Interval.D()
+}
+
+func (t *target) runTiering(id string, wg *sync.WaitGroup, bcks ...cmn.Bck) {
+	regToIC := id == ""
+	if regToIC {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewTiering(t, id, bcks)
+	if rns.Err != nil || rns.IsRunning() {
+		debug.Assert(rns.Err == nil || cmn.IsErrXactUsePrev(rns.Err))
+		if wg != nil {
+			wg.Done()
+		}
+		return
+	}
+	xtier := rns.Entry.Get()
+	if regToIC && xtier.ID() == id {
+		// pre-existing UUID: notify IC members
+		regMsg := xactRegMsg{UUID: id, Kind: apc.ActTiering, Srcs: []string{t.SID()}}
+		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
+		t.bcastAsyncIC(msg)
+	}
+	xtier.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xtier,
+	})
+	xtier.Run(wg)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/k8s"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/mirror"
@@ -117,6 +118,12 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 				t.writeErr(w, r, err)
 				return
 			}
+		} else if tcbmsg.Samples != nil {
+			var err error
+			if dp, err = shard.NewSamplesDP(tcbmsg.Samples, t.gmm); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
 		}
 		xid, err = t.tcb(c, tcbmsg, dp)
 	case apc.ActCopyObjects, apc.ActETLObjects:
@@ -134,12 +141,22 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 				t.writeErr(w, r, err)
 				return
 			}
+		} else if tcomsg.Samples != nil {
+			var err error
+			if dp, err = shard.NewSamplesDP(tcomsg.Samples, t.gmm); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
 		}
 		xid, err = t.tcobjs(c, tcomsg, dp)
 	case apc.ActECEncode:
 		xid, err = t.ecEncode(c)
 	case apc.ActArchive:
 		xid, err = t.createArchMultiObj(c)
+	case apc.ActInventory:
+		xid, err = t.inventory(c)
+	case apc.ActSyncBck:
+		xid, err = t.syncBck(c)
 	case apc.ActStartMaintenance, apc.ActDecommissionNode, apc.ActShutdownNode:
 		err = t.beginRm(c)
 	case apc.ActDestroyBck, apc.ActEvictRemoteBck:
@@ -847,6 +864,104 @@ func (t *target) createArchMultiObj(c *txnServerCtx) (string /*xaction uuid*/, e
 	return xid, nil
 }
 
+//
+// inventory: all targets (including those that receive remote pages from the designated
+// lister - see xs.XactInventory) are ready when the first one starts running
+//
+
+func (t *target) inventory(c *txnServerCtx) (string, error) {
+	switch c.phase {
+	case apc.ActBegin:
+		if err := c.bck.Init(t.owner.bmd); err != nil {
+			return "", err
+		}
+		invMsg := &cmn.InventoryMsg{}
+		if err := cos.MorphMarshal(c.msg.Value, invMsg); err != nil {
+			return "", fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, c.msg.Action, c.msg.Value, err)
+		}
+		if err := invMsg.Validate(c.bck.Bucket()); err != nil {
+			return "", err
+		}
+		bckTo := meta.CloneBck(&invMsg.ToBck)
+		if err := bckTo.Init(t.owner.bmd); err != nil {
+			return "", err
+		}
+		rns := xreg.RenewInventory(t, c.uuid, c.bck, &xreg.InvArgs{Msg: invMsg, BckTo: bckTo})
+		if rns.Err != nil {
+			nlog.Errorf("%s: %q %+v %v", t, c.uuid, invMsg, rns.Err)
+			return "", rns.Err
+		}
+		xinv := rns.Entry.Get().(*xs.XactInventory)
+		txn := newTxnInventory(c, xinv)
+		if err := t.transactions.begin(txn); err != nil {
+			xinv.TxnAbort(err)
+			return "", err
+		}
+	case apc.ActAbort:
+		t.transactions.find(c.uuid, apc.ActAbort)
+	case apc.ActCommit:
+		txn, err := t.transactions.find(c.uuid, apc.ActCommit)
+		if err != nil {
+			return "", err
+		}
+		xinv := txn.(*txnInventory).xinv
+		c.addNotif(xinv) // notify upon completion
+		go xinv.Run(nil)
+		return xinv.ID(), nil
+	default:
+		debug.Assert(false)
+	}
+	return "", nil
+}
+
+//
+// sync bucket: same as inventory (above) when either source or destination is remote
+//
+
+func (t *target) syncBck(c *txnServerCtx) (string, error) {
+	switch c.phase {
+	case apc.ActBegin:
+		if err := c.bck.Init(t.owner.bmd); err != nil {
+			return "", err
+		}
+		if c.bckTo == nil {
+			return "", fmt.Errorf("%s: %s destination is not specified", t, c.msg.Action)
+		}
+		if err := c.bckTo.Init(t.owner.bmd); err != nil {
+			return "", err
+		}
+		syncMsg := &apc.SyncBckMsg{}
+		if err := cos.MorphMarshal(c.msg.Value, syncMsg); err != nil {
+			return "", fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, c.msg.Action, c.msg.Value, err)
+		}
+		rns := xreg.RenewSyncBck(t, c.uuid, c.bck, &xreg.SyncArgs{Msg: syncMsg, BckTo: c.bckTo})
+		if rns.Err != nil {
+			nlog.Errorf("%s: %q %+v %v", t, c.uuid, syncMsg, rns.Err)
+			return "", rns.Err
+		}
+		xsync := rns.Entry.Get().(*xs.XactSyncBck)
+		txn := newTxnSyncBck(c, xsync)
+		if err := t.transactions.begin(txn); err != nil {
+			xsync.TxnAbort(err)
+			return "", err
+		}
+	case apc.ActAbort:
+		t.transactions.find(c.uuid, apc.ActAbort)
+	case apc.ActCommit:
+		txn, err := t.transactions.find(c.uuid, apc.ActCommit)
+		if err != nil {
+			return "", err
+		}
+		xsync := txn.(*txnSyncBck).xsync
+		c.addNotif(xsync) // notify upon completion
+		go xsync.Run(nil)
+		return xsync.ID(), nil
+	default:
+		debug.Assert(false)
+	}
+	return "", nil
+}
+
 //
 // begin (maintenance -- decommission -- shutdown) via p.beginRmTarget
 //
@@ -873,6 +988,13 @@ func (t *target) destroyBucket(c *txnServerCtx) error {
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return cmn.NewErrBusy("bucket", c.bck, "")
 		}
+		// (under bucket lock that also blocks new object locks until commit - see t.rlockBck)
+		if err := c.bck.Init(t.owner.bmd); err == nil {
+			if err = t.checkBckLocked(c.bck); err != nil {
+				nlp.Unlock()
+				return err
+			}
+		}
 		txn := newTxnBckBase(c.bck)
 		txn.fillFromCtx(c)
 		if err := t.transactions.begin(txn, nlp); err != nil {
@@ -185,6 +185,15 @@ func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *meta.Bck) erro
 		wg.Add(1)
 		go t.runStoreCleanup(args.ID, wg, args.Buckets...)
 		wg.Wait()
+	case apc.ActTiering:
+		bcks := args.Buckets
+		if bck != nil {
+			bcks = append(bcks, *bck.Bucket())
+		}
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go t.runTiering(args.ID, wg, bcks...)
+		wg.Wait()
 	case apc.ActResilver:
 		if bck != nil {
 			nlog.Errorf(erfmb, args.Kind, bck)
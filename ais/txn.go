@@ -126,6 +126,14 @@ type (
 		msg   *cmn.ArchiveBckMsg
 		txnBckBase
 	}
+	txnInventory struct {
+		xinv *xs.XactInventory
+		txnBckBase
+	}
+	txnSyncBck struct {
+		xsync *xs.XactSyncBck
+		txnBckBase
+	}
 	txnPromote struct {
 		msg    *cluster.PromoteArgs
 		xprm   *xs.XactDirPromote
@@ -147,6 +155,8 @@ var (
 	_ txn = (*txnTCB)(nil)
 	_ txn = (*txnTCObjs)(nil)
 	_ txn = (*txnECEncode)(nil)
+	_ txn = (*txnInventory)(nil)
+	_ txn = (*txnSyncBck)(nil)
 	_ txn = (*txnPromote)(nil)
 )
 
@@ -632,6 +642,48 @@ func (txn *txnArchMultiObj) String() string {
 	return txn.txnBckBase.String()
 }
 
+//////////////////
+// txnInventory //
+//////////////////
+
+func newTxnInventory(c *txnServerCtx, xinv *xs.XactInventory) (txn *txnInventory) {
+	txn = &txnInventory{xinv: xinv}
+	txn.init(c.bck)
+	txn.fillFromCtx(c)
+	return
+}
+
+func (txn *txnInventory) abort(err error) {
+	txn.unlock()
+	txn.xinv.TxnAbort(err)
+}
+
+func (txn *txnInventory) String() string {
+	txn.xctn = txn.xinv
+	return txn.txnBckBase.String()
+}
+
+////////////////
+// txnSyncBck //
+////////////////
+
+func newTxnSyncBck(c *txnServerCtx, xsync *xs.XactSyncBck) (txn *txnSyncBck) {
+	txn = &txnSyncBck{xsync: xsync}
+	txn.init(c.bck)
+	txn.fillFromCtx(c)
+	return
+}
+
+func (txn *txnSyncBck) abort(err error) {
+	txn.unlock()
+	txn.xsync.TxnAbort(err)
+}
+
+func (txn *txnSyncBck) String() string {
+	txn.xctn = txn.xsync
+	return txn.txnBckBase.String()
+}
+
 ////////////////
 // txnPromote //
 ////////////////
@@ -87,6 +87,14 @@ const (
 	AccessCluster = AceListBuckets | AceCreateBucket | AceDestroyBucket | AceMoveBucket | AceAdmin
 )
 
+// predefined roles (AuthN users and OIDC role rules)
+const (
+	AdminRole        = "Admin"
+	ClusterOwnerRole = "ClusterOwner"
+	BucketOwnerRole  = "BucketOwner"
+	GuestRole        = "Guest"
+)
+
 // verbs
 func SupportedPermissions() []string {
 	accList := []string{"ro", "rw", "su"}
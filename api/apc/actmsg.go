@@ -20,6 +20,7 @@ const (
 	ActResetBprops = "reset-bprops"
 
 	ActSummaryBck = "summary-bck"
+	ActInventory  = "inventory" // bucket inventory (see cmn.InventoryMsg)
 
 	ActECEncode  = "ec-encode" // erasure code a bucket
 	ActECGet     = "ec-get"    // read erasure coded objects
@@ -28,11 +29,13 @@ const (
 
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
+	ActSyncBck = "sync-bck" // see SyncBckMsg
 
 	ActETLInline = "etl-inline"
 
 	ActDsort    = "dsort"
 	ActDownload = "download"
+	ActBlobDl   = "blob-download" // see BlobMsg
 
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
@@ -46,6 +49,7 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActTiering      = "tiering"
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActInvalListCache = "inval-listobj-cache"
@@ -61,6 +65,7 @@ const (
 	ActSetConfig   = "set-config"
 
 	ActRotateLogs = "rotate-logs"
+	ActReloadCert = "reload-cert" // reload TLS certificate (and client CAs)
 
 	ActShutdownCluster = "shutdown" // see also: ActShutdownNode
 
@@ -70,11 +75,18 @@ const (
 	ActETLObjects      = "etl-listrange"
 	ActEvictObjects    = "evict-listrange"
 	ActPrefetchObjects = "prefetch-listrange"
-	ActArchive         = "archive" // see ArchiveMsg
+	ActPinObjects      = "pin-listrange"   // pin (cached) objects against eviction
+	ActUnpinObjects    = "unpin-listrange" // undo the above
+	ActArchive         = "archive"         // see ArchiveMsg
 
 	ActAttachRemAis = "attach"
 	ActDetachRemAis = "detach"
 
+	// scheduled jobs (see xact.Schedule)
+	ActAddSchedule = "add-schedule"
+	ActRmSchedule  = "rm-schedule"
+	ActRunSchedule = "run-schedule" // primary => proxies: updated run status
+
 	// Node maintenance & cluster membership (see also ActRmNodeUnsafe below)
 	ActStartMaintenance = "start-maintenance" // put into maintenance state
 	ActStopMaintenance  = "stop-maintenance"  // cancel maintenance state
@@ -0,0 +1,42 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"net/http"
+	"time"
+)
+
+// config.log.audit enum: categories of audited requests, each includes the previous one
+const (
+	AuditControl = "control" // control plane: cluster, node, and bucket operations that modify state
+	AuditWrites  = "writes"  // control plane and all object writes (PUT, DELETE, rename, etc.)
+	AuditAll     = "all"     // all client requests including reads
+)
+
+// a single line (JSON) in the audit log
+type AuditEntry struct {
+	Time     time.Time `json:"time"`
+	Node     string    `json:"node"`
+	User     string    `json:"user,omitempty"` // user (or service account) ID from the request's token
+	ClientIP string    `json:"client_ip"`
+	Method   string    `json:"method"`
+	Action   string    `json:"action,omitempty"` // apc.Act* (when carried by the request)
+	Path     string    `json:"path"`
+	Bucket   string    `json:"bucket,omitempty"`
+	Object   string    `json:"object,omitempty"`
+	Xid      string    `json:"xid,omitempty"` // xaction ID (if the operation started one)
+	Status   int       `json:"status"`
+}
+
+// operation: action or (if none) HTTP verb
+func (e *AuditEntry) Op() string {
+	if e.Action != "" {
+		return e.Action
+	}
+	return e.Method
+}
+
+func (e *AuditEntry) Failed() bool { return e.Status >= http.StatusBadRequest }
@@ -0,0 +1,13 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ActBlobDl: chunked (blob) download of a single large remote object.
+// Zero values default to the bucket's `blob_download` props (and, if those are unset,
+// to the system defaults).
+type BlobMsg struct {
+	ChunkSize  int64 `json:"chunk-size"`  // range read size
+	NumWorkers int   `json:"num-workers"` // concurrent range readers
+}
@@ -29,6 +29,7 @@ type (
 			OnDisk      uint64 `json:"size_on_disk,string"`          // sum(dir sizes) aka "apparent size"
 			PresentObjs uint64 `json:"size_all_present_objs,string"` // sum(cached object sizes)
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
+			PinnedObjs  uint64 `json:"size_pinned_objs,string"`      // sum(pinned object sizes), see ActPinObjects
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
 		UsedPct      uint64 `json:"used_pct"`
@@ -13,14 +13,21 @@ import (
 
 // Compression enum
 const (
-	CompressAlways = "always"
-	CompressNever  = "never"
+	CompressAlways   = "always"
+	CompressNever    = "never"
+	CompressAdaptive = "adaptive" // skip compressing (sampled or known by extension) incompressible objects
 )
 
-// sent via req.Header.Set(apc.HdrCompress, LZ4Compression)
-// (alternative to lz4 compressions upon popular request)
-const LZ4Compression = "lz4"
+// compression algorithms (see config.transport.compressor);
+// sent via req.Header.Set(apc.HdrCompress, LZ4Compression), etc.
+const (
+	LZ4Compression  = "lz4"
+	ZstdCompression = "zstd"
+)
 
-var SupportedCompression = []string{CompressNever, CompressAlways}
+var (
+	SupportedCompression = []string{CompressNever, CompressAlways, CompressAdaptive}
+	SupportedCompressors = []string{LZ4Compression, ZstdCompression}
+)
 
 func IsValidCompression(c string) bool { return c == "" || cos.StringInSlice(c, SupportedCompression) }
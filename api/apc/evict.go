@@ -0,0 +1,31 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "fmt"
+
+// eviction policy (enum and accessors)
+// bucket-configurable with global defaults via cluster config (see space/lru.go)
+type EvictPolicy string
+
+const (
+	EvictLRU  = EvictPolicy("lru")  // least recently used (default)
+	EvictLFU  = EvictPolicy("lfu")  // least frequently used (tracks per-object hit counts)
+	EvictSize = EvictPolicy("size") // size-weighted LRU: larger and less recently used first
+	EvictTTL  = EvictPolicy("ttl")  // objects cold-GET (or PUT) more than lru.ttl ago first, then by atime
+
+	EvictDefault = EvictPolicy("") // same as `EvictLRU`
+)
+
+var SupportedEvictPolicy = []string{string(EvictLRU), string(EvictLFU), string(EvictSize), string(EvictTTL)}
+
+func (ep EvictPolicy) IsLRU() bool { return ep == EvictDefault || ep == EvictLRU }
+
+func (ep EvictPolicy) Validate() (err error) {
+	if ep.IsLRU() || ep == EvictLFU || ep == EvictSize || ep == EvictTTL {
+		return
+	}
+	return fmt.Errorf("invalid eviction policy %q (expecting one of %v)", ep, SupportedEvictPolicy)
+}
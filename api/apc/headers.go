@@ -84,8 +84,9 @@ const (
 	HdrXactionID = HeaderPrefix + "xaction-id"
 
 	// Stream related headers.
-	HdrSessID   = HeaderPrefix + "session-id"
-	HdrCompress = HeaderPrefix + "compress" // LZ4Compression, etc.
+	HdrSessID       = HeaderPrefix + "session-id"
+	HdrCompress     = HeaderPrefix + "compress"      // LZ4Compression, etc.
+	HdrCompressMode = HeaderPrefix + "compress-mode" // CompressAdaptive (compressed and raw chunks)
 
 	// Promote(dir)
 	HdrPromoteNamesHash = HeaderPrefix + "promote-names-hash"
@@ -0,0 +1,11 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// bucket inventory (see cmn.InventoryMsg)
+const (
+	InvFormatCSV   = "csv"   // gzipped CSV (default)
+	InvFormatJSONL = "jsonl" // gzipped JSON lines
+)
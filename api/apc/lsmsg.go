@@ -71,6 +71,11 @@ const (
 	// - update AIS CLI to support non-recursive list-objects operation
 	// - when listing remote bucket, call backend (`Backend()`) to list non-recursively
 	LsNoRecursion
+
+	// Expand archives (shards) as WebDataset samples rather than files (requires LsArchDir).
+	// Sample is a group of archived files that share the same name sans extension
+	// (see also: SampleFilter)
+	LsArchSamples
 )
 
 // List objects default page size
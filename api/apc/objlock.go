@@ -0,0 +1,17 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// object lock (WORM) retention modes (see cmn.ObjLockConf)
+const (
+	// retention can be shortened or removed by privileged users (S3 "bypass governance retention")
+	RetentionGovernance = "governance"
+	// retention cannot be shortened or removed by anyone until it expires
+	RetentionCompliance = "compliance"
+)
+
+func IsValidRetention(mode string) bool {
+	return mode == RetentionGovernance || mode == RetentionCompliance
+}
@@ -70,10 +70,20 @@ const (
 	QparamLogOff  = "offset"
 	QparamAllLogs = "all"
 
+	// Get audit log: filters (see AuditEntry)
+	QparamAuditUser  = "user"
+	QparamAuditOp    = "op"
+	QparamAuditBck   = "bucket"
+	QparamAuditSince = "since" // RFC3339
+
 	// Archive filename and format (mime type)
 	QparamArchpath = "archpath"
 	QparamArchmime = "archmime"
 
+	// WebDataset sample (all archived files that share the same name sans extension)
+	// to GET as a TAR
+	QparamArchSample = "archsample"
+
 	// Skip loading existing object's metadata, in part to
 	// compare its Checksum and update its existing Version (if exists).
 	// Can be used to reduce PUT latency when:
@@ -140,6 +150,7 @@ const (
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return cluster info and status
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
+	QparamIsReplica        = "rpl" // true: DELETE (evict) cross-target replica (not to propagate any further)
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
 
@@ -147,6 +158,7 @@ const (
 	QparamTotalCompressedSize       = "tcs"
 	QparamTotalInputShardsExtracted = "tise"
 	QparamTotalUncompressedSize     = "tunc"
+	QparamResumePhase               = "rsm" // resume dsort job from a given phase
 
 	// 2PC transactions - control plane
 	QparamNetwTimeout  = "xnt" // [begin, start-commit] timeout
@@ -181,7 +193,8 @@ const (
 	WhatSysInfo    = "sysinfo"
 	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
 	// log
-	WhatLog = "log"
+	WhatLog      = "log"
+	WhatAuditLog = "audit"
 	// xactions
 	WhatOneXactStatus   = "status"      // IC status by uuid (returns a single matching xaction or none)
 	WhatAllXactStatus   = "status_all"  // ditto - all matching xactions
@@ -18,6 +18,18 @@ type (
 		Prefix  string `json:"prefix"`  // prefix to select matching _source_ objects or virtual directories
 		DryRun  bool   `json:"dry_run"` // visit all source objects, don't make any modifications
 		Force   bool   `json:"force"`   // force running in presence of "limited coexistence" type conflicts
+
+		// WebDataset: copy shards while dropping the samples that match (see SampleFilter)
+		Samples *SampleFilter `json:"samples,omitempty"`
+	}
+	// WebDataset sample is a group of archived files that share the same name sans extension,
+	// e.g. "a/0001.jpg", "a/0001.cls", and "a/0001.json".
+	// A sample matches when it has a file with one of the `Exts` extensions, or when its
+	// JSON sidecar (".json" file) contains `Field` equal to `Value` (any value when empty).
+	SampleFilter struct {
+		Exts  []string `json:"exts,omitempty"`  // e.g. [".png", ".cls.txt"]
+		Field string   `json:"field,omitempty"` // dot-separated path to the (nested) field, e.g. "meta.label"
+		Value string   `json:"value,omitempty"`
 	}
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
@@ -33,6 +45,24 @@ type (
 		Transform
 		CopyBckMsg
 	}
+	// sync destination bucket with the source: copy new and changed objects and, optionally,
+	// delete destination objects that are not present in the source
+	SyncBckMsg struct {
+		Prefix string `json:"prefix"`  // source and destination objects' name prefix (or virtual directory)
+		Delete bool   `json:"delete"`  // delete extraneous destination objects
+		DryRun bool   `json:"dry_run"` // compare only (see SyncBckStats), don't make any modifications
+	}
+	// sync-bck xaction's extended stats: the differences between the source and the destination
+	SyncBckStats struct {
+		NewCnt  int64 `json:"sync.new.n,string"`    // not present at the destination
+		NewSize int64 `json:"sync.new.size,string"` // ditto, total size
+		ModCnt  int64 `json:"sync.mod.n,string"`    // present but different (see sync-bck comparison rules)
+		ModSize int64 `json:"sync.mod.size,string"` // ditto
+		SameCnt int64 `json:"sync.same.n,string"`   // identical
+		DelCnt  int64 `json:"sync.del.n,string"`    // extraneous destination objects
+		DelSize int64 `json:"sync.del.size,string"` // ditto
+		DryRun  bool  `json:"sync.dry_run"`         // when true, the counts above are the diff that was found but not acted upon
+	}
 )
 
 ////////////
@@ -41,7 +71,13 @@ type (
 
 func (msg *TCBMsg) Validate(isEtl bool) (err error) {
 	if isEtl && msg.Transform.Name == "" {
-		err = errors.New("ETL name can't be empty")
+		return errors.New("ETL name can't be empty")
+	}
+	if msg.Samples != nil {
+		if isEtl {
+			return errors.New("filtering samples while transforming is not supported")
+		}
+		err = msg.Samples.Validate()
 	}
 	return
 }
@@ -61,3 +97,25 @@ func (msg *TCBMsg) ToName(name string) string {
 	}
 	return name
 }
+
+//////////////////
+// SampleFilter //
+//////////////////
+
+func (flt *SampleFilter) Validate() error {
+	if len(flt.Exts) == 0 && flt.Field == "" {
+		return errors.New("sample filter must specify extension(s) and/or JSON field")
+	}
+	if flt.Value != "" && flt.Field == "" {
+		return errors.New("sample filter: value " + flt.Value + " requires JSON field")
+	}
+	for i, ext := range flt.Exts {
+		if ext == "" || ext == "." {
+			return errors.New("sample filter: invalid empty extension")
+		}
+		if ext[0] != '.' {
+			flt.Exts[i] = "." + ext
+		}
+	}
+	return nil
+}
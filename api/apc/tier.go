@@ -0,0 +1,11 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// mountpath tiers (see cmn.MpathTierConf and cmn.TieringConf)
+const (
+	TierFast = "fast" // e.g., NVMe
+	TierSlow = "slow" // e.g., HDD (default)
+)
@@ -27,11 +27,13 @@ const (
 	Rebalance = "rebalance"
 	Xactions  = "xactions"
 	S3        = "s3"
+	Schedules = "schedules"
 	Txn       = "txn"      // 2PC
 	Notifs    = "notifs"   // intra-cluster notifications
 	Users     = "users"    // AuthN
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
+	Accounts  = "accounts" // AuthN service accounts
 	IC        = "ic"       // information center
 
 	// l3 ---
@@ -62,6 +64,8 @@ const (
 	Records     = "records"
 	Shards      = "shards"
 	FinishedAck = "finished_ack"
+	Checkpoint  = "checkpoint"
+	Resume      = "resume"
 	List        = "list"
 	Remove      = "remove"
 	Next        = "next"
@@ -143,11 +147,15 @@ var (
 	URLPathdSortMetrics = urlpath(Version, Sort, Metrics)
 	URLPathdSortAck     = urlpath(Version, Sort, FinishedAck)
 	URLPathdSortRemove  = urlpath(Version, Sort, Remove)
+	URLPathdSortCkpt    = urlpath(Version, Sort, Checkpoint)
+	URLPathdSortResume  = urlpath(Version, Sort, Resume)
 
 	URLPathDownload       = urlpath(Version, Download)
 	URLPathDownloadAbort  = urlpath(Version, Download, Abort)
 	URLPathDownloadRemove = urlpath(Version, Download, Remove)
 
+	URLPathSchedules = urlpath(Version, Schedules)
+
 	URLPathETL       = urlpath(Version, ETL)
 	URLPathETLObject = urlpath(Version, ETL, ETLObject)
 
@@ -155,6 +163,7 @@ var (
 	URLPathUsers    = urlpath(Version, Users)
 	URLPathClusters = urlpath(Version, Clusters)
 	URLPathRoles    = urlpath(Version, Roles)
+	URLPathAccounts = urlpath(Version, Accounts)
 )
 
 func (u URLPath) Join(words ...string) string {
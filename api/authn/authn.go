@@ -81,6 +81,28 @@ func LoginUser(bp api.BaseParams, userID, pass, clusterID string, expire *time.D
 	return token, nil
 }
 
+// Exchange service account's API key for a (short-lived) token.
+// If `expire` is `nil` the expiration time is set by AuthN (default: one hour).
+func LoginAPIKey(bp api.BaseParams, key, clusterID string, expire *time.Duration) (token *TokenMsg, err error) {
+	bp.Method = http.MethodPost
+	rec := APIKeyMsg{Key: key, ExpiresIn: expire, ClusterID: clusterID}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(rec)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&token); err != nil {
+		return nil, err
+	}
+	if token.Token == "" {
+		return nil, errors.New("login failed: empty response from AuthN server")
+	}
+	return token, nil
+}
+
 func RegisterCluster(bp api.BaseParams, cluSpec CluACL) error {
 	msg := cos.MustMarshal(cluSpec)
 	bp.Method = http.MethodPost
@@ -298,3 +320,64 @@ func SetConfig(bp api.BaseParams, conf *ConfigToUpdate) error {
 	}
 	return reqParams.DoRequest()
 }
+
+// Add a new service account. The returned account contains its API key -
+// the only time the key is shown (AuthN stores only its hash).
+func AddServiceAccount(bp api.BaseParams, sa *ServiceAccount) (*ServiceAccount, error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.S
+		reqParams.Body = cos.MustMarshal(sa)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	added := &ServiceAccount{}
+	_, err := reqParams.DoReqAny(added)
+	return added, err
+}
+
+// Delete service account and revoke its API key
+func DeleteServiceAccount(bp api.BaseParams, id string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(id)
+	}
+	return reqParams.DoRequest()
+}
+
+func GetServiceAccount(bp api.BaseParams, id string) (*ServiceAccount, error) {
+	if id == "" {
+		return nil, errors.New("missing service account ID")
+	}
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(id)
+	}
+	sa := &ServiceAccount{}
+	_, err := reqParams.DoReqAny(sa)
+	return sa, err
+}
+
+func GetAllServiceAccounts(bp api.BaseParams) ([]*ServiceAccount, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.S
+	}
+	accounts := make([]*ServiceAccount, 0)
+	_, err := reqParams.DoReqAny(&accounts)
+
+	less := func(i, j int) bool { return accounts[i].ID < accounts[j].ID }
+	sort.Slice(accounts, less)
+	return accounts, err
+}
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	AdminRole = "Admin"
+	AdminRole = apc.AdminRole
 )
 
 type (
@@ -33,6 +33,9 @@ type (
 	BckACL struct {
 		Bck    cmn.Bck         `json:"bck"`
 		Access apc.AccessAttrs `json:"perm,string"`
+		// when non-empty, the ACL applies only to the objects with names starting with the prefix
+		// (and overrides, for those objects, bucket-wide and cluster-wide permissions)
+		Prefix string `json:"prefix,omitempty"`
 	}
 	TokenMsg struct {
 		Token string `json:"token"`
@@ -53,6 +56,29 @@ type (
 		BucketACLs  []*BckACL `json:"buckets"`
 		IsAdmin     bool      `json:"admin"`
 	}
+
+	// Service account: named, long-lived, and revocable API key that belongs to a given user
+	// and gets exchanged for short-lived tokens (see APIKeyMsg).
+	// The permissions are a subset of the owner's: optionally masked (Access) and/or
+	// restricted to the specified buckets (in which case cluster-wide permissions do not apply
+	// to any other bucket).
+	ServiceAccount struct {
+		ID       string          `json:"id"`
+		Owner    string          `json:"owner"`
+		Desc     string          `json:"desc,omitempty"`
+		Buckets  []cmn.Bck       `json:"buckets,omitempty"`
+		Access   apc.AccessAttrs `json:"perm,string,omitempty"`
+		Created  time.Time       `json:"created"`
+		LastUsed time.Time       `json:"last_used"`
+		// API key is returned only once, upon creation (AuthN stores its hash)
+		Key     string `json:"key,omitempty"`
+		KeyHash string `json:"key_hash,omitempty"`
+	}
+	APIKeyMsg struct {
+		Key       string         `json:"key"`
+		ExpiresIn *time.Duration `json:"expires_in"`
+		ClusterID string         `json:"cluster_id"`
+	}
 )
 
 //////////
@@ -85,6 +111,12 @@ func (clu *CluACL) String() string {
 	return uuid
 }
 
+////////////
+// BckACL //
+////////////
+
+func (acl *BckACL) String() string { return acl.Bck.Cname(acl.Prefix) }
+
 //////////////
 // TokenMsg //
 //////////////
@@ -201,6 +201,32 @@ func CopyBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltP
 	return
 }
 
+// SyncBucket makes `bckTo` content the same as `bckFrom` (or its `msg.Prefix`-ed subset)
+// by copying new and modified objects (modified: different size, checksum, or version) and,
+// optionally, deleting destination objects that are not present in the source (`msg.Delete`).
+// Both buckets must exist. With `msg.DryRun` nothing gets copied or deleted - the job only
+// counts the differences and reports them as part of its (per-target) snapshots - see apc.SyncBckStats.
+// Returns xaction ID if successful, an error otherwise.
+func SyncBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.SyncBckMsg) (xid string, err error) {
+	if err = bckTo.Validate(); err != nil {
+		return
+	}
+	q := bckFrom.NewQuery()
+	_ = bckTo.AddUnameToQuery(q, apc.QparamBckTo)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bckFrom.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSyncBck, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
 // RenameBucket renames bckFrom as bckTo.
 // Returns xaction ID if successful, an error otherwise.
 func RenameBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) (xid string, err error) {
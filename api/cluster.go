@@ -305,6 +305,11 @@ func RotateClusterLogs(bp BaseParams) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActRotateLogs})
 }
 
+// all nodes: reload TLS certificate (and client CAs) - HTTPS only
+func ReloadClusterCert(bp BaseParams) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActReloadCert})
+}
+
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -5,10 +5,12 @@
 package api
 
 import (
+	"bufio"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster/meta"
@@ -16,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/stats"
+	jsoniter "github.com/json-iterator/go"
 )
 
 type GetLogInput struct {
@@ -25,6 +28,14 @@ type GetLogInput struct {
 	All      bool
 }
 
+// audit log filters (all optional)
+type GetAuditInput struct {
+	Since  time.Time
+	User   string
+	Op     string // action (apc.Act*) or HTTP verb
+	Bucket string // bucket's cname, e.g. "ais://abc"
+}
+
 // GetMountpaths given the direct public URL of the target, returns the target's mountpaths or error.
 func GetMountpaths(bp BaseParams, node *meta.Snode) (mpl *apc.MountpathList, err error) {
 	bp.Method = http.MethodGet
@@ -252,6 +263,52 @@ func GetDaemonLog(bp BaseParams, node *meta.Snode, args GetLogInput) (int64, err
 	return 0, err
 }
 
+// Returns audit log entries of a specific node (see config.log.audit) that match all the filters.
+func GetAuditLog(bp BaseParams, node *meta.Snode, args GetAuditInput) ([]*apc.AuditEntry, error) {
+	q := make(url.Values, 5)
+	q.Set(apc.QparamWhat, apc.WhatAuditLog)
+	if !args.Since.IsZero() {
+		q.Set(apc.QparamAuditSince, args.Since.Format(time.RFC3339Nano))
+	}
+	if args.User != "" {
+		q.Set(apc.QparamAuditUser, args.User)
+	}
+	if args.Op != "" {
+		q.Set(apc.QparamAuditOp, args.Op)
+	}
+	if args.Bucket != "" {
+		q.Set(apc.QparamAuditBck, args.Bucket)
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = q
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	body, err := reqParams.doReader()
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(body)
+
+	// JSON lines
+	var (
+		entries []*apc.AuditEntry
+		scanner = bufio.NewScanner(body)
+	)
+	for scanner.Scan() {
+		entry := &apc.AuditEntry{}
+		if err := jsoniter.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return entries, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
 // SetDaemonConfig, given key value pairs, sets the configuration accordingly for a specific node.
 func SetDaemonConfig(bp BaseParams, nodeID string, nvs cos.StrKVs, transient ...bool) error {
 	bp.Method = http.MethodPut
@@ -288,6 +345,11 @@ func RotateLogs(bp BaseParams, nodeID string) error {
 	return _putDaemon(bp, nodeID, apc.ActMsg{Action: apc.ActRotateLogs})
 }
 
+// reload node's TLS certificate (and client CAs) - HTTPS only
+func ReloadCert(bp BaseParams, nodeID string) error {
+	return _putDaemon(bp, nodeID, apc.ActMsg{Action: apc.ActReloadCert})
+}
+
 func _putDaemon(bp BaseParams, nodeID string, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -40,6 +40,20 @@ func AbortDsort(bp BaseParams, managerUUID string) error {
 	return err
 }
 
+// ResumeDsort resumes aborted job under the same ID, skipping already created output shards.
+func ResumeDsort(bp BaseParams, managerUUID string) (id string, err error) {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathdSortResume.S
+		reqParams.Query = url.Values{apc.QparamUUID: []string{managerUUID}}
+	}
+	_, err = reqParams.doReqStr(&id)
+	FreeRp(reqParams)
+	return
+}
+
 func RemoveDsort(bp BaseParams, managerUUID string) error {
 	bp.Method = http.MethodDelete
 	reqParams := AllocRp()
@@ -0,0 +1,82 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// MakeInventory starts generating inventory of the `bck` bucket: a complete listing
+// that all targets write in parallel into the destination bucket (`msg.ToBck`)
+// as gzipped chunks, along with (per-target) manifests.
+// See also: cmn.InventoryMsg, GetInventory, and InventoryNames.
+func MakeInventory(bp BaseParams, bck cmn.Bck, msg *cmn.InventoryMsg) (xid string, err error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	return dolr(bp, bck, apc.ActInventory, msg, q)
+}
+
+// GetInventory reads and merges per-target manifests of the inventory job `xid`
+// stored in the bucket `bck` under the virtual directory `dir`.
+// The inventory is complete when `manifest.Targets` equals the number of targets
+// in the cluster that ran the job.
+func GetInventory(bp BaseParams, bck cmn.Bck, dir, xid string) (*cmn.InventoryManifest, error) {
+	msg := &cmn.InventoryMsg{Dir: strings.Trim(dir, "/")}
+	lsmsg := &apc.LsoMsg{Prefix: msg.ObjDir(xid) + cmn.InvManifestPrefix, Props: apc.GetPropsName}
+	lst, err := ListObjects(bp, bck, lsmsg, ListArgs{})
+	if err != nil {
+		return nil, err
+	}
+	if len(lst.Entries) == 0 {
+		return nil, fmt.Errorf("inventory %q not found in %s", xid, bck.Cname(msg.ObjDir(xid)))
+	}
+	manifest := &cmn.InventoryManifest{}
+	for _, en := range lst.Entries {
+		r, err := GetObjectReader(bp, bck, en.Name, nil)
+		if err != nil {
+			return nil, err
+		}
+		var m cmn.InventoryManifest
+		err = jsoniter.NewDecoder(r).Decode(&m)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %v", bck.Cname(en.Name), err)
+		}
+		manifest.Merge(&m)
+	}
+	return manifest, nil
+}
+
+// ReadInventory reads inventory chunk (see `manifest.Chunks`) and calls back
+// for each listed object
+func ReadInventory(bp BaseParams, bck cmn.Bck, manifest *cmn.InventoryManifest, chunk string,
+	cb func(*cmn.InventoryEntry) error) error {
+	r, err := GetObjectReader(bp, bck, chunk, nil)
+	if err != nil {
+		return err
+	}
+	err = cmn.ReadInventory(r, manifest.Format, cb)
+	r.Close()
+	return err
+}
+
+// InventoryNames returns the names of all objects listed in a given inventory chunk -
+// e.g., to be used with CopyMultiObj, PrefetchList, and other list-range operations.
+// (Those operations do not read inventory chunks themselves - pass the returned names
+// as apc.ListRange.ObjNames, one chunk at a time.)
+func InventoryNames(bp BaseParams, bck cmn.Bck, manifest *cmn.InventoryManifest, chunk string) ([]string, error) {
+	names := make([]string, 0, 1024)
+	err := ReadInventory(bp, bck, manifest, chunk, func(e *cmn.InventoryEntry) error {
+		names = append(names, e.Name)
+		return nil
+	})
+	return names, err
+}
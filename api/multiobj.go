@@ -64,21 +64,62 @@ func DeleteRange(bp BaseParams, bck cmn.Bck, rng string) (string, error) {
 }
 
 // EvictList sends request to evict a list of objects from a remote bucket.
-func EvictList(bp BaseParams, bck cmn.Bck, fileslist []string) (string, error) {
+// Pinned objects (see PinList) are skipped unless `force` is specified.
+func EvictList(bp BaseParams, bck cmn.Bck, fileslist []string, force ...bool) (string, error) {
 	bp.Method = http.MethodDelete
 	q := bck.NewQuery()
+	if len(force) > 0 && force[0] {
+		q.Set(apc.QparamForce, "true")
+	}
 	msg := apc.ListRange{ObjNames: fileslist}
 	return dolr(bp, bck, apc.ActEvictObjects, msg, q)
 }
 
 // EvictRange sends request to evict a range of objects from a remote bucket.
-func EvictRange(bp BaseParams, bck cmn.Bck, rng string) (string, error) {
+// Pinned objects are skipped unless `force` is specified.
+func EvictRange(bp BaseParams, bck cmn.Bck, rng string, force ...bool) (string, error) {
 	bp.Method = http.MethodDelete
 	q := bck.NewQuery()
+	if len(force) > 0 && force[0] {
+		q.Set(apc.QparamForce, "true")
+	}
 	msg := apc.ListRange{Template: rng}
 	return dolr(bp, bck, apc.ActEvictObjects, msg, q)
 }
 
+// PinList sends request to pin a list of (cached) objects in a remote bucket,
+// so that neither LRU nor (non-forced) eviction will remove them.
+func PinList(bp BaseParams, bck cmn.Bck, fileslist []string) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	msg := apc.ListRange{ObjNames: fileslist}
+	return dolr(bp, bck, apc.ActPinObjects, msg, q)
+}
+
+// PinRange sends request to pin a range (or prefix) of cached objects in a remote bucket.
+func PinRange(bp BaseParams, bck cmn.Bck, rng string) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	msg := apc.ListRange{Template: rng}
+	return dolr(bp, bck, apc.ActPinObjects, msg, q)
+}
+
+// UnpinList sends request to unpin a list of objects in a remote bucket.
+func UnpinList(bp BaseParams, bck cmn.Bck, fileslist []string) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	msg := apc.ListRange{ObjNames: fileslist}
+	return dolr(bp, bck, apc.ActUnpinObjects, msg, q)
+}
+
+// UnpinRange sends request to unpin a range (or prefix) of objects in a remote bucket.
+func UnpinRange(bp BaseParams, bck cmn.Bck, rng string) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	msg := apc.ListRange{Template: rng}
+	return dolr(bp, bck, apc.ActUnpinObjects, msg, q)
+}
+
 // PrefetchList sends request to prefetch a list of objects from a remote bucket.
 func PrefetchList(bp BaseParams, bck cmn.Bck, fileslist []string) (string, error) {
 	bp.Method = http.MethodPost
@@ -95,7 +136,7 @@ func PrefetchRange(bp BaseParams, bck cmn.Bck, rng string) (string, error) {
 	return dolr(bp, bck, apc.ActPrefetchObjects, msg, q)
 }
 
-// multi-object list-range (delete, prefetch, evict, archive, copy, and etl)
+// multi-object list-range (delete, prefetch, evict, pin, archive, copy, and etl)
 func dolr(bp BaseParams, bck cmn.Bck, action string, msg any, q url.Values) (xid string, err error) {
 	reqParams := AllocRp()
 	{
@@ -391,7 +391,8 @@ func DeleteObject(bp BaseParams, bck cmn.Bck, object string) error {
 }
 
 // EvictObject evicts an object specified by bucket/object.
-func EvictObject(bp BaseParams, bck cmn.Bck, object string) error {
+// Fails with http.StatusConflict if the object is pinned, unless `force` is specified.
+func EvictObject(bp BaseParams, bck cmn.Bck, object string, force ...bool) error {
 	bp.Method = http.MethodDelete
 	actMsg := apc.ActMsg{Action: apc.ActEvictObjects, Name: cos.JoinWords(bck.Name, object)}
 	reqParams := AllocRp()
@@ -401,6 +402,9 @@ func EvictObject(bp BaseParams, bck cmn.Bck, object string) error {
 		reqParams.Body = cos.MustMarshal(actMsg)
 		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
 		reqParams.Query = bck.NewQuery()
+		if len(force) > 0 && force[0] {
+			reqParams.Query.Set(apc.QparamForce, "true")
+		}
 	}
 	err := reqParams.DoRequest()
 	FreeRp(reqParams)
@@ -567,6 +571,24 @@ func Promote(args *PromoteArgs) (xid string, err error) {
 	return
 }
 
+// BlobDownload cold-GETs a (large) remote object in chunks, via concurrent range reads.
+// Returns empty job ID when the object is already present in the cluster.
+// See also: bucket props `blob_download` (for the same, upon regular GET).
+func BlobDownload(bp BaseParams, bck cmn.Bck, objName string, msg *apc.BlobMsg) (xid string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActBlobDl, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
 // DoWithRetry executes `http-client.Do` and retries *retriable connection errors*,
 // such as "broken pipe" and "connection refused".
 // This function always closes the `reqArgs.BodR`, even in case of error.
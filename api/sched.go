@@ -0,0 +1,56 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Add new or update existing schedule (a named cron-like recurring job).
+// When updating, the schedule's run status is preserved.
+// See also: xact.Schedule
+func AddSchedule(bp BaseParams, job *xact.Schedule) error {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathSchedules.S
+		reqParams.Body = cos.MustMarshal(job)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+func RemoveSchedule(bp BaseParams, name string) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathSchedules.Join(name)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// Returns all schedules along with their respective run statuses
+func GetSchedules(bp BaseParams) (ss *xact.Schedules, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathSchedules.S
+	}
+	ss = &xact.Schedules{}
+	_, err = reqParams.DoReqAny(ss)
+	FreeRp(reqParams)
+	return
+}
@@ -36,6 +36,9 @@ type (
 		HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, errCode int, err error)
 		HeadObj(ctx context.Context, lom *LOM) (objAttrs *cmn.ObjAttrs, errCode int, err error)
 		GetObj(ctx context.Context, lom *LOM, owt cmn.OWT) (errCode int, err error)
-		GetObjReader(ctx context.Context, lom *LOM) GetReaderResult
+		// read the entire object (offset = length = 0) or its range;
+		// (when available) ExpCksum is the checksum of the entire object, Size - the size of what's being read;
+		// range reads are conditional on the lom's ETag and/or version (custom metadata), if present
+		GetObjReader(ctx context.Context, lom *LOM, offset, length int64) GetReaderResult
 	}
 )
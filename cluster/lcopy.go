@@ -7,6 +7,7 @@ package cluster
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -62,8 +63,12 @@ func (lom *LOM) isMirror(dst *LOM) bool {
 
 func (lom *LOM) delCopyMd(copyFQN string) {
 	delete(lom.md.copies, copyFQN)
+	if copyFQN == lom.md.tier {
+		lom.md.tier = ""
+	}
 	if len(lom.md.copies) <= 1 {
 		lom.md.copies = nil
+		lom.md.tier = ""
 	}
 }
 
@@ -115,7 +120,7 @@ func (lom *LOM) DelAllCopies() (err error) {
 }
 
 // DelExtraCopies deletes obj replicas that are not part of the lom.md.copies metadata
-// (cleanup)
+// (cleanup) - never the fast-tier replica (see CopyToFastTier)
 func (lom *LOM) DelExtraCopies(fqn ...string) (removed bool, err error) {
 	if lom.whingeCopy() {
 		return
@@ -123,7 +128,7 @@ func (lom *LOM) DelExtraCopies(fqn ...string) (removed bool, err error) {
 	availablePaths := fs.GetAvail()
 	for _, mi := range availablePaths {
 		copyFQN := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
-		if _, ok := lom.md.copies[copyFQN]; ok {
+		if _, ok := lom.md.copies[copyFQN]; ok || copyFQN == lom.md.tier {
 			continue
 		}
 		if err1 := cos.RemoveFile(copyFQN); err1 != nil {
@@ -291,11 +296,22 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 		for fqn, mpi := range lom.md.copies {
 			dst.md.copies[fqn] = mpi
 		}
+		dst.md.tier = lom.md.tier
 	}
 	if !dst.Bck().Equal(lom.Bck(), true /*same ID*/, true /*same backend*/) {
 		// The copy will be in a new bucket - completely separate object. Hence, we have to set initial version.
 		dst.SetVersion(lomInitialVersion)
 	}
+	if dst.Uname() != lom.Uname() {
+		// object lock is per object: a copy under a different name does not inherit it
+		// (and gets the destination bucket's default retention, if any)
+		dst.md.retainMode, dst.md.retainUntil, dst.md.legalHold = "", 0, false
+		if dst.Bprops().ObjLock.Enabled {
+			if err = dst.SetDefRetention(time.Now().UnixNano()); err != nil {
+				return
+			}
+		}
+	}
 
 	workFQN := fs.CSM.Gen(dst, fs.WorkfileType, fs.WorkfileCopy)
 	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, cksumType)
@@ -346,11 +362,16 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 	return
 }
 
-// load-balanced GET
+// load-balanced GET (fast-tier replica, if promoted, takes precedence)
 func (lom *LOM) LBGet() (fqn string) {
 	if !lom.HasCopies() {
 		return lom.FQN
 	}
+	if lom.md.tier != "" {
+		if _, ok := lom.md.copies[lom.md.tier]; ok {
+			return lom.md.tier
+		}
+	}
 	return lom.leastUtilCopy()
 }
 
@@ -375,7 +396,9 @@ func (lom *LOM) leastUtilCopy() (fqn string) {
 
 // returns the least utilized mountpath that does _not_ have a copy of this `lom` yet
 // (compare with leastUtilCopy())
-func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) {
+func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) { return lom.leastUtilNoCopy(false) }
+
+func (lom *LOM) leastUtilNoCopy(fast bool) (mi *fs.Mountpath) {
 	var (
 		availablePaths = fs.GetAvail()
 		mpathUtils     = fs.GetAllMpathUtils()
@@ -385,6 +408,9 @@ func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) {
 		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
 			continue
 		}
+		if fast && !mpathInfo.IsFast() {
+			continue
+		}
 		if util := mpathUtils.Get(mpath); util < minUtil {
 			minUtil, mi = util, mpathInfo
 		}
@@ -443,3 +469,55 @@ func (lom *LOM) ToMpath() (mi *fs.Mountpath, isHrw bool) {
 	mi = lom.LeastUtilNoCopy() // NOTE: nil when not enough mountpaths
 	return
 }
+
+//
+// storage tiering: fast-tier replica of a slow-tier object (see cmn.TieringConf)
+// - the object itself remains at its HRW location (see fs.Hrw), while
+// - md.tier points to one of its copies (and is persisted along with the latter)
+//
+
+// returns fast-tier replica, if promoted
+func (lom *LOM) TierFQN() string { return lom.md.tier }
+
+// promote: replicate the object to the least utilized fast-tier mountpath
+// must be called under w-lock
+func (lom *LOM) CopyToFastTier(buf []byte) error {
+	debug.Assert(lom.md.tier == "" && !lom.mi.IsFast(), lom.String())
+	mi := lom.leastUtilNoCopy(true)
+	if mi == nil {
+		return fmt.Errorf("%s: no fast-tier mountpath to promote to", lom)
+	}
+	lom.md.tier = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	if err := lom.Copy(mi, buf); err != nil {
+		lom.md.tier = ""
+		return err
+	}
+	lom.uncacheCopy(lom.md.tier)
+	return nil
+}
+
+// demote: remove fast-tier replica and reset the object's hit count
+// must be called under w-lock; the caller must persist
+func (lom *LOM) DelFastTierCopy() error {
+	tfqn := lom.md.tier
+	if tfqn == "" {
+		return nil
+	}
+	lom.md.hits = 0
+	if _, ok := lom.md.copies[tfqn]; !ok {
+		lom.md.tier = ""
+		return nil
+	}
+	err := lom.DelCopies(tfqn)
+	lom.uncacheCopy(tfqn)
+	return err
+}
+
+// the copy's metadata cached at its own mountpath (if any) is stale
+func (lom *LOM) uncacheCopy(copyFQN string) {
+	cplom := AllocLOM(lom.ObjName)
+	if err := cplom.InitFQN(copyFQN, lom.Bucket()); err == nil {
+		cplom.Uncache()
+	}
+	FreeLOM(cplom)
+}
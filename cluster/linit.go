@@ -141,6 +141,7 @@ func (lom *LOM) CloneMD(fqn string) *LOM {
 	dst.md = lom.md
 	dst.md.bckID = 0
 	dst.md.copies = nil
+	dst.md.tier = ""
 	dst.FQN = fqn
 	return dst
 }
@@ -51,6 +51,14 @@ type (
 		cmn.ObjAttrs
 		atimefs uint64 // NOTE: high bit is reserved for `dirty`
 		bckID   uint64
+		hits    uint64 // num GETs (tracked only with lru.policy == apc.EvictLFU or tiering enabled)
+		coldGet int64  // unix nano of the last cold GET or PUT (recorded only with lru.policy == apc.EvictTTL)
+		pinned  bool   // never evicted (see apc.ActPinObjects)
+		tier    string // fast-tier replica (one of the copies) when promoted (see cmn.TieringConf)
+		// object lock (see cmn.ObjLockConf and lretain.go)
+		retainUntil int64  // unix nano
+		retainMode  string // apc.RetentionGovernance | apc.RetentionCompliance
+		legalHold   bool
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -143,6 +151,22 @@ func (lom *LOM) Atime() time.Time      { return time.Unix(0, lom.md.Atime) }
 func (lom *LOM) AtimeUnix() int64      { return lom.md.Atime }
 func (lom *LOM) SetAtimeUnix(tu int64) { lom.md.Atime = tu }
 
+// access frequency (see apc.EvictLFU); persisted when flushing cold metadata (lcache_hk.go)
+// NOTE:
+//   - hits never decay: objects that were hot once stay ahead of the recently popular ones
+//   - the increment is not atomic (GETs hold read lock), and so concurrent GETs may undercount
+func (lom *LOM) Hits() uint64 { return lom.md.hits }
+func (lom *LOM) IncHits()     { lom.md.hits++; lom.md.makeDirty() }
+
+// time of the last cold GET or PUT (see apc.EvictTTL); unlike mtime, is not
+// affected by mirroring, resilvering, and tiering (that copy the metadata)
+func (lom *LOM) ColdGetUnix() int64      { return lom.md.coldGet }
+func (lom *LOM) SetColdGetUnix(tu int64) { lom.md.coldGet = tu }
+
+// pinned objects are skipped by LRU and (non-forced) evictions; caller must persist
+func (lom *LOM) IsPinned() bool     { return lom.md.pinned }
+func (lom *LOM) SetPinned(pin bool) { lom.md.pinned = pin }
+
 // custom metadata
 func (lom *LOM) GetCustomMD() cos.StrKVs   { return lom.md.GetCustomMD() }
 func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
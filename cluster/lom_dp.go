@@ -74,7 +74,7 @@ func (*LDP) Reader(lom *LOM) (cos.ReadOpenCloser, cos.OAH, error) {
 		Cksum: cos.NoneCksum, // will likely reassign (below)
 		Atime: lom.AtimeUnix(),
 	}
-	res := g.t.Backend(lom.Bck()).GetObjReader(context.Background(), lom)
+	res := g.t.Backend(lom.Bck()).GetObjReader(context.Background(), lom, 0, 0)
 
 	if lom.Checksum() != nil {
 		oah.Cksum = lom.Checksum()
@@ -992,6 +992,63 @@ var _ = Describe("LOM", func() {
 				Expect(lom.GetCopies()).To(BeNil())
 			})
 		})
+
+		Describe("fast-tier replica", func() {
+			It("should promote and demote the object", func() {
+				lom := prepareLOM(copyFQNs[0])
+				for _, mi := range fs.GetAvail() {
+					if mi.Path != lom.Mountpath().Path {
+						mi.Tier = apc.TierFast
+					}
+				}
+				defer func() {
+					for _, mi := range fs.GetAvail() {
+						mi.Tier = apc.TierSlow
+					}
+				}()
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.CopyToFastTier(make([]byte, testFileSize))).NotTo(HaveOccurred())
+				tfqn := lom.TierFQN()
+				Expect(tfqn).NotTo(BeEmpty())
+				Expect(tfqn).To(BeARegularFile())
+				Expect(getTestFileHash(tfqn)).To(Equal(getTestFileHash(lom.FQN)))
+				Expect(lom.IsCopy()).To(BeFalse())
+				Expect(lom.GetCopies()).To(And(HaveKey(lom.FQN), HaveKey(tfqn)))
+				Expect(lom.LBGet()).To(Equal(tfqn))
+
+				mi, _, err := fs.FQN2Mpath(tfqn)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mi.IsFast()).To(BeTrue())
+
+				// space cleanup must keep it
+				_, err = lom.DelExtraCopies()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tfqn).To(BeARegularFile())
+
+				// reload and check that the (explicit) location pointer persists
+				lom2 := NewBasicLom(lom.FQN)
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.TierFQN()).To(Equal(tfqn))
+				copyLOM := NewBasicLom(tfqn)
+				Expect(copyLOM.Load(false, true)).NotTo(HaveOccurred())
+				Expect(copyLOM.IsCopy()).To(BeTrue())
+				Expect(copyLOM.TierFQN()).To(Equal(tfqn))
+
+				// demote
+				Expect(lom.DelFastTierCopy()).NotTo(HaveOccurred())
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				Expect(tfqn).NotTo(BeAnExistingFile())
+				Expect(lom.TierFQN()).To(BeEmpty())
+				Expect(lom.LBGet()).To(Equal(lom.FQN))
+
+				lom2 = NewBasicLom(lom.FQN)
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.TierFQN()).To(BeEmpty())
+				Expect(lom2.HasCopies()).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("local and cloud bucket with the same name", func() {
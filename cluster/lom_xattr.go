@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -58,6 +59,16 @@ const (
 	lomObjSize
 	lomObjCopies
 	lomCustomMD
+
+	// extension records (cmn.MetaverLOM v2):
+	// - written only when set, so that objects without extensions remain v1 (readable by older binaries)
+	// - unknown extension records (added by a newer version) are skipped
+	lomObjHits   // access count (when non-zero)
+	lomObjPinned // pinned against eviction
+	lomObjTier   // fast-tier replica FQN (when promoted)
+	lomObjRetain // object lock retention: "<mode>,<retain-until>"
+	lomObjHold   // object lock legal hold
+	lomObjCold   // time of the last cold GET or PUT (lru.policy = ttl)
 )
 
 // packing format separators
@@ -68,7 +79,7 @@ const (
 	lenRecSepa   = len(recordSepa)
 )
 
-const prefLen = 10 // 10B prefix [ version = 1 | checksum-type | 64-bit xxhash ]
+const prefLen = 10 // 10B prefix [ version (cmn.MetaverLOM*) | checksum-type | 64-bit xxhash ]
 
 const getxattr = "getxattr" // syscall
 
@@ -283,11 +294,12 @@ func (md *lmeta) clearDirty()   { md.atimefs &= ^lomDirtyMask }
 func (md *lmeta) isDirty() bool { return md.atimefs&lomDirtyMask == lomDirtyMask }
 
 func (md *lmeta) pushrt() []uint64 {
-	return []uint64{uint64(md.Atime), md.atimefs, md.bckID}
+	return []uint64{uint64(md.Atime), md.atimefs, md.bckID, md.hits}
 }
 
 func (md *lmeta) poprt(saved []uint64) {
 	md.Atime, md.atimefs, md.bckID = int64(saved[0]), saved[1], saved[2]
+	md.hits = max(md.hits, saved[3]) // (in-memory may be ahead of persisted)
 }
 
 func (md *lmeta) unmarshal(buf []byte) error {
@@ -303,7 +315,7 @@ func (md *lmeta) unmarshal(buf []byte) error {
 	if len(buf) < prefLen {
 		return fmt.Errorf("%s: too short (%d)", invalid, len(buf))
 	}
-	if buf[0] != cmn.MetaverLOM {
+	if buf[0] != cmn.MetaverLOM && buf[0] != cmn.MetaverLOMv1 {
 		return fmt.Errorf("%s: unknown version %d", invalid, buf[0])
 	}
 	if buf[1] != mdCksumTyXXHash {
@@ -316,6 +328,9 @@ func (md *lmeta) unmarshal(buf []byte) error {
 		return cos.NewErrMetaCksum(expectedCksum, actualCksum, md.String())
 	}
 
+	md.pinned, md.tier = false, "" // (written only when set)
+	md.retainMode, md.retainUntil, md.legalHold = "", 0, false
+	md.coldGet = 0
 	for off := 0; !last; {
 		var (
 			record string
@@ -388,8 +403,41 @@ func (md *lmeta) unmarshal(buf []byte) error {
 				custom[entries[i]] = entries[i+1]
 			}
 			md.SetCustomMD(custom)
+		case lomObjHits:
+			hits, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				return errors.New(invalid + " #5.2")
+			}
+			md.hits = hits
+		case lomObjPinned:
+			md.pinned = true
+		case lomObjTier:
+			if val == "" {
+				return errors.New(invalid + " #5.3")
+			}
+			md.tier = val
+		case lomObjRetain:
+			i := strings.IndexByte(val, ',')
+			if i <= 0 {
+				return errors.New(invalid + " #5.4")
+			}
+			until, err := strconv.ParseInt(val[i+1:], 10, 64)
+			if err != nil {
+				return errors.New(invalid + " #5.5")
+			}
+			md.retainMode, md.retainUntil = val[:i], until
+		case lomObjHold:
+			md.legalHold = true
+		case lomObjCold:
+			tu, err := strconv.ParseInt(val, 10, 64)
+			if err != nil {
+				return errors.New(invalid + " #5.6")
+			}
+			md.coldGet = tu
 		default:
-			return errors.New(invalid + " #6")
+			if buf[0] == cmn.MetaverLOMv1 || key < lomObjHits {
+				return errors.New(invalid + " #6")
+			}
 		}
 	}
 	if haveCksumType != haveCksumValue {
@@ -406,6 +454,7 @@ func (md *lmeta) marshal(mdSize int64) (buf []byte) {
 	var (
 		b8                    [cos.SizeofI64]byte
 		cksumType, cksumValue = md.Cksum.Get()
+		metaver               = byte(cmn.MetaverLOMv1)
 	)
 	buf, _ = g.smm.AllocSize(mdSize)
 	buf = buf[:prefLen] // hold it for md-xattr checksum (below)
@@ -428,9 +477,36 @@ func (md *lmeta) marshal(mdSize int64) (buf []byte) {
 		buf = _marshRecord(buf, lomCustomMD, "", false)
 		buf = _marshCustomMD(buf, custom)
 	}
+	if md.hits > 0 || md.pinned || md.tier != "" || md.retainMode != "" || md.legalHold || md.coldGet != 0 {
+		metaver = cmn.MetaverLOM
+	}
+	if md.hits > 0 {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomObjHits, strconv.FormatUint(md.hits, 10), false)
+	}
+	if md.pinned {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomObjPinned, "", false)
+	}
+	if md.tier != "" {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomObjTier, md.tier, false)
+	}
+	if md.retainMode != "" {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomObjRetain, md.retainMode+","+strconv.FormatInt(md.retainUntil, 10), false)
+	}
+	if md.legalHold {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomObjHold, "", false)
+	}
+	if md.coldGet != 0 {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomObjCold, strconv.FormatInt(md.coldGet, 10), false)
+	}
 
 	// checksum, prepend, and return
-	buf[0] = cmn.MetaverLOM
+	buf[0] = metaver
 	buf[1] = mdCksumTyXXHash
 	mdCksumValue := xxhash.Checksum64S(buf[prefLen:], cos.MLCG32)
 	binary.BigEndian.PutUint64(buf[2:], mdCksumValue)
@@ -482,7 +558,7 @@ func _marshCustomMD(buf []byte, md cos.StrKVs) []byte {
 	return buf
 }
 
-// copy atime IFF valid and more recent
+// copy atime IFF valid and more recent (and the greater of the two hit counts)
 func (md *lmeta) cpAtime(from *lmeta) {
 	if !cos.IsValidAtime(from.Atime) {
 		return
@@ -490,4 +566,5 @@ func (md *lmeta) cpAtime(from *lmeta) {
 	if !cos.IsValidAtime(md.Atime) || (md.Atime > 0 && md.Atime < from.Atime) {
 		md.Atime = from.Atime
 	}
+	md.hits = max(md.hits, from.hits)
 }
@@ -5,7 +5,9 @@
 package cluster_test
 
 import (
+	"encoding/binary"
 	"os"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
@@ -14,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/OneOfOne/xxhash"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -280,6 +283,134 @@ var _ = Describe("LOM Xattributes", func() {
 				Expect(lom1.GetCopies()).To(BeEquivalentTo(lom2.GetCopies()))
 			})
 
+			It("should read hit count from fs", func() {
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom2 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				for i := 0; i < 3; i++ {
+					lom1.IncHits()
+				}
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				err := lom2.LoadMetaFromFS()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lom2.Hits()).To(Equal(uint64(3)))
+				Expect(lom1.Checksum()).To(BeEquivalentTo(lom2.Checksum()))
+			})
+
+			It("should read pinned state from fs", func() {
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom2 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				lom1.SetPinned(true)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				err := lom2.LoadMetaFromFS()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lom2.IsPinned()).To(BeTrue())
+
+				lom1.SetPinned(false)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.IsPinned()).To(BeFalse())
+			})
+
+			It("should read cold GET time from fs", func() {
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom2 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				tu := time.Now().Add(-time.Hour).UnixNano()
+				lom1.SetColdGetUnix(tu)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				err := lom2.LoadMetaFromFS()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lom2.ColdGetUnix()).To(Equal(tu))
+
+				lom1.SetColdGetUnix(0)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.ColdGetUnix()).To(BeZero())
+			})
+
+			It("should read object lock state from fs and enforce it", func() {
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom2 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				until := time.Now().Add(time.Hour).UnixNano()
+				Expect(lom1.SetRetention(apc.RetentionGovernance, until, false)).NotTo(HaveOccurred())
+				lom1.SetLegalHold(true)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				mode, tu := lom2.Retention()
+				Expect(mode).To(Equal(apc.RetentionGovernance))
+				Expect(tu).To(Equal(until))
+				Expect(lom2.LegalHold()).To(BeTrue())
+				Expect(cmn.IsErrObjLocked(lom2.CheckLocked(true /*bypass governance*/))).To(BeTrue())
+				// (new version of the object that is about to be written)
+				Expect(cmn.IsErrObjLocked(NewBasicLom(localFQN).CheckLockedPut())).To(BeTrue())
+
+				lom1.SetLegalHold(false)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.LegalHold()).To(BeFalse())
+				Expect(cmn.IsErrObjLocked(lom2.CheckLocked(false))).To(BeTrue())
+				Expect(lom2.CheckLocked(true /*bypass governance*/)).NotTo(HaveOccurred())
+
+				// governance: shorten only when bypassing; compliance: extend only
+				Expect(cmn.IsErrObjLocked(lom2.SetRetention(apc.RetentionGovernance, until-1, false))).To(BeTrue())
+				Expect(lom2.SetRetention(apc.RetentionCompliance, until+1, false)).NotTo(HaveOccurred())
+				Expect(cmn.IsErrObjLocked(lom2.SetRetention(apc.RetentionGovernance, until+2, true))).To(BeTrue())
+				Expect(cmn.IsErrObjLocked(lom2.SetRetention("", 0, true))).To(BeTrue())
+				Expect(cmn.IsErrObjLocked(lom2.CheckLocked(true))).To(BeTrue())
+			})
+
+			It("should write extension records only with v2 and skip unknown ones", func() {
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom2 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				b, err := fs.GetXattr(localFQN, cluster.XattrLOM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b[0]).To(BeEquivalentTo(cmn.MetaverLOMv1))
+
+				lom1.IncHits()
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				b, err = fs.GetXattr(localFQN, cluster.XattrLOM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b[0]).To(BeEquivalentTo(cmn.MetaverLOM))
+
+				// append a record unknown to this version
+				unknown := append([]byte("\xe3/\xbd"), 0, 200)
+				unknown = append(unknown, "future"...)
+				b = append(b, unknown...)
+				binary.BigEndian.PutUint64(b[2:], xxhash.Checksum64S(b[10:], cos.MLCG32))
+				Expect(fs.SetXattr(localFQN, cluster.XattrLOM, b)).NotTo(HaveOccurred())
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.Hits()).To(Equal(uint64(1)))
+
+				// but not in v1
+				b[0] = cmn.MetaverLOMv1
+				Expect(fs.SetXattr(localFQN, cluster.XattrLOM, b)).NotTo(HaveOccurred())
+				Expect(lom2.LoadMetaFromFS()).To(MatchError("invalid lmeta #6"))
+			})
+
 			Describe("error cases", func() {
 				var lom *cluster.LOM
 
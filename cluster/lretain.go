@@ -0,0 +1,94 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"errors"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object lock (WORM): retention and legal hold (see cmn.ObjLockConf).
+// An object that is under legal hold or (not yet expired) retention cannot be
+// overwritten, deleted, renamed, or evicted. In all cases, caller must persist.
+
+func (lom *LOM) Retention() (mode string, until int64) { return lom.md.retainMode, lom.md.retainUntil }
+
+func (lom *LOM) LegalHold() bool      { return lom.md.legalHold }
+func (lom *LOM) SetLegalHold(on bool) { lom.md.legalHold = on }
+
+// empty mode (and zero `until`) removes retention; otherwise:
+// - retention can be always extended (including governance => compliance);
+// - governance retention can be shortened or removed only when bypassing governance;
+// - compliance retention cannot be shortened or removed until it expires
+func (lom *LOM) SetRetention(mode string, until int64, bypassGovernance bool) error {
+	now := time.Now().UnixNano()
+	if mode != "" {
+		if !apc.IsValidRetention(mode) {
+			return errors.New("invalid retention mode \"" + mode + "\"")
+		}
+		if until <= now {
+			return errors.New("retain-until date must be in the future")
+		}
+	} else {
+		until = 0
+	}
+	if lom.md.retainUntil > now {
+		weaker := until < lom.md.retainUntil || (lom.md.retainMode == apc.RetentionCompliance && mode != apc.RetentionCompliance)
+		if weaker && (lom.md.retainMode == apc.RetentionCompliance || !bypassGovernance) {
+			return cmn.NewErrObjLocked(lom.Cname(), lom._retention())
+		}
+	}
+	lom.md.retainMode, lom.md.retainUntil = mode, until
+	return nil
+}
+
+// returns cmn.ErrObjLocked when the object cannot be modified or removed
+func (lom *LOM) CheckLocked(bypassGovernance bool) error {
+	if lom.md.legalHold {
+		return cmn.NewErrObjLocked(lom.Cname(), "legal hold")
+	}
+	if lom.md.retainUntil <= time.Now().UnixNano() {
+		return nil
+	}
+	if bypassGovernance && lom.md.retainMode == apc.RetentionGovernance {
+		return nil
+	}
+	return cmn.NewErrObjLocked(lom.Cname(), lom._retention())
+}
+
+func (lom *LOM) _retention() string {
+	return lom.md.retainMode + " retention until " + cos.FormatNanoTime(lom.md.retainUntil, time.RFC3339)
+}
+
+// the object is about to be written (overwritten, appended to); caller must hold the write lock
+// (compare with CheckLocked above - this one loads the existing object's metadata, if any)
+func (lom *LOM) CheckLockedPut() error {
+	existing := AllocLOM(lom.ObjName)
+	defer FreeLOM(existing)
+	if err := existing.InitBck(lom.Bucket()); err != nil {
+		return err
+	}
+	if err := existing.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return existing.CheckLocked(false)
+}
+
+// new version of the object gets the bucket's default retention unless already specified
+// (e.g., via S3 headers) and not expired
+func (lom *LOM) SetDefRetention(now int64) error {
+	conf := &lom.Bprops().ObjLock
+	if _, until := lom.Retention(); until > now || conf.Mode == "" {
+		return nil
+	}
+	return lom.SetRetention(conf.Mode, now+conf.Retention.D().Nanoseconds(), false)
+}
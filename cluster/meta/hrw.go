@@ -17,6 +17,7 @@ import (
 
 // A variant of consistent hash based on rendezvous algorithm by Thaler and Ravishankar,
 // aka highest random weight (HRW)
+// Optionally, weighted - see Snode.Weight and cos.HrwWeighted
 // See also: fs/hrw.go
 
 var robin atomic.Uint64 // round
@@ -49,7 +50,7 @@ func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 		if tsi.InMaintOrDecomm() { // always skipping targets 'in maintenance mode'
 			continue
 		}
-		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
+		cs := smap.tscore(tsi, digest)
 		if cs >= max {
 			max = cs
 			si = tsi
@@ -65,7 +66,7 @@ func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 func (smap *Smap) HrwHash2Tall(digest uint64) (si *Snode, err error) {
 	var max uint64
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
+		cs := smap.tscore(tsi, digest)
 		if cs >= max {
 			max = cs
 			si = tsi
@@ -77,6 +78,16 @@ func (smap *Smap) HrwHash2Tall(digest uint64) (si *Snode, err error) {
 	return si, err
 }
 
+// target's HRW score for a given digest - weighted when all (active) targets
+// have weights (see Smap.InitDigests), otherwise the hash itself
+func (smap *Smap) tscore(tsi *Snode, digest uint64) uint64 {
+	cs := xoshiro256.Hash(tsi.Digest() ^ digest)
+	if smap.weighted {
+		return cos.HrwWeighted(cs, tsi.Weight)
+	}
+	return cs
+}
+
 func (smap *Smap) HrwProxy(idToSkip string) (pi *Snode, err error) {
 	var max uint64
 	for pid, psi := range smap.Pmap {
@@ -159,6 +170,11 @@ type hrwList struct {
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+//
+// When targets are labeled with failure domains (Snode.Domain), the selection is
+// spread across domains: round-robin in the HRW order, one target per domain per round.
+// The first target is always the same as the one returned by HrwName2T, and
+// the resulting order is prefix-stable (the list of `n` is a prefix of the list of `n+1`).
 
 func (smap *Smap) HrwTargetList(uname string, count int) (sis Nodes, err error) {
 	const fmterr = "%v: required %d, available %d, %s"
@@ -167,17 +183,30 @@ func (smap *Smap) HrwTargetList(uname string, count int) (sis Nodes, err error)
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, cnt, smap)
 		return
 	}
-	digest := xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+	var (
+		digest  = xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+		domains bool
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.Domain != "" && !tsi.InMaintOrDecomm() {
+			domains = true
+			break
+		}
+	}
 	hlist := newHrwList(count)
-
+	if domains {
+		hlist.n = cnt // all (to spread below)
+	}
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
 		if tsi.InMaintOrDecomm() {
 			continue
 		}
-		hlist.add(cs, tsi)
+		hlist.add(smap.tscore(tsi, digest), tsi)
 	}
 	sis = hlist.get()
+	if domains {
+		sis = spreadDomains(sis, count)
+	}
 	if count != cnt && len(sis) < count {
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, len(sis), smap)
 		return nil, err
@@ -185,6 +214,39 @@ func (smap *Smap) HrwTargetList(uname string, count int) (sis Nodes, err error)
 	return sis, nil
 }
 
+// reorder HRW-sorted targets round-robin across failure domains, and truncate;
+// unlabeled target is a domain of its own
+func spreadDomains(sorted Nodes, count int) Nodes {
+	var (
+		ranks = make(map[string]int, len(sorted))
+		order = make([]int, len(sorted)) // rank within the respective domain
+	)
+	for i, tsi := range sorted {
+		domain := tsi.Domain
+		if domain == "" {
+			domain = tsi.ID()
+		}
+		order[i] = ranks[domain]
+		ranks[domain]++
+	}
+	sis := make(Nodes, 0, min(count, len(sorted)))
+	for round := 0; len(sis) < count; round++ {
+		n := len(sis)
+		for i, tsi := range sorted {
+			if order[i] == round {
+				sis = append(sis, tsi)
+				if len(sis) == count {
+					break
+				}
+			}
+		}
+		if len(sis) == n {
+			break
+		}
+	}
+	return sis
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
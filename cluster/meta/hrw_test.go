@@ -0,0 +1,133 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HrwTargetList", func() {
+	// `perDomain` targets in each of the `numDomains` failure domains (none when numDomains == 0)
+	newSmap := func(numDomains, perDomain int) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap), Pmap: make(meta.NodeMap)}
+		for i := 0; i < max(numDomains, 1)*perDomain; i++ {
+			tsi := &meta.Snode{}
+			tsi.Init(fmt.Sprintf("t%d", i), apc.Target)
+			if numDomains > 0 {
+				tsi.Domain = fmt.Sprintf("rack%d", i%numDomains)
+			}
+			smap.Tmap.Add(tsi)
+		}
+		return smap
+	}
+
+	It("should select the same targets as before when failure domains are not configured", func() {
+		smap := newSmap(0, 10)
+		for i := 0; i < 100; i++ {
+			uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+			sis, err := smap.HrwTargetList(uname, 4)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(4))
+			tsi, err := smap.HrwName2T(uname)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0].ID()).To(Equal(tsi.ID()))
+		}
+		Expect(smap.Domains()).To(BeNil())
+		Expect(smap.MaxPerDomain(4)).To(Equal(1))
+	})
+
+	It("should spread targets across failure domains", func() {
+		smap := newSmap(3, 4)
+		Expect(smap.Domains()).To(HaveLen(3))
+		for i := 0; i < 100; i++ {
+			uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+			sis, err := smap.HrwTargetList(uname, 7)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(7))
+
+			// main target does not change
+			tsi, err := smap.HrwName2T(uname)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0].ID()).To(Equal(tsi.ID()))
+
+			perDomain := make(map[string]int, 3)
+			for _, si := range sis {
+				perDomain[si.Domain]++
+			}
+			for _, n := range perDomain {
+				Expect(n).To(BeNumerically("<=", smap.MaxPerDomain(7)))
+			}
+
+			// prefix-stable
+			shorter, err := smap.HrwTargetList(uname, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shorter).To(Equal(sis[:3]))
+			Expect(shorter[0].Domain).NotTo(Equal(shorter[1].Domain))
+			Expect(shorter[1].Domain).NotTo(Equal(shorter[2].Domain))
+			Expect(shorter[0].Domain).NotTo(Equal(shorter[2].Domain))
+		}
+		Expect(smap.MaxPerDomain(7)).To(Equal(3))
+		Expect(smap.MaxPerDomain(3)).To(Equal(1))
+	})
+
+	Describe("weighted", func() {
+		const numObjs = 20000
+
+		place := func(smap *meta.Smap) map[string]string {
+			smap.InitDigests()
+			m := make(map[string]string, numObjs)
+			for i := 0; i < numObjs; i++ {
+				uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+				tsi, err := smap.HrwName2T(uname)
+				Expect(err).NotTo(HaveOccurred())
+				m[uname] = tsi.ID()
+			}
+			return m
+		}
+
+		It("should select the same targets when all weights are equal", func() {
+			smap := newSmap(0, 8)
+			unweighted := place(smap)
+			for _, tsi := range smap.Tmap {
+				tsi.Weight = 8192
+			}
+			weighted := place(smap)
+			Expect(smap.IsWeighted()).To(BeTrue())
+			Expect(weighted).To(Equal(unweighted))
+		})
+
+		It("should not be weighted unless all targets have weights", func() {
+			smap := newSmap(0, 4)
+			smap.Tmap["t0"].Weight = 100
+			smap.InitDigests()
+			Expect(smap.IsWeighted()).To(BeFalse())
+		})
+
+		It("should distribute proportionally to weights and only move objects to the reweighted target", func() {
+			smap := newSmap(0, 4)
+			for _, tsi := range smap.Tmap {
+				tsi.Weight = 8
+			}
+			before := place(smap)
+
+			smap.Tmap["t0"].Weight = 20
+			after := place(smap)
+			counts := make(map[string]int, 4)
+			for uname, tid := range after {
+				counts[tid]++
+				if tid != before[uname] {
+					Expect(tid).To(Equal("t0"))
+				}
+			}
+			// expecting 20/(20+3*8) = 45% (+/- 2%)
+			Expect(counts["t0"]).To(BeNumerically("~", numObjs*20/44, numObjs/50))
+		})
+	})
+})
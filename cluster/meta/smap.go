@@ -70,6 +70,8 @@ type (
 		ControlNet NetInfo    `json:"intra_control_net"` // cmn.NetIntraControl
 		DaeType    string     `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string     `json:"daemon_id"`
+		Domain     string     `json:"domain,omitempty"` // failure domain (e.g., rack or zone); see HrwTargetList
+		Weight     uint64     `json:"weight,omitempty"` // weighted HRW: relative target weight (e.g., capacity in GiB)
 		name       string
 		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
 		idDigest   uint64
@@ -87,6 +89,7 @@ type (
 		UUID         string  `json:"uuid"`          // assigned once at creation time and never change
 		CreationTime string  `json:"creation_time"` // creation timestamp
 		Version      int64   `json:"version,string"`
+		weighted     bool    // all active targets have weights (see InitDigests)
 	}
 )
 
@@ -246,6 +249,9 @@ func (d *Snode) isDupNet(n *Snode, smap *Smap) error {
 	return nil
 }
 
+// whether the two (same ID) nodes are equally placed: failure domain and weight
+func (d *Snode) SamePlacement(o *Snode) bool { return d.Domain == o.Domain && d.Weight == o.Weight }
+
 func (d *Snode) IsProxy() bool  { return d.DaeType == apc.Proxy }
 func (d *Snode) IsTarget() bool { return d.DaeType == apc.Target }
 
@@ -318,10 +324,21 @@ func (ni *NetInfo) eq(o *NetInfo) bool {
 // Cluster map (aks Smap) is a versioned, protected and replicated object
 // Smap versioning is monotonic and incremental
 
+// NOTE: in addition, determines whether target selection is weighted:
+// only when all active targets have (positive) weights
 func (m *Smap) InitDigests() {
+	var weighted, unweighted bool
 	for _, node := range m.Tmap {
 		node.setDigest()
+		switch {
+		case node.InMaintOrDecomm():
+		case node.Weight > 0:
+			weighted = true
+		default:
+			unweighted = true
+		}
 	}
+	m.weighted = weighted && !unweighted
 	for _, node := range m.Pmap {
 		node.setDigest()
 	}
@@ -346,6 +363,7 @@ func (m *Smap) StringEx() string {
 }
 
 func (m *Smap) CountTargets() int { return len(m.Tmap) }
+func (m *Smap) IsWeighted() bool  { return m.weighted }
 func (m *Smap) CountProxies() int { return len(m.Pmap) }
 func (m *Smap) Count() int        { return len(m.Pmap) + len(m.Tmap) }
 
@@ -369,6 +387,54 @@ func (m *Smap) HasActiveTs(except string) bool {
 	return false
 }
 
+// number of active targets in each failure domain, or nil if none is labeled
+// (an unlabeled target forms its own single-node domain and is not included)
+func (m *Smap) Domains() (domains map[string]int) {
+	for _, t := range m.Tmap {
+		if t.Domain == "" || t.InMaintOrDecomm() {
+			continue
+		}
+		if domains == nil {
+			domains = make(map[string]int, 4)
+		}
+		domains[t.Domain]++
+	}
+	return
+}
+
+// given `count` targets selected by HrwTargetList, returns the maximum number
+// of those that may end up in a single failure domain (worst case across all names)
+func (m *Smap) MaxPerDomain(count int) int {
+	var (
+		domains = m.Domains()
+		sizes   = make([]int, 0, len(domains))
+		nolabel = m.CountActiveTs()
+	)
+	for _, n := range domains {
+		sizes = append(sizes, n)
+		nolabel -= n
+	}
+	for i := 0; i < nolabel; i++ {
+		sizes = append(sizes, 1)
+	}
+	// round-robin: every round takes one target from each domain that has any left
+	var rounds, taken int
+	for taken < count {
+		var cnt int
+		for _, n := range sizes {
+			if n > rounds {
+				cnt++
+			}
+		}
+		if cnt == 0 {
+			break
+		}
+		taken += cnt
+		rounds++
+	}
+	return rounds
+}
+
 func (m *Smap) CountActivePs() (count int) {
 	for _, p := range m.Pmap {
 		if !p.InMaintOrDecomm() {
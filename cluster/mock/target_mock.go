@@ -44,17 +44,25 @@ func (*TargetMock) Sowner() meta.Sowner      { return nil }
 func (*TargetMock) PageMM() *memsys.MMSA     { return memsys.PageMM() }
 func (*TargetMock) ByteMM() *memsys.MMSA     { return memsys.ByteMM() }
 
-func (*TargetMock) GetAllRunning(*cluster.AllRunningInOut, bool)                {}
-func (*TargetMock) PutObject(*cluster.LOM, *cluster.PutObjectParams) error      { return nil }
-func (*TargetMock) FinalizeObj(*cluster.LOM, string, cluster.Xact) (int, error) { return 0, nil }
-func (*TargetMock) EvictObject(*cluster.LOM) (int, error)                       { return 0, nil }
-func (*TargetMock) DeleteObject(*cluster.LOM, bool) (int, error)                { return 0, nil }
-func (*TargetMock) Promote(*cluster.PromoteParams) (int, error)                 { return 0, nil }
-func (*TargetMock) Backend(*meta.Bck) cluster.BackendProvider                   { return nil }
-func (*TargetMock) HeadObjT2T(*cluster.LOM, *meta.Snode) bool                   { return false }
-func (*TargetMock) BMDVersionFixup(*http.Request, ...cmn.Bck)                   {}
-func (*TargetMock) FSHC(error, string)                                          {}
-func (*TargetMock) OOS(*fs.CapStatus) fs.CapStatus                              { return fs.CapStatus{} }
+func (*TargetMock) GetAllRunning(*cluster.AllRunningInOut, bool)           {}
+func (*TargetMock) PutObject(*cluster.LOM, *cluster.PutObjectParams) error { return nil }
+func (*TargetMock) EvictObject(*cluster.LOM, bool) (int, error)            { return 0, nil }
+func (*TargetMock) DeleteObject(*cluster.LOM, bool) (int, error)           { return 0, nil }
+func (*TargetMock) Promote(*cluster.PromoteParams) (int, error)            { return 0, nil }
+func (*TargetMock) Backend(*meta.Bck) cluster.BackendProvider              { return nil }
+func (*TargetMock) HeadObjT2T(*cluster.LOM, *meta.Snode) bool              { return false }
+func (*TargetMock) PutObjT2T(*cluster.LOM, *meta.Snode) error              { return nil }
+func (*TargetMock) BMDVersionFixup(*http.Request, ...cmn.Bck)              {}
+func (*TargetMock) FSHC(error, string)                                     {}
+func (*TargetMock) OOS(*fs.CapStatus) fs.CapStatus                         { return fs.CapStatus{} }
+
+func (*TargetMock) HeadObjAttrsT2T(*cluster.LOM, *meta.Snode) (*cmn.ObjAttrs, int, error) {
+	return nil, 0, nil
+}
+
+func (*TargetMock) FinalizeObj(*cluster.LOM, string, cluster.Xact, cmn.OWT) (int, error) {
+	return 0, nil
+}
 
 func (*TargetMock) CopyObject(*cluster.LOM, *cluster.CopyObjectParams, bool) (int64, error) {
 	return 0, nil
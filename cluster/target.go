@@ -68,13 +68,15 @@ type (
 		CompareObjects(ctx context.Context, lom *LOM) (equal bool, errCode int, err error)
 
 		// core object (+ PutObject above)
-		FinalizeObj(lom *LOM, workFQN string, xctn Xact) (errCode int, err error)
-		EvictObject(lom *LOM) (errCode int, err error)
+		FinalizeObj(lom *LOM, workFQN string, xctn Xact, owt cmn.OWT) (errCode int, err error)
+		EvictObject(lom *LOM, force bool) (errCode int, err error) // (force: evict pinned as well)
 		DeleteObject(lom *LOM, evict bool) (errCode int, err error)
 		CopyObject(lom *LOM, params *CopyObjectParams, dryRun bool) (int64, error)
 		GetCold(ctx context.Context, lom *LOM, owt cmn.OWT) (errCode int, err error)
 		Promote(params *PromoteParams) (errCode int, err error)
 		HeadObjT2T(lom *LOM, si *meta.Snode) bool
+		HeadObjAttrsT2T(lom *LOM, si *meta.Snode) (*cmn.ObjAttrs, int, error)
+		PutObjT2T(lom *LOM, si *meta.Snode) error
 	}
 
 	TargetExt interface {
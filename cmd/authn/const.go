@@ -4,12 +4,16 @@
  */
 package main
 
-import "time"
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
 
 const (
-	ClusterOwnerRole = "ClusterOwner"
-	BucketOwnerRole  = "BucketOwner"
-	GuestRole        = "Guest"
+	ClusterOwnerRole = apc.ClusterOwnerRole
+	BucketOwnerRole  = apc.BucketOwnerRole
+	GuestRole        = apc.GuestRole
 )
 
 const (
@@ -17,9 +21,16 @@ const (
 	rolesCollection    = "role"
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
+	accountsCollection = "svcaccount"
 
 	adminUserID   = "admin"
 	adminUserPass = "admin"
 
 	foreverTokenTime = 24 * 365 * 20 * time.Hour // kind of never-expired token
+
+	// service accounts
+	apiKeySepa       = "."            // API key: <service account ID>.<secret>
+	apiKeySecretLen  = 40             //
+	accountTokenTime = time.Hour      // default expiration of the tokens issued in exchange for API key
+	maxAccTokenTime  = 24 * time.Hour // max (ditto)
 )
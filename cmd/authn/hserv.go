@@ -85,6 +85,7 @@ func (h *hserv) registerPublicHandlers() {
 	h.registerHandler(apc.URLPathTokens.S, h.tokenHandler)
 	h.registerHandler(apc.URLPathClusters.S, h.clusterHandler)
 	h.registerHandler(apc.URLPathRoles.S, h.roleHandler)
+	h.registerHandler(apc.URLPathAccounts.S, h.accountHandler)
 	h.registerHandler(apc.URLPathDae.S, configHandler)
 }
 
@@ -107,8 +108,10 @@ func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
 		h.httpRevokeToken(w, r)
+	case http.MethodPost:
+		h.httpExchangeKey(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost)
 	}
 }
 
@@ -148,6 +151,29 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 	h.mgr.revokeToken(msg.Token)
 }
 
+// Exchanges service account's API key for a (short-lived) token
+func (h *hserv) httpExchangeKey(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	msg := &authn.APIKeyMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	if msg.Key == "" {
+		cmn.WriteErrMsg(w, r, "Not authorized", http.StatusUnauthorized)
+		return
+	}
+	tokenString, err := h.mgr.issueAccountToken(msg)
+	if err != nil {
+		nlog.Errorf("Failed to generate token in exchange for API key: %v\n", err)
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	repl := fmt.Sprintf(`{"token": %q}`, tokenString)
+	writeBytes(w, []byte(repl), "auth")
+}
+
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := parseURL(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
@@ -537,3 +563,86 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+func (h *hserv) accountHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.httpAccountPost(w, r)
+	case http.MethodDelete:
+		h.httpAccountDel(w, r)
+	case http.MethodGet:
+		h.httpAccountGet(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+	}
+}
+
+func (h *hserv) httpAccountGet(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 0, apc.URLPathAccounts.L)
+	if err != nil {
+		return
+	}
+	if len(apiItems) > 1 {
+		cmn.WriteErrMsg(w, r, "invalid request")
+		return
+	}
+	if err = validateAdminPerms(w, r); err != nil {
+		return
+	}
+	if len(apiItems) == 0 {
+		accounts, err := h.mgr.accountList()
+		if err != nil {
+			cmn.WriteErr(w, r, err)
+			return
+		}
+		writeJSON(w, accounts, "list service accounts")
+		return
+	}
+	sa, err := h.mgr.lookupAccount(apiItems[0])
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	writeJSON(w, sa, "service account")
+}
+
+// Adds a new service account and returns it along with its (newly generated) API key
+func (h *hserv) httpAccountPost(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathAccounts.L); err != nil {
+		return
+	}
+	if err := validateAdminPerms(w, r); err != nil {
+		return
+	}
+	info := &authn.ServiceAccount{}
+	if err := cmn.ReadJSON(w, r, info); err != nil {
+		return
+	}
+	sa, err := h.mgr.addAccount(info)
+	if err != nil {
+		cmn.WriteErrMsg(w, r, fmt.Sprintf("Failed to add service account: %v", err))
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("Add service account %q (owner %q)", sa.ID, sa.Owner)
+	}
+	writeJSON(w, sa, "add service account")
+}
+
+// Deletes service account (and revokes its API key)
+func (h *hserv) httpAccountDel(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 1, apc.URLPathAccounts.L)
+	if err != nil {
+		return
+	}
+	if err = validateAdminPerms(w, r); err != nil {
+		return
+	}
+	if err := h.mgr.delAccount(apiItems[0]); err != nil {
+		if cos.IsErrNotFound(err) {
+			cmn.WriteErr(w, r, err, http.StatusNotFound)
+		} else {
+			cmn.WriteErr(w, r, err)
+		}
+	}
+}
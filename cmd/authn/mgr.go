@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -388,20 +389,8 @@ func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error
 		if cid == "" {
 			return "", cos.NewErrNotFound("%s: cluster %q", svcName, msg.ClusterID)
 		}
-		uInfo.ClusterACLs = mergeClusterACLs(make([]*authn.CluACL, 0, len(uInfo.ClusterACLs)), uInfo.ClusterACLs, cid)
-		uInfo.BucketACLs = mergeBckACLs(make([]*authn.BckACL, 0, len(uInfo.BucketACLs)), uInfo.BucketACLs, cid)
-	}
-
-	// update ACLs with roles's ones
-	for _, role := range uInfo.Roles {
-		rInfo := &authn.Role{}
-		err := m.db.Get(rolesCollection, role, rInfo)
-		if err != nil {
-			continue
-		}
-		uInfo.ClusterACLs = mergeClusterACLs(uInfo.ClusterACLs, rInfo.ClusterACLs, cid)
-		uInfo.BucketACLs = mergeBckACLs(uInfo.BucketACLs, rInfo.BucketACLs, cid)
 	}
+	m.userACLs(uInfo, cid)
 
 	// generate token
 	Conf.RLock()
@@ -428,6 +417,24 @@ func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error
 	return token, err
 }
 
+// Updates user's ACLs with the ones inherited from the user's roles;
+// if cluster ID is specified, leaves only the ACLs of this cluster.
+func (m *mgr) userACLs(uInfo *authn.User, cid string) {
+	if cid != "" {
+		uInfo.ClusterACLs = mergeClusterACLs(make([]*authn.CluACL, 0, len(uInfo.ClusterACLs)), uInfo.ClusterACLs, cid)
+		uInfo.BucketACLs = mergeBckACLs(make([]*authn.BckACL, 0, len(uInfo.BucketACLs)), uInfo.BucketACLs, cid)
+	}
+	for _, role := range uInfo.Roles {
+		rInfo := &authn.Role{}
+		err := m.db.Get(rolesCollection, role, rInfo)
+		if err != nil {
+			continue
+		}
+		uInfo.ClusterACLs = mergeClusterACLs(uInfo.ClusterACLs, rInfo.ClusterACLs, cid)
+		uInfo.BucketACLs = mergeBckACLs(uInfo.BucketACLs, rInfo.BucketACLs, cid)
+	}
+}
+
 // Before putting a list of cluster permissions to a token, cluster aliases
 // must be replaced with their IDs.
 func (m *mgr) fixClusterIDs(lst []*authn.CluACL) {
@@ -489,6 +496,128 @@ func (m *mgr) generateRevokedTokenList() ([]string, error) {
 	return revokeList, nil
 }
 
+//
+// service accounts ============================================================
+//
+
+// Registers a new service account and generates its API key
+// (the key is returned to the caller only once and is never stored - only its hash)
+func (m *mgr) addAccount(sa *authn.ServiceAccount) (*authn.ServiceAccount, error) {
+	if err := cos.ValidateNiceID(sa.ID, 2, "service account"); err != nil {
+		return nil, err
+	}
+	if _, err := m.db.GetString(accountsCollection, sa.ID); err == nil {
+		return nil, fmt.Errorf("service account %q already exists", sa.ID)
+	}
+	if _, err := m.db.GetString(usersCollection, sa.Owner); err != nil {
+		return nil, cos.NewErrNotFound("%s: user %q (service account owner)", svcName, sa.Owner)
+	}
+	secret := cos.CryptoRandS(apiKeySecretLen)
+	sa.Key, sa.KeyHash = "", encryptPassword(secret)
+	sa.Created, sa.LastUsed = time.Now(), time.Time{}
+	if err := m.db.Set(accountsCollection, sa.ID, sa); err != nil {
+		return nil, err
+	}
+	sa.Key, sa.KeyHash = sa.ID+apiKeySepa+secret, ""
+	return sa, nil
+}
+
+// Deletes service account and, effectively, revokes its API key
+// (tokens that were previously issued in exchange for the key remain valid until they expire)
+func (m *mgr) delAccount(id string) error {
+	if _, err := m.db.GetString(accountsCollection, id); err != nil {
+		return cos.NewErrNotFound("%s: service account %q", svcName, id)
+	}
+	return m.db.Delete(accountsCollection, id)
+}
+
+func (m *mgr) lookupAccount(id string) (*authn.ServiceAccount, error) {
+	sa := &authn.ServiceAccount{}
+	if err := m.db.Get(accountsCollection, id, sa); err != nil {
+		return nil, cos.NewErrNotFound("%s: service account %q", svcName, id)
+	}
+	sa.KeyHash = ""
+	return sa, nil
+}
+
+func (m *mgr) accountList() ([]*authn.ServiceAccount, error) {
+	recs, err := m.db.GetAll(accountsCollection, "")
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*authn.ServiceAccount, 0, len(recs))
+	for _, str := range recs {
+		sa := &authn.ServiceAccount{}
+		if err := jsoniter.Unmarshal([]byte(str), sa); err != nil {
+			return nil, err
+		}
+		sa.KeyHash = ""
+		accounts = append(accounts, sa)
+	}
+	return accounts, nil
+}
+
+// Exchanges API key for a short-lived token with the service account's permissions
+// (that are always a subset of the owner's ones - see scopeACLs)
+func (m *mgr) issueAccountToken(msg *authn.APIKeyMsg) (string, error) {
+	var (
+		sa    = &authn.ServiceAccount{}
+		uInfo = &authn.User{}
+		cid   string
+	)
+	id, secret, ok := strings.Cut(msg.Key, apiKeySepa)
+	if !ok {
+		return "", errInvalidCredentials
+	}
+	if err := m.db.Get(accountsCollection, id, sa); err != nil {
+		return "", errInvalidCredentials
+	}
+	if !isSamePassword(secret, sa.KeyHash) {
+		return "", errInvalidCredentials
+	}
+	if err := m.db.Get(usersCollection, sa.Owner, uInfo); err != nil {
+		nlog.Errorf("service account %q: failed to load owner %q: %v", id, sa.Owner, err)
+		return "", errInvalidCredentials
+	}
+	if msg.ClusterID != "" {
+		if cid = m.cluLookup(msg.ClusterID, msg.ClusterID); cid == "" {
+			return "", cos.NewErrNotFound("%s: cluster %q", svcName, msg.ClusterID)
+		}
+	} else if !uInfo.IsAdmin() || len(sa.Buckets) > 0 {
+		return "", fmt.Errorf("couldn't issue token for service account %q: cluster ID not set", id)
+	}
+
+	// NOTE: tokens issued to service accounts never carry admin (AuthN management) privileges
+	if uInfo.IsAdmin() {
+		uInfo.ClusterACLs = []*authn.CluACL{{ID: cid, Access: apc.AccessAll}}
+		uInfo.BucketACLs = nil
+	} else {
+		m.userACLs(uInfo, cid)
+		m.fixClusterIDs(uInfo.ClusterACLs)
+	}
+	cluACLs, bckACLs := scopeACLs(sa, cid, uInfo.ClusterACLs, uInfo.BucketACLs)
+	if cluACLs == nil && bckACLs == nil {
+		return "", fmt.Errorf("%v: service account %q (owner %q)", tok.ErrNoPermissions, id, sa.Owner)
+	}
+
+	now := time.Now()
+	sa.LastUsed = now
+	if err := m.db.Set(accountsCollection, id, sa); err != nil {
+		nlog.Errorf("service account %q: failed to update: %v", id, err)
+	}
+
+	expDelta := accountTokenTime
+	if msg.ExpiresIn != nil && *msg.ExpiresIn > 0 {
+		expDelta = *msg.ExpiresIn
+		if expDelta > maxAccTokenTime {
+			expDelta = maxAccTokenTime
+		}
+	}
+	Conf.RLock()
+	defer Conf.RUnlock()
+	return tok.IssueJWT(now.Add(expDelta), sa.Owner+"/"+id, bckACLs, cluACLs, Conf.Server.Secret)
+}
+
 //
 // private helpers ============================================================
 //
@@ -0,0 +1,235 @@
+// Package tok provides AuthN token (structure and methods)
+// for validation by AIS gateways
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tok
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/golang-jwt/jwt/v4"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// OIDC: JWTs signed by external identity providers (see cmn.OIDCConf)
+
+type (
+	// JSON Web Key (RFC 7517): RSA and EC public keys
+	JWK struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid,omitempty"`
+		Use string `json:"use,omitempty"`
+		Alg string `json:"alg,omitempty"`
+		// RSA
+		N string `json:"n,omitempty"`
+		E string `json:"e,omitempty"`
+		// EC
+		Crv string `json:"crv,omitempty"`
+		X   string `json:"x,omitempty"`
+		Y   string `json:"y,omitempty"`
+	}
+	JWKS struct {
+		Keys []JWK `json:"keys"`
+	}
+
+	// public key by issuer and key ID (the latter may be empty when the issuer has a single key)
+	KeyLookup func(iss, kid string) (crypto.PublicKey, error)
+)
+
+var oidcMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// IsOIDC returns true if the token is signed with one of the supported asymmetric
+// algorithms (as opposed to AuthN tokens signed with the cluster secret)
+func IsOIDC(tokenStr string) bool {
+	jwtToken, _, err := jwt.NewParser().ParseUnverified(tokenStr, jwt.MapClaims{})
+	if err != nil {
+		return false
+	}
+	alg := jwtToken.Method.Alg()
+	return strings.HasPrefix(alg, "RS") || strings.HasPrefix(alg, "ES")
+}
+
+// DecryptOIDC validates the token's signature, issuer, expiration, and (optionally) audience,
+// and then maps its claims to cluster and bucket permissions in accordance with the issuer's rules
+func DecryptOIDC(tokenStr string, conf *cmn.OIDCConf, clusterID string, lookup KeyLookup) (*Token, error) {
+	var (
+		ic     *cmn.OIDCIssuerConf
+		claims = jwt.MapClaims{}
+		parser = jwt.NewParser(jwt.WithValidMethods(oidcMethods))
+	)
+	_, err := parser.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (any, error) {
+		iss, _ := claims["iss"].(string)
+		if ic = conf.Issuer(iss); ic == nil {
+			return nil, fmt.Errorf("unknown issuer %q", iss)
+		}
+		kid, _ := t.Header["kid"].(string)
+		return lookup(iss, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	if !claims.VerifyExpiresAt(now, true /*required*/) {
+		return nil, ErrTokenExpired
+	}
+	if ic.Audience != "" && !claims.VerifyAudience(ic.Audience, true) {
+		return nil, fmt.Errorf("%v: unexpected audience (expecting %q)", ErrInvalidToken, ic.Audience)
+	}
+	uname, _ := claims[ic.Username()].(string)
+	if uname == "" {
+		return nil, fmt.Errorf("%v: missing %q claim", ErrInvalidToken, ic.Username())
+	}
+	tk := &Token{UserID: uname, Token: tokenStr}
+	if exp, ok := claims["exp"].(float64); ok {
+		tk.Expires = time.Unix(int64(exp), 0)
+	}
+	if err := tk.applyRules(ic, claims, clusterID); err != nil {
+		return nil, err
+	}
+	return tk, nil
+}
+
+func (tk *Token) applyRules(ic *cmn.OIDCIssuerConf, claims jwt.MapClaims, clusterID string) error {
+	var cluPerms apc.AccessAttrs
+	for i := range ic.Rules {
+		rule := &ic.Rules[i]
+		if !rule.Match(claims[rule.Claim]) {
+			continue
+		}
+		var perms apc.AccessAttrs
+		switch rule.Role {
+		case apc.AdminRole:
+			tk.IsAdmin = true
+			continue
+		case apc.ClusterOwnerRole:
+			perms = apc.AccessAll
+		case apc.BucketOwnerRole:
+			perms = apc.AccessRW
+		case apc.GuestRole:
+			perms = apc.AccessRO
+		}
+		if rule.Bucket == "" {
+			cluPerms |= perms
+			continue
+		}
+		bck, _, err := cmn.ParseBckObjectURI(rule.Bucket, cmn.ParseURIOpts{})
+		if err != nil {
+			return err
+		}
+		bck.Ns.UUID = clusterID // (see aclForBucket)
+		tk.BucketACLs = append(tk.BucketACLs, &authn.BckACL{Bck: bck, Access: perms})
+	}
+	if cluPerms != 0 {
+		// cluster ACL with empty ID is the default one
+		tk.ClusterACLs = []*authn.CluACL{{Access: cluPerms}}
+	}
+	if !tk.IsAdmin && cluPerms == 0 && len(tk.BucketACLs) == 0 {
+		return fmt.Errorf("%v: [user %s] no matching role rules (issuer %q)", ErrNoPermissions, tk.UserID, ic.Issuer)
+	}
+	return nil
+}
+
+//////////
+// JWKS //
+//////////
+
+// ParseJWKS returns public keys by key ID (empty ID for a key that does not have one);
+// keys of unsupported types and keys not intended for signature verification are skipped
+func ParseJWKS(b []byte) (map[string]crypto.PublicKey, error) {
+	var jwks JWKS
+	if err := jsoniter.Unmarshal(b, &jwks); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %v", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for i := range jwks.Keys {
+		jwk := &jwks.Keys[i]
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var (
+			key crypto.PublicKey
+			err error
+		)
+		switch jwk.Kty {
+		case "RSA":
+			key, err = jwk.rsa()
+		case "EC":
+			key, err = jwk.ec()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWK %q: %v", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS contains no (supported) signing keys")
+	}
+	return keys, nil
+}
+
+func (jwk *JWK) rsa() (*rsa.PublicKey, error) {
+	n, err := b64int(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := b64int(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid RSA exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (jwk *JWK) ec() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+	}
+	x, err := b64int(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := b64int(jwk.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) { //nolint:staticcheck // (validating untrusted input)
+		return nil, errors.New("invalid EC point")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func b64int(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("missing key parameter")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
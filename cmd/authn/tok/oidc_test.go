@@ -0,0 +1,125 @@
+// Package tok_test contains unit tests for OIDC token validation
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tok_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	testIssuer  = "https://idp.example.com"
+	testCluster = "clu-uuid"
+)
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+func testJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) map[string]crypto.PublicKey {
+	jwks := tok.JWKS{Keys: []tok.JWK{
+		{Kty: "RSA", Kid: "rsa1", Use: "sig", N: b64(rsaKey.N.Bytes()), E: b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{Kty: "EC", Kid: "ec1", Crv: "P-256", X: b64(ecKey.X.Bytes()), Y: b64(ecKey.Y.Bytes())},
+		{Kty: "oct", Kid: "hmac"}, // (unsupported - skipped)
+	}}
+	keys, err := tok.ParseJWKS(cos.MustMarshal(jwks))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 2, "expected 2 keys, got %d", len(keys))
+	return keys
+}
+
+func TestDecryptOIDC(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	tassert.CheckFatal(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+
+	var (
+		keys   = testJWKS(t, rsaKey, ecKey)
+		lookup = func(iss, kid string) (crypto.PublicKey, error) {
+			tassert.Errorf(t, iss == testIssuer, "unexpected issuer %q", iss)
+			return keys[kid], nil
+		}
+		conf = &cmn.OIDCConf{Issuers: []cmn.OIDCIssuerConf{{
+			Issuer:        testIssuer,
+			JWKS:          "/tmp/jwks.json",
+			Audience:      "ais",
+			UsernameClaim: "email",
+			Rules: []cmn.OIDCRoleRule{
+				{Claim: "groups", Value: "ais-admins", Role: apc.AdminRole},
+				{Claim: "groups", Value: "ml-*", Role: apc.GuestRole},
+				{Claim: "email", Value: "*@example.com", Role: apc.BucketOwnerRole, Bucket: "ais://scratch"},
+			},
+		}}}
+		exp  = time.Now().Add(time.Hour).Unix()
+		sign = func(method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+			token := jwt.NewWithClaims(method, claims)
+			token.Header["kid"] = kid
+			s, err := token.SignedString(key)
+			tassert.CheckFatal(t, err)
+			return s
+		}
+	)
+	tassert.CheckFatal(t, conf.Validate())
+
+	// RS256: guest in the cluster, bucket owner in ais://scratch
+	tk, err := tok.DecryptOIDC(sign(jwt.SigningMethodRS256, "rsa1", rsaKey, jwt.MapClaims{
+		"iss": testIssuer, "aud": "ais", "exp": exp, "email": "alice@example.com", "groups": []string{"ml-train"},
+	}), conf, testCluster, lookup)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.UserID == "alice@example.com" && !tk.IsAdmin, "unexpected token %+v", tk)
+	scratch := cmn.Bck{Name: "scratch", Provider: apc.AIS}
+	tassert.CheckError(t, tk.CheckPermissions(testCluster, &scratch, apc.AcePUT))
+	other := cmn.Bck{Name: "other", Provider: apc.AIS}
+	tassert.CheckError(t, tk.CheckPermissions(testCluster, &other, apc.AceGET))
+	if err := tk.CheckPermissions(testCluster, &other, apc.AcePUT); err == nil {
+		t.Error("expected PUT to be denied")
+	}
+
+	// ES256: admin
+	tk, err = tok.DecryptOIDC(sign(jwt.SigningMethodES256, "ec1", ecKey, jwt.MapClaims{
+		"iss": testIssuer, "aud": []string{"ais", "other"}, "exp": exp, "email": "bob@corp.com", "groups": []string{"ais-admins"},
+	}), conf, testCluster, lookup)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.IsAdmin, "expected admin, got %+v", tk)
+
+	// invalid
+	invalid := []string{
+		// expired
+		sign(jwt.SigningMethodRS256, "rsa1", rsaKey, jwt.MapClaims{
+			"iss": testIssuer, "aud": "ais", "exp": time.Now().Add(-time.Minute).Unix(), "email": "a@example.com",
+		}),
+		// no expiration
+		sign(jwt.SigningMethodRS256, "rsa1", rsaKey, jwt.MapClaims{"iss": testIssuer, "aud": "ais", "email": "a@example.com"}),
+		// wrong audience
+		sign(jwt.SigningMethodRS256, "rsa1", rsaKey, jwt.MapClaims{"iss": testIssuer, "aud": "xyz", "exp": exp, "email": "a@example.com"}),
+		// unknown issuer
+		sign(jwt.SigningMethodRS256, "rsa1", rsaKey, jwt.MapClaims{"iss": "xyz", "aud": "ais", "exp": exp, "email": "a@example.com"}),
+		// signed with a different key
+		sign(jwt.SigningMethodES256, "rsa1", ecKey, jwt.MapClaims{"iss": testIssuer, "aud": "ais", "exp": exp, "email": "a@example.com"}),
+		// no matching rules
+		sign(jwt.SigningMethodRS256, "rsa1", rsaKey, jwt.MapClaims{"iss": testIssuer, "aud": "ais", "exp": exp, "email": "a@corp.com"}),
+		// HMAC
+		sign(jwt.SigningMethodHS256, "", []byte("secret"), jwt.MapClaims{"iss": testIssuer, "aud": "ais", "exp": exp, "email": "a@example.com"}),
+	}
+	for i, token := range invalid {
+		if _, err := tok.DecryptOIDC(token, conf, testCluster, lookup); err == nil {
+			t.Errorf("validation of invalid token #%d succeeded", i)
+		}
+	}
+	tassert.Errorf(t, !tok.IsOIDC(invalid[len(invalid)-1]) && tok.IsOIDC(invalid[0]), "IsOIDC")
+}
@@ -0,0 +1,93 @@
+// Package tok_test contains unit tests for AuthN tokens
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tok_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func TestPrefixACLs(t *testing.T) {
+	var (
+		datasets = cmn.Bck{Name: "datasets", Provider: apc.AIS}
+		aclBck   = cmn.Bck{Name: "datasets", Provider: apc.AIS, Ns: cmn.Ns{UUID: testCluster}}
+		nsBck    = cmn.Bck{Name: "datasets", Provider: apc.AIS, Ns: cmn.Ns{Name: "ns"}}
+		tk       = &tok.Token{
+			UserID:      "teamA",
+			ClusterACLs: []*authn.CluACL{{Access: apc.AccessRO}},
+			BucketACLs: []*authn.BckACL{
+				{Bck: aclBck, Prefix: "teamA/", Access: apc.AccessRW},
+				{Bck: aclBck, Prefix: "teamA/secret/", Access: apc.AceObjHEAD},
+			},
+		}
+	)
+	tests := []struct {
+		objName string
+		perms   apc.AccessAttrs
+		ok      bool
+	}{
+		{"teamA/a.tar", apc.AcePUT, true},
+		{"teamA/a.tar", apc.AceObjDELETE, true},
+		{"teamB/a.tar", apc.AceGET, true}, // (cluster-wide read-only)
+		{"teamB/a.tar", apc.AcePUT, false},
+		{"teamA", apc.AcePUT, false},
+		{"teamA/secret/a.tar", apc.AceObjHEAD, true},
+		{"teamA/secret/a.tar", apc.AceGET, false}, // (the longest prefix overrides)
+	}
+	for _, test := range tests {
+		err := tk.CheckObjPermissions(testCluster, &datasets, test.objName, test.perms)
+		if (err == nil) != test.ok {
+			t.Errorf("%s %s: expected ok=%t, got %v", test.perms.Describe(), test.objName, test.ok, err)
+		}
+	}
+	// bucket-level access is not affected by prefix-scoped ACLs
+	if err := tk.CheckPermissions(testCluster, &datasets, apc.AcePUT); err == nil {
+		t.Error("expected bucket-level PUT to be denied")
+	}
+	// namespace must match
+	if err := tk.CheckObjPermissions(testCluster, &nsBck, "teamA/a.tar", apc.AcePUT); err == nil {
+		t.Error("expected PUT into a different namespace to be denied")
+	}
+
+	// list-objects
+	lsoTests := []struct {
+		prefix string
+		ok     bool
+	}{
+		{"teamA/x", true},
+		{"teamA/", false}, // includes "teamA/secret/"
+		{"teamB/", true},
+		{"teamA/secret/", false},
+		{"teamA", false},
+		{"", false},
+	}
+	for _, test := range lsoTests {
+		err := tk.CheckLsoPermissions(testCluster, &datasets, test.prefix, apc.AceObjLIST)
+		if (err == nil) != test.ok {
+			t.Errorf("list %q: expected ok=%t, got %v", test.prefix, test.ok, err)
+		}
+	}
+
+	// multi-object (range or prefix) delete: narrower read-only prefix rejects the operation
+	if err := tk.CheckLsoPermissions(testCluster, &datasets, "teamA/", apc.AceObjDELETE); err == nil {
+		t.Error("expected multi-object delete of \"teamA/\" (includes \"teamA/secret/\") to be denied")
+	}
+	if err := tk.CheckLsoPermissions(testCluster, &datasets, "teamA/x", apc.AceObjDELETE); err != nil {
+		t.Error(err)
+	}
+
+	// with no cluster-wide permissions listing is allowed only within the prefix
+	tk.ClusterACLs = nil
+	if err := tk.CheckLsoPermissions(testCluster, &datasets, "teamB/", apc.AceObjLIST); err == nil {
+		t.Error("expected listing outside allowed prefixes to be denied")
+	}
+	if err := tk.CheckObjPermissions(testCluster, &datasets, "teamA/b.tar", apc.AceGET); err != nil {
+		t.Error(err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cluster/mock"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
@@ -193,6 +194,94 @@ func TestToken(t *testing.T) {
 	}
 }
 
+func TestServiceAccount(t *testing.T) {
+	const (
+		cid   = "ABCD"
+		owner = "owner"
+	)
+	var (
+		secret = Conf.Server.Secret
+		bck    = cmn.Bck{Name: "bck", Provider: apc.AIS}
+		other  = cmn.Bck{Name: "other", Provider: apc.AIS}
+	)
+	mgr, err := newMgr(mock.NewDBDriver())
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, mgr.db.Set(clustersCollection, cid, authn.CluACL{ID: cid, Alias: "clu"}))
+	tassert.CheckFatal(t, mgr.addUser(&authn.User{
+		ID: owner, Password: "pass",
+		ClusterACLs: []*authn.CluACL{{ID: cid, Access: apc.AccessRW}},
+	}))
+
+	_, err = mgr.addAccount(&authn.ServiceAccount{ID: "loader", Owner: "nobody"})
+	tassert.Errorf(t, err != nil, "expected error adding service account for non-existing user")
+
+	sa, err := mgr.addAccount(&authn.ServiceAccount{ID: "loader", Owner: owner, Access: apc.AccessRO})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, sa.Key != "" && sa.KeyHash == "", "unexpected %+v", sa)
+	_, err = mgr.addAccount(&authn.ServiceAccount{ID: "loader", Owner: owner})
+	tassert.Errorf(t, err != nil, "expected error adding duplicate service account")
+
+	// exchange API key for token
+	token, err := mgr.issueAccountToken(&authn.APIKeyMsg{Key: sa.Key, ClusterID: "clu"})
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.UserID == owner+"/loader" && !tk.IsAdmin, "unexpected token %+v", tk)
+	tassert.Errorf(t, time.Until(tk.Expires) <= accountTokenTime, "token expires in %v", time.Until(tk.Expires))
+	tassert.CheckError(t, tk.CheckPermissions(cid, &bck, apc.AceGET))
+	if err := tk.CheckPermissions(cid, &bck, apc.AcePUT); err == nil {
+		t.Error("expected PUT to be denied (read-only service account)")
+	}
+
+	// invalid keys
+	for _, key := range []string{"", "loader", "loader.xyz", sa.Key + "x", "nobody" + sa.Key[len("loader"):]} {
+		if _, err := mgr.issueAccountToken(&authn.APIKeyMsg{Key: key, ClusterID: cid}); err == nil {
+			t.Errorf("token issued for invalid key %q", key)
+		}
+	}
+
+	// list (no hashes), last used
+	list, err := mgr.accountList()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(list) == 1 && list[0].KeyHash == "" && list[0].Key == "", "unexpected %+v", list)
+	tassert.Errorf(t, !list[0].LastUsed.IsZero(), "last-used timestamp not updated")
+
+	// restricted to a single bucket
+	sa2, err := mgr.addAccount(&authn.ServiceAccount{ID: "ci", Owner: owner, Buckets: []cmn.Bck{bck}})
+	tassert.CheckFatal(t, err)
+	token, err = mgr.issueAccountToken(&authn.APIKeyMsg{Key: sa2.Key, ClusterID: cid})
+	tassert.CheckFatal(t, err)
+	tk, err = tok.DecryptToken(token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.CheckError(t, tk.CheckPermissions(cid, &bck, apc.AcePUT))
+	if err := tk.CheckPermissions(cid, &other, apc.AceGET); err == nil {
+		t.Error("expected access to other bucket to be denied")
+	}
+
+	// bucket-scoped key of an admin owner (default admin user): no cluster-wide permissions
+	sa3, err := mgr.addAccount(&authn.ServiceAccount{ID: "scoped", Owner: adminUserID, Buckets: []cmn.Bck{bck}})
+	tassert.CheckFatal(t, err)
+	token, err = mgr.issueAccountToken(&authn.APIKeyMsg{Key: sa3.Key, ClusterID: cid})
+	tassert.CheckFatal(t, err)
+	tk, err = tok.DecryptToken(token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.CheckError(t, tk.CheckPermissions(cid, &bck, apc.AcePUT))
+	for _, perm := range []apc.AccessAttrs{apc.AceDestroyBucket, apc.AceMoveBucket, apc.AceAdmin, apc.AceCreateBucket} {
+		if err := tk.CheckPermissions(cid, &other, perm); err == nil {
+			t.Errorf("expected %s to be denied outside the scope", perm.Describe())
+		}
+	}
+	if err := tk.CheckPermissions(cid, &other, apc.AceGET); err == nil {
+		t.Error("expected access to other bucket to be denied")
+	}
+
+	// revoke
+	tassert.CheckFatal(t, mgr.delAccount("loader"))
+	if _, err := mgr.issueAccountToken(&authn.APIKeyMsg{Key: sa.Key, ClusterID: cid}); err == nil {
+		t.Error("token issued for revoked key")
+	}
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
@@ -5,14 +5,16 @@
 package main
 
 import (
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
 )
 
 type bckACLList []*authn.BckACL
 
 func (bckList bckACLList) updated(bckACL *authn.BckACL) bool {
 	for _, acl := range bckList {
-		if acl.Bck.Equal(&bckACL.Bck) {
+		if acl.Bck.Equal(&bckACL.Bck) && acl.Prefix == bckACL.Prefix {
 			acl.Access = bckACL.Access
 			return true
 		}
@@ -61,3 +63,98 @@ func mergeClusterACLs(toACLs, fromACLs cluACLList, cluIDFlt string) []*authn.Clu
 	}
 	return toACLs
 }
+
+// scopeACLs returns service account's permissions given the owner's (already filtered by cluster ID) ACLs:
+//   - all permissions are masked with sa.Access (if specified);
+//   - if sa.Buckets is specified, cluster ACLs retain no permissions at all - cluster-level ones
+//     (create/destroy/rename bucket, admin) do not apply to a given bucket and would otherwise
+//     extend to all buckets in the cluster - while the owner's bucket-level ones are carried over
+//     into (the owner's or newly created) bucket ACLs for the specified buckets only.
+//
+// Bucket ACLs with no permissions left are not removed: they still override (and restrict)
+// cluster-wide permissions. Ditto cluster ACLs (with no permissions) of a bucket-scoped account.
+func scopeACLs(sa *authn.ServiceAccount, cid string, cluACLs []*authn.CluACL,
+	bckACLs []*authn.BckACL) (clu []*authn.CluACL, bck []*authn.BckACL) {
+	var (
+		mask    = sa.Access
+		granted apc.AccessAttrs
+	)
+	if mask == 0 {
+		mask = apc.AccessAll
+	}
+	for _, acl := range cluACLs {
+		var access apc.AccessAttrs
+		if len(sa.Buckets) == 0 {
+			access = acl.Access & mask
+		}
+		clu = append(clu, &authn.CluACL{ID: acl.ID, Access: access})
+		granted |= access
+	}
+	for _, acl := range bckACLs {
+		if len(sa.Buckets) > 0 && !bckInScope(sa.Buckets, &acl.Bck, cid) {
+			continue
+		}
+		access := acl.Access & mask
+		if len(sa.Buckets) > 0 {
+			access &^= apc.AccessCluster
+		}
+		bck = append(bck, &authn.BckACL{Bck: acl.Bck, Prefix: acl.Prefix, Access: access})
+		granted |= access
+	}
+	// buckets without (bucket-wide) ACLs inherit the owner's cluster-wide permissions
+	for i := range sa.Buckets {
+		b := sa.Buckets[i]
+		if b.Ns.UUID == "" {
+			b.Ns.UUID = cid
+		}
+		if cid != "" && b.Ns.UUID != cid {
+			continue
+		}
+		if bckACLList(bck).has(&b) {
+			continue
+		}
+		access := cluACLList(cluACLs).access(b.Ns.UUID) & mask &^ apc.AccessCluster
+		bck = append(bck, &authn.BckACL{Bck: b, Access: access})
+		granted |= access
+	}
+	if granted == 0 {
+		return nil, nil
+	}
+	return clu, bck
+}
+
+func bckInScope(buckets []cmn.Bck, bck *cmn.Bck, cid string) bool {
+	for i := range buckets {
+		b := buckets[i]
+		if b.Ns.UUID == "" {
+			b.Ns.UUID = cid
+		}
+		if b.Equal(bck) {
+			return true
+		}
+	}
+	return false
+}
+
+// (bucket-wide ACL)
+func (bckList bckACLList) has(bck *cmn.Bck) bool {
+	for _, acl := range bckList {
+		if acl.Prefix == "" && acl.Bck.Equal(bck) {
+			return true
+		}
+	}
+	return false
+}
+
+// cluster's permissions or, if not found, the default ones (compare with tok.aclForCluster)
+func (cluList cluACLList) access(cid string) (access apc.AccessAttrs) {
+	for _, acl := range cluList {
+		if acl.ID == cid {
+			return acl.Access
+		}
+		if acl.ID == "" {
+			access = acl.Access
+		}
+	}
+	return access
+}
@@ -57,6 +57,13 @@ var (
 				Action:       rotateLogs,
 				BashComplete: suggestAllNodes,
 			},
+			{
+				Name:         cmdReloadCert,
+				Usage:        "reload TLS certificate (and client CAs) without restarting: all nodes or the specified one (HTTPS only)",
+				ArgsUsage:    optionalNodeIDArgument,
+				Action:       reloadCert,
+				BashComplete: suggestAllNodes,
+			},
 		},
 	}
 )
@@ -161,3 +168,25 @@ func rotateLogs(c *cli.Context) error {
 	actionDone(c, "cluster: rotated all logs")
 	return nil
 }
+
+func reloadCert(c *cli.Context) error {
+	node, sname, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	// 1. node
+	if node != nil {
+		if err := api.ReloadCert(apiBP, node.ID()); err != nil {
+			return V(err)
+		}
+		msg := fmt.Sprintf("%s: reloaded TLS certificate", sname)
+		actionDone(c, msg)
+		return nil
+	}
+	// 2. or cluster
+	if err := api.ReloadClusterCert(apiBP); err != nil {
+		return V(err)
+	}
+	actionDone(c, "cluster: reloaded TLS certificates")
+	return nil
+}
@@ -38,13 +38,14 @@ const (
 	flagsAuthRevokeToken = "revoke_token"
 	flagsAuthRoleShow    = "role_show"
 	flagsAuthConfShow    = "conf_show"
+	flagsAuthAccountAdd  = "account_add"
 )
 
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
 
 var (
 	authFlags = map[string][]cli.Flag{
-		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag},
+		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag, apiKeyFlag},
 		flagsAuthUserLogout:  {tokenFileFlag},
 		cmdAuthUser:          {passwordFlag},
 		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag},
@@ -52,6 +53,7 @@ var (
 		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
 		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
 		flagsAuthConfShow:    {jsonFlag},
+		flagsAuthAccountAdd:  {descAccountFlag, bucketsAccountFlag},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -80,6 +82,13 @@ var (
 				ArgsUsage: showAuthUserListArgument,
 				Action:    wrapAuthN(showAuthUserHandler),
 			},
+			{
+				Name:         cmdAuthAccount,
+				Usage:        "show service accounts (API keys are never shown)",
+				ArgsUsage:    showAuthAccountArgument,
+				Action:       wrapAuthN(showAuthAccountHandler),
+				BashComplete: oneAccountCompletions,
+			},
 			{
 				Name:   cmdAuthConfig,
 				Usage:  "show AuthN server configuration",
@@ -98,7 +107,7 @@ var (
 			// add
 			{
 				Name:  cmdAuthAdd,
-				Usage: "add AuthN entity: user, role, AIS cluster",
+				Usage: "add AuthN entity: user, role, AIS cluster, service account",
 				Subcommands: []cli.Command{
 					{
 						Name:         cmdAuthUser,
@@ -122,6 +131,16 @@ var (
 						Action:       wrapAuthN(addAuthRoleHandler),
 						BashComplete: addRoleCompletions,
 					},
+					{
+						Name: cmdAuthAccount,
+						Usage: "add service account owned by an existing user and generate its API key\n" +
+							indent4 + "\t(the account's permissions are a subset of the owner's, optionally limited\n" +
+							indent4 + "\tto the specified permissions and/or buckets)",
+						ArgsUsage:    addAuthAccountArgument,
+						Flags:        authFlags[flagsAuthAccountAdd],
+						Action:       wrapAuthN(addAuthAccountHandler),
+						BashComplete: accountOwnerCompletions,
+					},
 				},
 			},
 			// rm
@@ -150,6 +169,13 @@ var (
 						Action:       wrapAuthN(deleteRoleHandler),
 						BashComplete: oneRoleCompletions,
 					},
+					{
+						Name:         cmdAuthAccount,
+						Usage:        "remove service account (and revoke its API key)",
+						ArgsUsage:    deleteAuthAccountArgument,
+						Action:       wrapAuthN(deleteAccountHandler),
+						BashComplete: oneAccountCompletions,
+					},
 					{
 						Name:      cmdAuthToken,
 						Usage:     "revoke AuthN token",
@@ -198,7 +224,7 @@ var (
 			// login, logout
 			{
 				Name:      cmdAuthLogin,
-				Usage:     "log in with existing user ID and password",
+				Usage:     "log in with existing user ID and password or with service account's API key",
 				Flags:     authFlags[flagsAuthUserLogin],
 				ArgsUsage: userLoginArgument,
 				Action:    wrapAuthN(loginUserHandler),
@@ -352,8 +378,7 @@ func deleteRoleHandler(c *cli.Context) (err error) {
 func loginUserHandler(c *cli.Context) (err error) {
 	var (
 		expireIn *time.Duration
-		name     = cliAuthnUserName(c)
-		password = cliAuthnUserPassword(c, false)
+		token    *authn.TokenMsg
 		cluID    = parseStrFlag(c, clusterTokenFlag)
 	)
 	if flagIsSet(c, expireFlag) {
@@ -364,7 +389,15 @@ func loginUserHandler(c *cli.Context) (err error) {
 			return err
 		}
 	}
-	token, err := authn.LoginUser(authParams, name, password, cluID, expireIn)
+	if flagIsSet(c, apiKeyFlag) {
+		token, err = authn.LoginAPIKey(authParams, parseStrFlag(c, apiKeyFlag), cluID, expireIn)
+	} else {
+		var (
+			name     = cliAuthnUserName(c)
+			password = cliAuthnUserPassword(c, false)
+		)
+		token, err = authn.LoginUser(authParams, name, password, cluID, expireIn)
+	}
 	if err != nil {
 		return err
 	}
@@ -382,6 +415,63 @@ func loginUserHandler(c *cli.Context) (err error) {
 	return nil
 }
 
+func addAuthAccountHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	sa := &authn.ServiceAccount{
+		ID:    c.Args().Get(0),
+		Owner: c.Args().Get(1),
+		Desc:  parseStrFlag(c, descAccountFlag),
+	}
+	for i := 2; i < c.NArg(); i++ {
+		p, err := apc.StrToAccess(c.Args().Get(i))
+		if err != nil {
+			return err
+		}
+		sa.Access |= p
+	}
+	if flagIsSet(c, bucketsAccountFlag) {
+		for _, uri := range splitCsv(parseStrFlag(c, bucketsAccountFlag)) {
+			bck, err := parseBckURI(c, uri, true /*error only*/)
+			if err != nil {
+				return err
+			}
+			sa.Buckets = append(sa.Buckets, bck)
+		}
+	}
+	added, err := authn.AddServiceAccount(authParams, sa)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "Added service account %q (owner %q). API key:\n%s\n", added.ID, added.Owner, added.Key)
+	actionWarn(c, "make sure to save the API key - it cannot be retrieved later\n")
+	return nil
+}
+
+func deleteAccountHandler(c *cli.Context) error {
+	id := c.Args().Get(0)
+	if id == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteServiceAccount(authParams, id)
+}
+
+func showAuthAccountHandler(c *cli.Context) error {
+	if id := c.Args().Get(0); id != "" {
+		sa, err := authn.GetServiceAccount(authParams, id)
+		if err != nil {
+			return err
+		}
+		return teb.Print([]*authn.ServiceAccount{sa}, teb.AuthNAccountTmpl)
+	}
+	list, err := authn.GetAllServiceAccounts(authParams)
+	if err != nil {
+		return err
+	}
+	return teb.Print(list, teb.AuthNAccountTmpl)
+}
+
 func logoutUserHandler(c *cli.Context) (err error) {
 	tokenFile, err := tokfile(c)
 	if err != nil {
@@ -632,7 +722,8 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 		Desc: parseStrFlag(c, descRoleFlag),
 	}
 	if bucket != "" {
-		bck, err := parseBckURI(c, bucket, false)
+		// optional object name prefix, e.g. ais://datasets/teamA/
+		bck, prefix, err := parseBckObjURI(c, bucket, true /*emptyObjnameOK*/)
 		if err != nil {
 			return nil, err
 		}
@@ -641,6 +732,7 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 			{
 				Bck:    bck,
 				Access: perms,
+				Prefix: prefix,
 			},
 		}
 	} else {
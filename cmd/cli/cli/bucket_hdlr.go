@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
 
@@ -51,6 +52,8 @@ var (
 			copyDryRunFlag,
 			copyPrependFlag,
 			copyObjPrefixFlag,
+			dropSamplesExtFlag,
+			dropSamplesIfFlag,
 			listFlag,
 			templateFlag,
 			progressFlag,
@@ -62,11 +65,19 @@ var (
 			waitFlag,
 			waitJobXactFinishedFlag,
 		},
+		commandSync: {
+			syncObjPrefixFlag,
+			syncDeleteFlag,
+			syncDryRunFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
 		commandEvict: append(
 			listrangeFlags,
 			dryRunFlag,
 			keepMDFlag,
 			verboseFlag,
+			forceFlag,
 		),
 		cmdSetBprops: {
 			forceFlag,
@@ -94,6 +105,7 @@ var (
 			dontHeadRemoteFlag,
 			dontAddRemoteFlag,
 			listArchFlag,
+			listArchSamplesFlag,
 			unitsFlag,
 			silentFlag,
 			dontWaitFlag,
@@ -156,7 +168,8 @@ var (
 			indent1 + "(to select, use '--list' or '--template'), e.g.:\n" +
 			indent1 + "\t* gs://abc\t- evict entire bucket (all gs://abc objects in aistore);\n" +
 			indent1 + "\t* gs:\t- evict all GCP buckets;\n" +
-			indent1 + "\t* gs://abc --template images/\t- evict all objects from the virtual subdirectory called \"images\"",
+			indent1 + "\t* gs://abc --template images/\t- evict all objects from the virtual subdirectory called \"images\";\n" +
+			indent1 + "pinned objects (see 'ais object pin') are not evicted unless '--force' is specified",
 		ArgsUsage:    optionalObjectsArgument,
 		Flags:        bucketCmdsFlags[commandEvict],
 		Action:       evictHandler,
@@ -170,6 +183,16 @@ var (
 		Action:       copyBucketHandler,
 		BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0, 2),
 	}
+	bucketCmdSync = cli.Command{
+		Name: commandSync,
+		Usage: "sync destination bucket with the source: copy new and modified objects and, optionally,\n" +
+			indent1 + "delete destination objects that are not present in the source (objects are compared by name, size,\n" +
+			indent1 + "checksum, and version; use '--dry-run' to only count the differences)",
+		ArgsUsage:    bucketSrcArgument + " " + bucketDstArgument,
+		Flags:        bucketCmdsFlags[commandSync],
+		Action:       syncBucketHandler,
+		BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0, 2),
+	}
 	bucketCmdRename = cli.Command{
 		Name:         commandRename,
 		Usage:        "rename/move ais bucket",
@@ -196,6 +219,7 @@ var (
 				Action:    createBucketHandler,
 			},
 			bucketCmdCopy,
+			bucketCmdSync,
 			bucketCmdRename,
 			{
 				Name:      commandRemove,
@@ -359,6 +383,45 @@ func mvBucketHandler(c *cli.Context) error {
 	return mvBucket(c, bckFrom, bckTo)
 }
 
+func syncBucketHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bckFrom, bckTo, err := parseBcks(c, bucketSrcArgument, bucketDstArgument, 0 /*shift*/)
+	if err != nil {
+		return err
+	}
+	if bckFrom.Equal(&bckTo) {
+		return incorrectUsageMsg(c, errFmtSameBucket, commandSync, bckTo)
+	}
+	msg := &apc.SyncBckMsg{
+		Prefix: parseStrFlag(c, syncObjPrefixFlag),
+		Delete: flagIsSet(c, syncDeleteFlag),
+		DryRun: flagIsSet(c, syncDryRunFlag),
+	}
+	if msg.DryRun {
+		dryRunCptn(c)
+	}
+	xid, err := api.SyncBucket(apiBP, bckFrom, bckTo, msg)
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(apc.ActSyncBck)
+	text := fmt.Sprintf("Started %s[%s] %s => %s. ", xname, xid, bckFrom.Cname(msg.Prefix), bckTo.Cname(msg.Prefix))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+	actionDone(c, text)
+	if err := waitJob(c, apc.ActSyncBck, xid, bckFrom); err != nil {
+		return err
+	}
+	if s := toShowMsg(c, xid, "To see the differences (sync.* counters)", true); msg.DryRun && s != "" {
+		fmt.Fprintln(c.App.Writer, s)
+	}
+	return nil
+}
+
 func removeBucketHandler(c *cli.Context) error {
 	buckets, err := bucketsFromArgsOrEnv(c)
 	if err != nil {
@@ -625,7 +688,7 @@ func listAnyHandler(c *cli.Context) error {
 		return listOrSummBuckets(c, cmn.QueryBcks(bck), lsb)
 	default: // list objects
 		prefix := parseStrFlag(c, listObjPrefixFlag)
-		listArch := flagIsSet(c, listArchFlag) // include archived content, if requested
+		listArch := flagIsSet(c, listArchFlag) || flagIsSet(c, listArchSamplesFlag) // include archived content, if requested
 		return listObjects(c, bck, prefix, listArch)
 	}
 }
@@ -47,6 +47,7 @@ var (
 		"compression.checksum":                apc.SupportedCompression,
 		"rebalance.compression":               apc.SupportedCompression,
 		"distributed_sort.compression":        apc.SupportedCompression,
+		"transport.compressor":                apc.SupportedCompressors,
 		"distributed_sort.duplicated_records": cmn.SupportedReactions,
 		"distributed_sort.ekm_malformed_line": cmn.SupportedReactions,
 		"distributed_sort.ekm_missing_key":    cmn.SupportedReactions,
@@ -714,6 +715,36 @@ func oneUserCompletionsWithRoles(c *cli.Context) {
 	}
 }
 
+// NAME OWNER [PERMISSION ...]
+func accountOwnerCompletions(c *cli.Context) {
+	switch c.NArg() {
+	case 0:
+	case 1:
+		userList, err := authn.GetAllUsers(authParams)
+		if err != nil {
+			return
+		}
+		for _, user := range userList {
+			fmt.Println(user.ID)
+		}
+	default:
+		accessCompletions(c)
+	}
+}
+
+func oneAccountCompletions(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	list, err := authn.GetAllServiceAccounts(authParams)
+	if err != nil {
+		return
+	}
+	for _, sa := range list {
+		fmt.Println(sa.ID)
+	}
+}
+
 func oneClusterCompletions(c *cli.Context) {
 	if c.NArg() > 0 {
 		return
@@ -268,6 +268,8 @@ func setcfg(c *cli.Context, nvs cos.StrKVs) error {
 			jsoniter.Unmarshal([]byte(v), &toUpdate.Backend)
 		case k == "mirror" || strings.HasPrefix(k, "mirror."):
 			jsoniter.Unmarshal([]byte(v), &toUpdate.Mirror)
+		case k == "replication" || strings.HasPrefix(k, "replication."):
+			jsoniter.Unmarshal([]byte(v), &toUpdate.Repl)
 		case k == "ec" || strings.HasPrefix(k, "ec."):
 			jsoniter.Unmarshal([]byte(v), &toUpdate.EC)
 		case k == "log" || strings.HasPrefix(k, "log."):
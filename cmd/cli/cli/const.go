@@ -49,6 +49,7 @@ const (
 	cmdRandNode      = "random-node"
 	cmdRandMountpath = "random-mountpath"
 	cmdRotateLogs    = "rotate-logs"
+	cmdReloadCert    = apc.ActReloadCert
 )
 
 // - 2nd level subcommands (mostly, verbs)
@@ -69,11 +70,15 @@ const (
 	commandStart     = apc.ActXactStart
 	commandStop      = apc.ActXactStop
 	commandWait      = "wait"
+	commandResume    = "resume"
+	commandSchedule  = "schedule"
+	commandSync      = "sync"
 
 	cmdSmap   = apc.WhatSmap
 	cmdBMD    = apc.WhatBMD
 	cmdConfig = "config" // apc.WhatNodeConfig and apc.WhatClusterConfig
 	cmdLog    = apc.WhatLog
+	cmdAudit  = apc.WhatAuditLog
 
 	cmdBucket = "bucket"
 	cmdObject = "object"
@@ -88,14 +93,18 @@ const (
 	commandMirror   = "mirror"   // display name for apc.ActMakeNCopies
 	commandEvict    = "evict"    // apc.ActEvictRemoteBck or apc.ActEvictObjects
 	commandPrefetch = "prefetch" // apc.ActPrefetchObjects
+	commandPin      = "pin"      // apc.ActPinObjects
+	commandUnpin    = "unpin"    // apc.ActUnpinObjects
 
 	cmdDownload    = apc.ActDownload
+	cmdBlobDl      = apc.ActBlobDl
 	cmdDsort       = apc.ActDsort
 	cmdRebalance   = apc.ActRebalance
 	cmdLRU         = apc.ActLRU
 	cmdStgCleanup  = "cleanup" // display name for apc.ActStoreCleanup
 	cmdStgValidate = "validate"
 	cmdSummary     = "summary" // ditto apc.ActSummaryBck
+	cmdInventory   = apc.ActInventory
 
 	cmdCluster    = commandCluster
 	cmdNode       = "node"
@@ -156,6 +165,7 @@ const (
 	cmdAuthRole    = "role"
 	cmdAuthCluster = cmdCluster
 	cmdAuthToken   = "token"
+	cmdAuthAccount = "account"
 	cmdAuthConfig  = cmdConfig
 
 	// K8s subcommans
@@ -169,6 +179,9 @@ const (
 	cmdCode    = "code"
 	cmdDetails = "details"
 
+	// job schedule subcommands
+	cmdSchedAdd = "add"
+
 	// config subcommands
 	cmdCLI        = "cli"
 	cmdCLIShow    = commandShow
@@ -318,6 +331,9 @@ const (
 	showAuthUserListArgument  = "[USER_NAME]"
 	addSetAuthRoleArgument    = "ROLE [PERMISSION ...]"
 	deleteAuthRoleArgument    = "ROLE"
+	addAuthAccountArgument    = "NAME OWNER [PERMISSION ...]"
+	showAuthAccountArgument   = "[NAME]"
+	deleteAuthAccountArgument = "NAME"
 	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE" //nolint:gosec // false positive G101
 
 	// Alias
@@ -410,6 +426,28 @@ var (
 			indent4 + "\ta/b that have names (relative to this directory) starting with the letter c",
 	}
 
+	invPrefixFlag = cli.StringFlag{
+		Name:  "prefix",
+		Usage: "inventory only those objects that start with the specified prefix (virtual directory)",
+	}
+	invDirFlag = cli.StringFlag{
+		Name: "dir",
+		Usage: "destination virtual directory for the inventory chunks and manifests\n" +
+			indent4 + "\t(default: \"inventory/<provider>/<source bucket name>\")",
+	}
+	invFormatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "inventory format: gzipped \"" + apc.InvFormatCSV + "\" (default) or gzipped JSON lines (\"" + apc.InvFormatJSONL + "\")",
+	}
+	invChunkEntriesFlag = cli.IntFlag{
+		Name:  "chunk-entries",
+		Usage: "maximum number of objects listed in a single inventory chunk (zero: use the default)",
+	}
+	invCachedFlag = cli.BoolFlag{
+		Name:  "cached",
+		Usage: "inventory only those objects from a remote bucket that are present (\"cached\")",
+	}
+
 	//
 	// longRunFlags
 	//
@@ -539,6 +577,18 @@ var (
 		Value: logFlushTime,
 	}
 
+	// Audit log (filters)
+	auditSinceFlag = DurationFlag{
+		Name:  "since",
+		Usage: "show only the entries logged within the specified time interval, e.g.: '--since 1h'",
+	}
+	auditUserFlag = cli.StringFlag{Name: "user", Usage: "show only the requests made by the specified user (or service account)"}
+	auditOpFlag   = cli.StringFlag{
+		Name:  "op",
+		Usage: "show only the specified operation: action (e.g., '--op destroy-bck') or HTTP verb (e.g., '--op DELETE')",
+	}
+	auditBucketFlag = cli.StringFlag{Name: "bucket", Usage: "show only the requests to the specified bucket, e.g.: '--bucket ais://abc'"}
+
 	// Download
 	descJobFlag = cli.StringFlag{Name: "description,desc", Usage: "job description"}
 
@@ -569,6 +619,15 @@ var (
 		Name:  "object-list,from",
 		Usage: "path to file containing JSON array of object names to download",
 	}
+	dloadManifestFlag = cli.StringFlag{
+		Name: "manifest",
+		Usage: "download manifest: HTTP(S) URL or AIS object (JSON lines or CSV: link, object name, size, checksum type and value),\n" +
+			indent4 + "\te.g.: 'ais start download --manifest ais://abc/manifest.jsonl ais://dst'",
+	}
+	dloadVerifyRetriesFlag = cli.IntFlag{
+		Name:  "verify-retries",
+		Usage: "number of times to retry download that fails manifest (size, checksum) verification",
+	}
 	syncFlag = cli.BoolFlag{Name: "sync", Usage: "sync bucket with Cloud"}
 
 	// dsort
@@ -717,6 +776,10 @@ var (
 		Name:  "chunk-size",
 		Usage: "chunk size in IEC or SI units, or \"raw\" bytes (e.g.: 1MiB or 1048576; see '--units')",
 	}
+	numWorkersFlag = cli.IntFlag{
+		Name:  "num-workers",
+		Usage: "number of concurrent workers (zero: use the default)",
+	}
 
 	cksumFlag = cli.BoolFlag{Name: "checksum", Usage: "validate checksum"}
 
@@ -742,15 +805,44 @@ var (
 	descRoleFlag      = cli.StringFlag{Name: "description,desc", Usage: "role description"}
 	clusterRoleFlag   = cli.StringFlag{Name: "cluster", Usage: "associate role with the specified AIS cluster"}
 	clusterTokenFlag  = cli.StringFlag{Name: "cluster", Usage: "issue token for the cluster"}
-	bucketRoleFlag    = cli.StringFlag{Name: "bucket", Usage: "associate a role with the specified bucket"}
+	bucketRoleFlag    = cli.StringFlag{Name: "bucket", Usage: "associate a role with the specified bucket (and, optionally, object name prefix, e.g. ais://abc/images/)"}
 	clusterFilterFlag = cli.StringFlag{
 		Name:  "cluster",
 		Usage: "comma-separated list of AIS cluster IDs (type ',' for an empty cluster ID)",
 	}
+	descAccountFlag    = cli.StringFlag{Name: "description,desc", Usage: "service account description"}
+	bucketsAccountFlag = cli.StringFlag{
+		Name:  "buckets",
+		Usage: "comma-separated list of buckets to restrict service account to, e.g. 'ais://abc,s3://xyz'",
+	}
+	apiKeyFlag = cli.StringFlag{
+		Name:  "api-key",
+		Usage: "log in with service account's API key (instead of user name and password)",
+	}
 
 	// archive
 	listArchFlag = cli.BoolFlag{Name: "archive", Usage: "list archived content (see docs/archive.md for details)"}
 
+	// WebDataset samples: archived files that share the same name sans extension
+	listArchSamplesFlag = cli.BoolFlag{
+		Name:  "samples",
+		Usage: "list archived content as WebDataset samples (implies " + qflprn(listArchFlag) + ")",
+	}
+	archSampleGetFlag = cli.StringFlag{
+		Name:  "sample",
+		Usage: "get all files of the specified WebDataset sample from an archive (shard) as a TAR",
+	}
+	dropSamplesExtFlag = cli.StringFlag{
+		Name: "drop-samples-ext",
+		Usage: "copy shards while dropping WebDataset samples that contain files with any of the specified\n" +
+			indent4 + "\tcomma-separated extensions, e.g.: '.png,.cls'",
+	}
+	dropSamplesIfFlag = cli.StringFlag{
+		Name: "drop-samples-if",
+		Usage: "copy shards while dropping WebDataset samples with the JSON sidecar ('.json') field equal to value, e.g.:\n" +
+			indent4 + "\t'meta.label=cat' (or, simply, 'meta.label' to drop samples that have the field)",
+	}
+
 	archpathFlag = cli.StringFlag{
 		Name:  "archpath",
 		Usage: "filename in archive (shard)",
@@ -799,6 +891,22 @@ var (
 		Value: 24 * time.Hour,
 	}
 
+	// Sync Bucket
+	syncObjPrefixFlag = cli.StringFlag{
+		Name: "prefix",
+		Usage: "sync only those source and destination objects that start with the specified prefix, e.g.:\n" +
+			indent4 + "\t'--prefix a/b/c' - sync virtual directory a/b/c and/or objects from the virtual directory\n" +
+			indent4 + "\ta/b that have their names (relative to this directory) starting with the letter c",
+	}
+	syncDeleteFlag = cli.BoolFlag{
+		Name:  "delete",
+		Usage: "delete destination objects that are not present in the source bucket",
+	}
+	syncDryRunFlag = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "count new, modified, and extraneous (see '--delete') objects without copying or deleting anything",
+	}
+
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
 		Name:  "dry-run",
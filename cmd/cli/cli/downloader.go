@@ -421,6 +421,9 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 		if d.ErrorCnt > 0 {
 			errs = fmt.Sprintf(", error%s: %d", cos.Plural(d.ErrorCnt), d.ErrorCnt)
 		}
+		if d.ResumedSize > 0 {
+			skipped += ", resumed: " + cos.ToSizeIEC(d.ResumedSize, 2)
+		}
 		fmt.Fprintf(w, "Done: %d file%s downloaded%s%s\n", d.FinishedCnt, cos.Plural(d.FinishedCnt), skipped, errs)
 
 		if len(d.Errs) == 0 {
@@ -468,8 +471,12 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 					fmt.Fprintln(w, cos.ToSizeIEC(task.Downloaded, 2))
 				} else {
 					pctDownloaded := 100 * float64(task.Downloaded) / float64(task.Total)
-					fmt.Fprintf(w, "%s/%s (%.2f%%)\n",
+					fmt.Fprintf(w, "%s/%s (%.2f%%)",
 						cos.ToSizeIEC(task.Downloaded, 2), cos.ToSizeIEC(task.Total, 2), pctDownloaded)
+					if task.Resumed > 0 {
+						fmt.Fprintf(w, ", resumed at %s", cos.ToSizeIEC(task.Resumed, 2))
+					}
+					fmt.Fprintln(w)
 				}
 			}
 		}
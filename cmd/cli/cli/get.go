@@ -90,6 +90,21 @@ func getHandler(c *cli.Context) error {
 				qflprn(lengthFlag), qflprn(offsetFlag), extractVia)
 		}
 	}
+	if flagIsSet(c, archSampleGetFlag) {
+		if archpath != "" {
+			return fmt.Errorf(errFmtExclusive, qflprn(archSampleGetFlag), qflprn(archpathGetFlag))
+		}
+		if extract {
+			return fmt.Errorf(errFmtExclusive, extractVia, qflprn(archSampleGetFlag))
+		}
+		if flagIsSet(c, getObjPrefixFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(getObjPrefixFlag), qflprn(archSampleGetFlag))
+		}
+		if flagIsSet(c, lengthFlag) {
+			return fmt.Errorf("read range (%s, %s) of archived samples (%s) is not implemented yet",
+				qflprn(lengthFlag), qflprn(offsetFlag), qflprn(archSampleGetFlag))
+		}
+	}
 	if archpath != "" {
 		if flagIsSet(c, getObjPrefixFlag) {
 			return fmt.Errorf(errFmtExclusive, qflprn(getObjPrefixFlag), qflprn(archpathGetFlag))
@@ -416,6 +431,12 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 		}
 		getArgs.Query.Set(apc.QparamArchpath, archpath)
 	}
+	if flagIsSet(c, archSampleGetFlag) {
+		if getArgs.Query == nil {
+			getArgs.Query = make(url.Values, 1)
+		}
+		getArgs.Query.Set(apc.QparamArchSample, parseStrFlag(c, archSampleGetFlag))
+	}
 	if flagIsSet(c, silentFlag) {
 		if getArgs.Query == nil {
 			getArgs.Query = make(url.Values, 1)
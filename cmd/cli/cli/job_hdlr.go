@@ -37,7 +37,9 @@ var (
 		jobStartSub,
 		jobStopSub,
 		jobWaitSub,
+		jobResumeSub,
 		jobRemoveSub,
+		jobScheduleSub,
 		makeAlias(showCmdJob, "", true, commandShow), // alias for `ais show`
 	}
 )
@@ -54,6 +56,8 @@ var (
 			descJobFlag,
 			limitConnectionsFlag,
 			objectsListFlag,
+			dloadManifestFlag,
+			dloadVerifyRetriesFlag,
 			dloadProgressFlag,
 			progressFlag,
 			waitFlag,
@@ -74,6 +78,21 @@ var (
 			lruBucketsFlag,
 			forceFlag,
 		},
+		cmdBlobDl: {
+			chunkSizeFlag,
+			numWorkersFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
+		cmdInventory: {
+			invPrefixFlag,
+			invDirFlag,
+			invFormatFlag,
+			invChunkEntriesFlag,
+			invCachedFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
 	}
 
 	jobStartResilver = cli.Command{
@@ -104,7 +123,29 @@ var (
 				Flags:     startSpecialFlags[cmdDownload],
 				Action:    startDownloadHandler,
 			},
+			{
+				Name: cmdBlobDl,
+				Usage: "cold-GET a large remote object in chunks, via concurrent range reads, e.g.:\n" +
+					indent1 + "\t- 'ais start blob-download s3://abc/large --chunk-size 64MiB --num-workers 16'\n" +
+					indent1 + "(see also: bucket property 'blob_download' to do the same upon regular GET)",
+				ArgsUsage:    objectArgument,
+				Flags:        startSpecialFlags[cmdBlobDl],
+				Action:       startBlobDlHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
 			dsortStartCmd,
+			{
+				Name: cmdInventory,
+				Usage: "generate bucket inventory: complete listing of the source bucket stored as gzipped chunks\n" +
+					indent1 + "\tin the destination bucket, e.g.:\n" +
+					indent1 + "\t- 'ais start inventory s3://abc ais://inv' - CSV inventory of s3://abc in ais://inv/inventory/aws/abc/<job ID>/;\n" +
+					indent1 + "\t- 'ais start inventory ais://abc ais://inv --format jsonl --prefix images/'\n" +
+					indent1 + "(see also: 'ais job schedule' to generate inventories periodically)",
+				ArgsUsage:    bucketSrcArgument + " " + bucketDstArgument,
+				Flags:        startSpecialFlags[cmdInventory],
+				Action:       startInventoryHandler,
+				BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0, 2),
+			},
 			{
 				Name:         cmdLRU,
 				Usage:        "run LRU eviction",
@@ -167,6 +208,24 @@ var (
 	}
 )
 
+// ais job resume
+var (
+	jobResumeSub = cli.Command{
+		Name:  commandResume,
+		Usage: "resume aborted job",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdDsort,
+				Usage: "resume aborted dsort job under the same job ID, starting from the last completed phase\n" +
+					indent1 + "and skipping already created output shards",
+				ArgsUsage:    jobIDArgument,
+				Action:       resumeDsortHandler,
+				BashComplete: dsortIDFinishedCompletions,
+			},
+		},
+	}
+)
+
 // ais job remove
 var (
 	removeCmdsFlags = []cli.Flag{
@@ -322,6 +381,83 @@ func startXaction(c *cli.Context, xname string, bck cmn.Bck, sid string) error {
 	return waitJob(c, xname, xid, bck)
 }
 
+func startBlobDlHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if bck.IsAIS() {
+		return fmt.Errorf("cannot %s from ais bucket %s (the operation applies to remote buckets only)",
+			cmdBlobDl, bck.Cname(""))
+	}
+	msg := &apc.BlobMsg{NumWorkers: parseIntFlag(c, numWorkersFlag)}
+	if flagIsSet(c, chunkSizeFlag) {
+		if msg.ChunkSize, err = parseSizeFlag(c, chunkSizeFlag); err != nil {
+			return err
+		}
+	}
+	xid, err := api.BlobDownload(apiBP, bck, objName, msg)
+	if err != nil {
+		return V(err)
+	}
+	if xid == "" {
+		actionDone(c, bck.Cname(objName)+" is already present in the cluster - nothing to do")
+		return nil
+	}
+	actionDone(c, fmt.Sprintf("Started %s[%s]. %s", cmdBlobDl, xid, toMonitorMsg(c, xid, "")))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		return nil
+	}
+	return waitJob(c, apc.ActBlobDl, xid, bck)
+}
+
+func startInventoryHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() == 1 {
+		return missingArgumentsError(c, bucketDstArgument)
+	}
+	if c.NArg() > 2 {
+		return incorrectUsageMsg(c, "", c.Args()[2:])
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	bckTo, err := parseBckURI(c, c.Args().Get(1), false)
+	if err != nil {
+		return err
+	}
+	msg := &cmn.InventoryMsg{
+		ToBck:        bckTo,
+		Prefix:       parseStrFlag(c, invPrefixFlag),
+		Dir:          parseStrFlag(c, invDirFlag),
+		Format:       parseStrFlag(c, invFormatFlag),
+		ChunkEntries: parseIntFlag(c, invChunkEntriesFlag),
+		ObjCached:    flagIsSet(c, invCachedFlag),
+	}
+	if err := msg.Validate(&bck); err != nil {
+		return err
+	}
+	xid, err := api.MakeInventory(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Started %s[%s] => %s. %s", cmdInventory, xid, bckTo.Cname(msg.ObjDir(xid)),
+		toMonitorMsg(c, xid, "")))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		return nil
+	}
+	return waitJob(c, apc.ActInventory, xid, bck)
+}
+
 func startDownloadHandler(c *cli.Context) error {
 	var (
 		description      = parseStrFlag(c, descJobFlag)
@@ -330,6 +466,9 @@ func startDownloadHandler(c *cli.Context) error {
 		progressInterval = parseStrFlag(c, dloadProgressFlag)
 		id               string
 	)
+	if flagIsSet(c, dloadManifestFlag) {
+		return startManifestDlHandler(c, description, timeout, progressInterval)
+	}
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
@@ -480,7 +619,55 @@ func startDownloadHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	return dlStarted(c, id)
+}
 
+// multi-object download via manifest (with expected sizes and checksums, if any)
+func startManifestDlHandler(c *cli.Context, description, timeout, progressInterval string) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, "destination")
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "%s: expecting destination bucket (and no source), got %v",
+			qflprn(dloadManifestFlag), c.Args())
+	}
+	bck, pathSuffix, err := parseDest(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	if pathSuffix != "" {
+		return incorrectUsageMsg(c, "%s: destination object names are specified by the manifest (got %q)",
+			qflprn(dloadManifestFlag), pathSuffix)
+	}
+	limitBPH, err := parseSizeFlag(c, limitBytesPerHourFlag)
+	if err != nil {
+		return err
+	}
+	if _, err := time.ParseDuration(progressInterval); err != nil {
+		return err
+	}
+	payload := dload.MultiBody{
+		Base: dload.Base{
+			Bck:              bck,
+			Timeout:          timeout,
+			Description:      description,
+			ProgressInterval: progressInterval,
+			Limits: dload.Limits{
+				Connections:  parseIntFlag(c, limitConnectionsFlag),
+				BytesPerHour: int(limitBPH),
+			},
+		},
+		Manifest:      parseStrFlag(c, dloadManifestFlag),
+		VerifyRetries: parseIntFlag(c, dloadVerifyRetriesFlag),
+	}
+	id, err := api.DownloadWithParam(apiBP, dload.TypeMulti, payload)
+	if err != nil {
+		return err
+	}
+	return dlStarted(c, id)
+}
+
+func dlStarted(c *cli.Context, id string) error {
 	fmt.Fprintf(c.App.Writer, "Started download job %s\n", id)
 
 	if flagIsSet(c, progressFlag) {
@@ -1151,6 +1338,18 @@ func removeDsortHandler(c *cli.Context) error {
 	return nil
 }
 
+func resumeDsortHandler(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return missingArgumentsError(c, jobIDArgument)
+	}
+	id := c.Args().Get(0)
+	if _, err := api.ResumeDsort(apiBP, id); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Resumed dsort job %q", id))
+	return nil
+}
+
 func removeDsortRegex(c *cli.Context, regex string) error {
 	dsortLst, err := api.ListDsort(apiBP, regex, false /*onlyActive*/)
 	if err != nil {
@@ -11,12 +11,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/sys"
@@ -38,6 +40,13 @@ var (
 			yesFlag,
 			allLogsFlag,
 		),
+		cmdAudit: {
+			auditSinceFlag,
+			auditUserFlag,
+			auditOpFlag,
+			auditBucketFlag,
+			jsonFlag,
+		},
 	}
 
 	// 'show log' and 'log show'
@@ -69,13 +78,26 @@ var (
 		},
 	}
 
+	auditCmdLog = cli.Command{
+		Name: cmdAudit,
+		Usage: "show audit log entries (see 'log.audit' configuration) from a selected node or all nodes, e.g.:\n" +
+			indent4 + "\t - 'ais log audit' - all recorded client requests, cluster-wide;\n" +
+			indent4 + "\t - 'ais log audit --since 1h --op destroy-bck' - buckets destroyed during the last hour;\n" +
+			indent4 + "\t - 'ais log audit NODE_ID --user alice --bucket ais://abc' - requests by user 'alice' to ais://abc (via a given node)",
+		ArgsUsage:    optionalNodeIDArgument,
+		Flags:        nodeLogFlags[cmdAudit],
+		Action:       auditLogHandler,
+		BashComplete: suggestAllNodes,
+	}
+
 	// top-level
 	logCmd = cli.Command{
 		Name:  commandLog,
-		Usage: "view ais node's log in real time; download the current log; download all logs (history)",
+		Usage: "view ais node's log in real time; download the current log; download all logs (history); show audit log",
 		Subcommands: []cli.Command{
 			makeAlias(showCmdLog, "", true, commandShow),
 			getCmdLog,
+			auditCmdLog,
 		},
 	}
 )
@@ -324,6 +346,55 @@ func _currentLog(c *cli.Context) error {
 	return V(err)
 }
 
+func auditLogHandler(c *cli.Context) error {
+	var (
+		nodes []*meta.Snode
+		args  = api.GetAuditInput{
+			User: parseStrFlag(c, auditUserFlag),
+			Op:   parseStrFlag(c, auditOpFlag),
+		}
+	)
+	if flagIsSet(c, auditSinceFlag) {
+		args.Since = time.Now().Add(-parseDurationFlag(c, auditSinceFlag))
+	}
+	if flagIsSet(c, auditBucketFlag) {
+		bck, err := parseBckURI(c, parseStrFlag(c, auditBucketFlag), false)
+		if err != nil {
+			return err
+		}
+		args.Bucket = bck.Cname("")
+	}
+	if c.NArg() > 0 {
+		node, _, err := getNode(c, c.Args().Get(0))
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, node)
+	} else {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return err
+		}
+		for _, nodeMap := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+			for _, si := range nodeMap {
+				nodes = append(nodes, si)
+			}
+		}
+	}
+
+	var entries []*apc.AuditEntry
+	for _, si := range nodes {
+		list, err := api.GetAuditLog(apiBP, si, args)
+		if err != nil {
+			actionWarn(c, si.StringEx()+" returned error: "+V(err).Error())
+			continue
+		}
+		entries = append(entries, list...)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+	return teb.Print(entries, teb.AuditLogTmpl, teb.Jopts(flagIsSet(c, jsonFlag)))
+}
+
 func parseLogSev(c *cli.Context) (sev string, err error) {
 	sev = strings.ToLower(parseStrFlag(c, logSevFlag))
 	if sev != "" {
@@ -291,6 +291,9 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	}
 	if listArch {
 		msg.SetFlag(apc.LsArchDir)
+		if flagIsSet(c, listArchSamplesFlag) {
+			msg.SetFlag(apc.LsArchSamples)
+		}
 	}
 
 	var (
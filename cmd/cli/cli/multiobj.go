@@ -61,6 +61,9 @@ func multiobjTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etl
 			msg.Timeout = cos.Duration(etlBucketRequestTimeout.Value)
 		}
 		msg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
+		if etlName == "" {
+			msg.Samples = parseSampleFilter(c)
+		}
 	}
 	// 3. start copying/transforming
 	var (
@@ -203,9 +206,23 @@ func _listOp(c *cli.Context, bck cmn.Bck) (xid, xname, text string, num int64, e
 		if err = ensureHasProvider(bck); err != nil {
 			return
 		}
-		xid, err = api.EvictList(apiBP, bck, fileList)
+		xid, err = api.EvictList(apiBP, bck, fileList, flagIsSet(c, forceFlag))
 		kind = apc.ActEvictObjects
 		action = "evict"
+	case commandPin:
+		if err = ensureHasProvider(bck); err != nil {
+			return
+		}
+		xid, err = api.PinList(apiBP, bck, fileList)
+		kind = apc.ActPinObjects
+		action = "pin"
+	case commandUnpin:
+		if err = ensureHasProvider(bck); err != nil {
+			return
+		}
+		xid, err = api.UnpinList(apiBP, bck, fileList)
+		kind = apc.ActUnpinObjects
+		action = "unpin"
 	default:
 		debug.Assert(false, c.Command.Name)
 		return
@@ -263,9 +280,23 @@ func _rangeOp(c *cli.Context, bck cmn.Bck) (xid, xname, text string, num int64,
 		if err = ensureHasProvider(bck); err != nil {
 			return
 		}
-		xid, err = api.EvictRange(apiBP, bck, rangeStr)
+		xid, err = api.EvictRange(apiBP, bck, rangeStr, flagIsSet(c, forceFlag))
 		kind = apc.ActEvictObjects
 		action = "evict"
+	case commandPin:
+		if err = ensureHasProvider(bck); err != nil {
+			return
+		}
+		xid, err = api.PinRange(apiBP, bck, rangeStr)
+		kind = apc.ActPinObjects
+		action = "pin"
+	case commandUnpin:
+		if err = ensureHasProvider(bck); err != nil {
+			return
+		}
+		xid, err = api.UnpinRange(apiBP, bck, rangeStr)
+		kind = apc.ActUnpinObjects
+		action = "unpin"
 	default:
 		debug.Assert(false, c.Command.Name)
 		return
@@ -306,7 +337,7 @@ func multiobjArg(c *cli.Context, command string) error {
 				fmt.Fprintf(c.App.Writer, "Evict: %s\n", bck.Cname(objName))
 				continue
 			}
-			if err := api.EvictObject(apiBP, bck, objName); err != nil {
+			if err := api.EvictObject(apiBP, bck, objName, flagIsSet(c, forceFlag)); err != nil {
 				if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
 					err = &errDoesNotExist{what: "object", name: bck.Cname(objName),
 						suffix: " (not \"cached\")"}
@@ -314,6 +345,20 @@ func multiobjArg(c *cli.Context, command string) error {
 				return V(err)
 			}
 			fmt.Fprintf(c.App.Writer, "evicted %q from %s\n", objName, bck.Cname(""))
+		case commandPin, commandUnpin:
+			if !bck.IsRemote() {
+				return fmt.Errorf("cannot %s objects in %s (not a remote bucket)", command, bck.Cname(""))
+			}
+			var xid string
+			if command == commandPin {
+				xid, err = api.PinList(apiBP, bck, []string{objName})
+			} else {
+				xid, err = api.UnpinList(apiBP, bck, []string{objName})
+			}
+			if err != nil {
+				return V(err)
+			}
+			fmt.Fprintf(c.App.Writer, "%sning %q in %s (job %s)\n", command, objName, bck.Cname(""), xid)
 		}
 	}
 	return nil
@@ -39,6 +39,7 @@ var (
 			progressFlag,
 			// archive
 			archpathGetFlag,
+			archSampleGetFlag,
 			extractFlag,
 			// multi-object options (passed to list-objects)
 			getObjPrefixFlag,
@@ -82,6 +83,8 @@ var (
 			unitsFlag,
 			progressFlag,
 		},
+		commandPin:   listrangeFlags,
+		commandUnpin: listrangeFlags,
 		commandCat: {
 			offsetFlag,
 			lengthFlag,
@@ -164,6 +167,26 @@ var (
 		Action:    setCustomPropsHandler,
 	}
 
+	objectCmdPin = cli.Command{
+		Name: commandPin,
+		Usage: "pin objects in a remote bucket, to keep them \"cached\" regardless of LRU and (non-forced) eviction\n" +
+			indent1 + "(to select multiple objects, use '--list' or '--template'), e.g.:\n" +
+			indent1 + "\t* s3://abc/val-0001.tar\t- pin a single object;\n" +
+			indent1 + "\t* s3://abc --template validation/\t- pin all cached objects in the virtual subdirectory \"validation\"",
+		ArgsUsage:    optionalObjectsArgument,
+		Flags:        objectCmdsFlags[commandPin],
+		Action:       pinHandler,
+		BashComplete: bucketCompletions(bcmplop{multiple: true, separator: true}),
+	}
+	objectCmdUnpin = cli.Command{
+		Name:         commandUnpin,
+		Usage:        "unpin previously pinned objects (to select multiple objects, use '--list' or '--template')",
+		ArgsUsage:    optionalObjectsArgument,
+		Flags:        objectCmdsFlags[commandUnpin],
+		Action:       pinHandler,
+		BashComplete: bucketCompletions(bcmplop{multiple: true, separator: true}),
+	}
+
 	objectCmd = cli.Command{
 		Name:  commandObject,
 		Usage: "put, get, list, rename, remove, and other operations on objects",
@@ -175,6 +198,8 @@ var (
 			objectCmdConcat,
 			objectCmdSetCustom,
 			bucketObjCmdEvict,
+			objectCmdPin,
+			objectCmdUnpin,
 			makeAlias(showCmdObject, "", true, commandShow), // alias for `ais show`
 			{
 				Name:         commandRename,
@@ -294,6 +319,36 @@ func removeObjectHandler(c *cli.Context) (err error) {
 	return multiobjArg(c, commandRemove)
 }
 
+// pin and unpin
+func pinHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() == 1 {
+		bck, objName, err := parseBckObjURI(c, c.Args().Get(0), true /*is optional*/)
+		if err != nil {
+			return err
+		}
+		if flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) {
+			if objName != "" {
+				return incorrectUsageMsg(c,
+					"object name (%q) cannot be used together with %s and/or %s flags",
+					objName, qflprn(listFlag), qflprn(templateFlag))
+			}
+			return listrange(c, bck)
+		}
+		if objName == "" {
+			return incorrectUsageMsg(c, "use one of: (%s or %s) to indicate _which_ objects to %s",
+				qflprn(listFlag), qflprn(templateFlag), c.Command.Name)
+		}
+	}
+	if flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) {
+		return incorrectUsageMsg(c, "flags %q, %q cannot be used together with object name arguments",
+			listFlag.Name, templateFlag.Name)
+	}
+	return multiobjArg(c, c.Command.Name)
+}
+
 // main PUT handler: cases 1 through 4
 func putHandler(c *cli.Context) error {
 	if flagIsSet(c, appendConcatFlag) {
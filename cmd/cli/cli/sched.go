@@ -0,0 +1,280 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles scheduled (recurring) jobs.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/ext/dsort"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	schedAddArgument = "NAME CRON_EXPRESSION JOB_NAME [BUCKET [DST_BUCKET]]"
+	schedNameArg     = "NAME"
+)
+
+var (
+	schedAddFlags = []cli.Flag{
+		dsortSpecFlag,
+		disableFlag,
+	}
+	jobScheduleSub = cli.Command{
+		Name:  commandSchedule,
+		Usage: "add, list, and remove scheduled (recurring) jobs",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdSchedAdd,
+				Usage: "add new or update existing schedule, e.g.:\n" +
+					indent1 + "\t- 'ais job schedule add nightly-lru \"0 2 * * *\" lru'\t- run LRU every night at 2am (UTC);\n" +
+					indent1 + "\t- 'ais job schedule add backup @daily copy-bck ais://src ais://dst'\t- copy bucket once a day;\n" +
+					indent1 + "\t- 'ais job schedule add resort \"@every 6h\" dsort -f spec.json'\t- dsort every 6 hours;\n" +
+					indent1 + "\t- 'ais job schedule add fetch @weekly download -f body.json'\t- download (see dload.Body) every Sunday;\n" +
+					indent1 + "\t- 'ais job schedule add inv @daily inventory s3://abc ais://inv'\t- daily inventory of s3://abc;\n" +
+					indent1 + "\t- 'ais job schedule add mirror @hourly sync-bck s3://abc ais://abc'\t- copy new and modified objects every hour.\n" +
+					indent1 + "JOB_NAME is either a startable xaction kind, or one of: dsort, download, copy-bck, sync-bck, inventory",
+				ArgsUsage:    schedAddArgument,
+				Flags:        schedAddFlags,
+				Action:       addScheduleHandler,
+				BashComplete: suggestSchedJobs,
+			},
+			{
+				Name:      commandList,
+				Usage:     "list schedules along with their respective last-run status",
+				ArgsUsage: "",
+				Flags:     []cli.Flag{jsonFlag, noHeaderFlag},
+				Action:    listSchedulesHandler,
+			},
+			{
+				Name:         commandRemove,
+				Usage:        "remove schedule",
+				ArgsUsage:    schedNameArg,
+				Action:       removeScheduleHandler,
+				BashComplete: suggestSchedules,
+			},
+		},
+	}
+)
+
+func addScheduleHandler(c *cli.Context) (err error) {
+	if c.NArg() < 3 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	var (
+		job = &xact.Schedule{
+			Name:     c.Args().Get(0),
+			Cron:     c.Args().Get(1),
+			Disabled: flagIsSet(c, disableFlag),
+		}
+		jobName = c.Args().Get(2)
+		bck     cmn.Bck
+	)
+	if _, err := cos.ParseCron(job.Cron); err != nil {
+		return err
+	}
+	if c.NArg() > 3 {
+		if bck, err = parseBckURI(c, c.Args().Get(3), false); err != nil {
+			return err
+		}
+	}
+	switch jobName {
+	case cmdDsort, cmdDownload:
+		if !flagIsSet(c, dsortSpecFlag) {
+			return missingArgumentsError(c, qflprn(dsortSpecFlag))
+		}
+		value, err := schedSpec(jobName, parseStrFlag(c, dsortSpecFlag))
+		if err != nil {
+			return err
+		}
+		job.Action = &apc.ActMsg{Action: jobName, Value: value}
+	case apc.ActCopyBck, apc.ActSyncBck:
+		if c.NArg() < 5 {
+			return missingArgumentsError(c, "source and destination buckets")
+		}
+		bckTo, err := parseBckURI(c, c.Args().Get(4), false)
+		if err != nil {
+			return err
+		}
+		job.Action = &apc.ActMsg{Action: apc.ActCopyBck, Value: &apc.TCBMsg{}}
+		if jobName == apc.ActSyncBck {
+			job.Action = &apc.ActMsg{Action: apc.ActSyncBck, Value: &apc.SyncBckMsg{}}
+		}
+		job.Bck, job.Query = bck, bck.NewQuery()
+		_ = bckTo.AddUnameToQuery(job.Query, apc.QparamBckTo)
+	case apc.ActInventory:
+		if c.NArg() < 5 {
+			return missingArgumentsError(c, "source and destination buckets")
+		}
+		bckTo, err := parseBckURI(c, c.Args().Get(4), false)
+		if err != nil {
+			return err
+		}
+		job.Action = &apc.ActMsg{Action: apc.ActInventory, Value: &cmn.InventoryMsg{ToBck: bckTo}}
+		job.Bck, job.Query = bck, bck.NewQuery()
+	default:
+		kind, _ := xact.GetKindName(jobName)
+		if kind == "" {
+			return incorrectUsageMsg(c, "unknown job %q", jobName)
+		}
+		job.Args = &xact.ArgsMsg{Kind: kind, Bck: bck}
+	}
+	if err := api.AddSchedule(apiBP, job); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Scheduled %q: %s %q", job.Name, jobName, job.Cron))
+	return nil
+}
+
+// read dsort request spec (JSON or YAML) or download request body (JSON)
+func schedSpec(jobName, specPath string) (any, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if specPath == fileStdIO {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(specPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if jobName == cmdDownload {
+		body := &dload.Body{}
+		if err := jsoniter.Unmarshal(b, body); err != nil {
+			return nil, err
+		}
+		if body.Type == "" {
+			return nil, errors.New("download request body: missing type (e.g. \"single\", \"range\", \"backend\")")
+		}
+		return body, nil
+	}
+	spec := &dsort.RequestSpec{}
+	if errj := jsoniter.Unmarshal(b, spec); errj != nil {
+		if erry := yaml.Unmarshal(b, spec); erry != nil {
+			return nil, fmt.Errorf("failed to parse dsort specification, errs: (%v, %v)", errj, erry)
+		}
+	}
+	return spec, nil
+}
+
+func listSchedulesHandler(c *cli.Context) error {
+	ss, err := api.GetSchedules(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(ss, "", teb.Jopts(true))
+	}
+	if len(ss.Jobs) == 0 {
+		actionDone(c, "No schedules")
+		return nil
+	}
+	names := make([]string, 0, len(ss.Jobs))
+	for name := range ss.Jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NAME\tCRON\tJOB\tBUCKET\tNEXT RUN\tLAST RUN\tRUNS\tLAST JOB ID\tERROR")
+	}
+	for _, name := range names {
+		var (
+			job        = ss.Jobs[name]
+			jname, bck = schedJob(job)
+			next       = "disabled"
+			last       = teb.NotSetVal
+		)
+		if !job.Disabled {
+			if n, err := job.Next(); err == nil {
+				next = cos.FormatNanoTime(n, "")
+			}
+		}
+		if job.Status.LastRun != 0 {
+			last = cos.FormatNanoTime(job.Status.LastRun, "")
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", name, job.Cron, jname, bck, next, last,
+			strconv.FormatInt(job.Status.Runs, 10), job.Status.Xid, job.Status.Err)
+	}
+	tw.Flush()
+	return nil
+}
+
+func schedJob(job *xact.Schedule) (jname, bck string) {
+	bck = teb.NotSetVal
+	if job.Args != nil {
+		if !job.Args.Bck.IsEmpty() {
+			bck = job.Args.Bck.Cname("")
+		}
+		_, jname = xact.GetKindName(job.Args.Kind)
+		return
+	}
+	jname = job.Action.Action
+	if !job.Bck.IsEmpty() {
+		bck = job.Bck.Cname("")
+		if to := job.Query.Get(apc.QparamBckTo); to != "" {
+			bckTo, _ := cmn.ParseUname(to)
+			bck += " => " + bckTo.Cname("")
+		}
+	}
+	return
+}
+
+func removeScheduleHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	name := c.Args().Get(0)
+	if err := api.RemoveSchedule(apiBP, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed schedule %q", name))
+	return nil
+}
+
+func suggestSchedJobs(c *cli.Context) {
+	if c.NArg() != 2 {
+		return
+	}
+	names := xact.ListDisplayNames(true /*only-startable*/)
+	names = append(names, cmdDsort, cmdDownload, apc.ActCopyBck, apc.ActSyncBck, apc.ActInventory)
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(c.App.Writer, name)
+	}
+}
+
+func suggestSchedules(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	ss, err := api.GetSchedules(apiBP)
+	if err != nil {
+		completionErr(c, err)
+		return
+	}
+	for name := range ss.Jobs {
+		fmt.Fprintln(c.App.Writer, name)
+	}
+}
@@ -486,6 +486,9 @@ func (ctx *bsummCtx) progress(summaries *cmn.AllBsummResults, done bool) {
 		} else {
 			s += fmt.Sprintf("[cluster: (%s, size=%s)",
 				cos.FormatBigNum(int(res.ObjCount.Present)), teb.FmtSize(int64(res.TotalSize.PresentObjs), ctx.units, 2))
+			if res.TotalSize.PinnedObjs > 0 {
+				s += fmt.Sprintf(", pinned: %s", teb.FmtSize(int64(res.TotalSize.PinnedObjs), ctx.units, 2))
+			}
 		}
 		if res.ObjCount.Remote == 0 {
 			s += "]"
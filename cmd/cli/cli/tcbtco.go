@@ -56,6 +56,7 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, allIncludingRemote bool)
 		Prefix:  parseStrFlag(c, copyObjPrefixFlag),
 		DryRun:  flagIsSet(c, copyDryRunFlag),
 		Force:   flagIsSet(c, forceFlag),
+		Samples: parseSampleFilter(c),
 	}
 
 	// by default, copying objects in the cluster, with an option to override
@@ -291,3 +292,18 @@ func tcbtco(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck, allIncluding
 	}
 	return multiobjTCO(c, bckFrom, bckTo, listObjs, tmplObjs, etlName)
 }
+
+// WebDataset: drop samples that match (nil when not requested)
+func parseSampleFilter(c *cli.Context) *apc.SampleFilter {
+	if !flagIsSet(c, dropSamplesExtFlag) && !flagIsSet(c, dropSamplesIfFlag) {
+		return nil
+	}
+	flt := &apc.SampleFilter{}
+	if flagIsSet(c, dropSamplesExtFlag) {
+		flt.Exts = splitCsv(parseStrFlag(c, dropSamplesExtFlag))
+	}
+	if flagIsSet(c, dropSamplesIfFlag) {
+		flt.Field, flt.Value, _ = strings.Cut(parseStrFlag(c, dropSamplesIfFlag), "=")
+	}
+	return flt
+}
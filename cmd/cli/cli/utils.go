@@ -512,6 +512,7 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 			{"access", props.Access.Describe()},
 			{"checksum", props.Cksum.String()},
 			{"mirror", props.Mirror.String()},
+			{"replication", props.Repl.String()},
 			{"ec", props.EC.String()},
 			{"lru", props.LRU.String()},
 			{"versioning", props.Versioning.String()},
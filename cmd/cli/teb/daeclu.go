@@ -16,6 +16,7 @@ import (
 const (
 	colProxy     = "PROXY"
 	colTarget    = "TARGET"
+	colDomain    = "DOMAIN"
 	colMemUsed   = "MEM USED(%)"
 	colMemAvail  = "MEM AVAIL"
 	colCapUsed   = "CAP USED(%)"
@@ -133,6 +134,7 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 		versions = h.versions()
 		cols     = []*header{
 			{name: colTarget},
+			{name: colDomain, hide: smap.Domains() == nil},
 			{name: colMemUsed},
 			{name: colMemAvail},
 			{name: colCapUsed},
@@ -156,6 +158,7 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 			nid, nstatus := fmtStatusSID(ds.Snode.ID(), smap, ds.Status)
 			row := []string{
 				nid,
+				fmtDomain(ds.Snode, smap),
 				unknownVal,
 				unknownVal,
 				unknownVal,
@@ -192,6 +195,7 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 		}
 		row := []string{
 			fmtDaemonID(ds.Snode.ID(), smap, ds.Status),
+			fmtDomain(ds.Snode, smap),
 			memUsed,
 			memAvail,
 			capUsed,
@@ -208,3 +212,13 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 	}
 	return table
 }
+
+func fmtDomain(si *meta.Snode, smap *meta.Smap) string {
+	if node := smap.GetNode(si.ID()); node != nil && node.Domain != "" {
+		return node.Domain
+	}
+	if si.Domain != "" {
+		return si.Domain
+	}
+	return unknownVal
+}
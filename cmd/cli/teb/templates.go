@@ -74,6 +74,7 @@ const (
 	ClusterSummary = indent1 + "Proxies:\t{{FormatProxiesSumm .Smap}}\n" +
 		indent1 + "Targets:\t{{FormatTargetsSumm .Smap .NumDisks}}\n" +
 		indent1 + "Cluster Map:\t{{FormatSmap .Smap}}\n" +
+		"{{if .Smap.Domains}}" + indent1 + "Failure Domains:\t{{FormatDomains .Smap .CluConfig}}\n{{end}}" +
 		indent1 + "Deployment:\t{{ ( Deployments .Status) }}\n" +
 		indent1 + "Status:\t{{ ( OnlineStatus .Status) }}\n" +
 		indent1 + "Rebalance:\t{{ ( Rebalance .Status) }}\n" +
@@ -241,12 +242,12 @@ const (
 	ListBucketsTmplNoSummary = ListBucketsHdrNoSummary + ListBucketsBodyNoSummary
 
 	// Bucket summary templates
-	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\n" +
+	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, pinned, remote)\t USAGE(%)\n" +
 		BucketsSummariesBody
 	BucketsSummariesBody = "{{range $k, $v := . }}" +
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjCount.Present}} {{$v.ObjCount.Remote}}\t " +
 		"{{FormatMAM $v.ObjSize.Min}} {{FormatMAM $v.ObjSize.Avg}} {{FormatMAM $v.ObjSize.Max}}\t " +
-		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
+		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.PinnedObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
 		"{{end}}"
 
 	BucketSummaryValidateTmpl = "BUCKET\t OBJECTS\t MISPLACED\t MISSING COPIES\n" + bucketSummaryValidateBody
@@ -291,6 +292,20 @@ See '--help' and docs/cli for details.`
 		"{{ $role.ID }}\t{{ $role.Desc }}\n" +
 		"{{end}}"
 
+	AuthNAccountTmpl = "NAME\tOWNER\tPERMISSIONS\tBUCKETS\tCREATED\tLAST USED\n" +
+		"{{ range $sa := . }}" +
+		"{{ $sa.ID }}\t{{ $sa.Owner }}\t{{ if $sa.Access }}{{ FormatACL $sa.Access }}{{ else }}(owner's){{ end }}\t" +
+		"{{ if $sa.Buckets }}{{ range $i, $bck := $sa.Buckets }}{{ if $i }},{{ end }}{{ FormatBckName $bck }}{{ end }}{{ else }}-{{ end }}\t" +
+		"{{ FormatTime $sa.Created }}\t{{ FormatTime $sa.LastUsed }}\n" +
+		"{{end}}"
+
+	AuditLogTmpl = "TIME\tNODE\tUSER\tCLIENT\tOPERATION\tBUCKET\tOBJECT\tSTATUS\tXACTION\n" +
+		"{{ range $e := . }}" +
+		"{{ $e.Time.Format \"Jan _2 15:04:05\" }}\t{{ $e.Node }}\t{{ if $e.User }}{{ $e.User }}{{ else }}-{{ end }}\t" +
+		"{{ $e.ClientIP }}\t{{ $e.Op }}\t{{ if $e.Bucket }}{{ $e.Bucket }}{{ else }}-{{ end }}\t" +
+		"{{ if $e.Object }}{{ $e.Object }}{{ else }}-{{ end }}\t{{ $e.Status }}\t{{ if $e.Xid }}{{ $e.Xid }}{{ else }}-{{ end }}\n" +
+		"{{end}}"
+
 	AuthNUserTmpl = "NAME\tROLES\n" +
 		"{{ range $user := . }}" +
 		"{{ $user.ID }}\t{{ JoinList $user.Roles }}\n" +
@@ -411,6 +426,7 @@ var (
 		"FormatObjIsCached": fmtObjIsCached,
 		"FormatDaemonID":    fmtDaemonID,
 		"FormatSmap":        fmtSmap,
+		"FormatDomains":     fmtDomains,
 		"FormatProxiesSumm": fmtProxiesSumm,
 		"FormatTargetsSumm": fmtTargetsSumm,
 		"FormatCapPctMAM":   fmtCapPctMAM,
@@ -420,6 +436,7 @@ var (
 		"FormatACL":         fmtACL,
 		"FormatNameArch":    fmtNameArch,
 		"FormatXactState":   FmtXactStatus,
+		"FormatTime":        fmtTime,
 		//  misc. helpers
 		"IsUnsetTime":   isUnsetTime,
 		"IsEqS":         func(a, b string) bool { return a == b },
@@ -6,6 +6,7 @@ package teb
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -187,6 +188,36 @@ func fmtCapPctMAM(tcdf *fs.TargetCDF, list bool) string {
 	return fmt.Sprintf("%s%2d%%%s %s%2d%%%s %s%2d%%", a, tcdf.PctMin, sepa, b, tcdf.PctAvg, sepa, c, tcdf.PctMax)
 }
 
+// failure domains and their (active) targets, with a warning when the cluster's
+// default EC configuration cannot survive the loss of a domain (see meta.HrwTargetList)
+func fmtDomains(smap *meta.Smap, cfg *cmn.ClusterConfig) string {
+	var (
+		domains = smap.Domains()
+		names   = make([]string, 0, len(domains))
+		nolabel = smap.CountActiveTs()
+	)
+	for domain, n := range domains {
+		names = append(names, domain)
+		nolabel -= n
+	}
+	sort.Strings(names)
+	for i, domain := range names {
+		names[i] = fmt.Sprintf("%s(%d)", domain, domains[domain])
+	}
+	s := fmt.Sprintf("%d [%s]", len(domains), strings.Join(names, ", "))
+	if nolabel > 0 {
+		s += fred(" (warning: %d target%s not labeled)", nolabel, cos.Plural(nolabel))
+	}
+	if cfg == nil {
+		return s
+	}
+	ec := &cfg.EC
+	if d, p := max(ec.DataSlices, 1), max(ec.ParitySlices, 1); smap.MaxPerDomain(d+p+1) > p {
+		s += fred(" (warning: EC d=%d, p=%d cannot survive the loss of a domain)", d, p)
+	}
+	return s
+}
+
 func fmtSmap(smap *meta.Smap) string {
 	return fmt.Sprintf("version %d, UUID %s, primary %s", smap.Version, smap.UUID, smap.Primary.StringEx())
 }
@@ -321,6 +352,13 @@ func extECPutStats(base *cluster.Snap) *ec.ExtECPutStats {
 //
 
 // see also: cli.isUnsetTime and cli.fmtBucketCreatedTime
+func fmtTime(t time.Time) string {
+	if t.IsZero() {
+		return NotSetVal
+	}
+	return cos.FormatTime(t, "")
+}
+
 func isUnsetTime(t time.Time) bool {
 	return t.IsZero()
 }
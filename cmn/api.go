@@ -42,12 +42,16 @@ type (
 		BackendBck  Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
 		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"`
 		WritePolicy WritePolicyConf `json:"write_policy"`
+		BlobDl      BlobDlConf      `json:"blob_download"`
+		Readahead   ReadaheadConf   `json:"readahead"`
+		ObjLock     ObjLockConf     `json:"object_lock"`
 		Provider    string          `json:"provider" list:"readonly"`       // backend provider
 		Renamed     string          `list:"omit"`                           // non-empty if the bucket has been renamed
 		Cksum       CksumConf       `json:"checksum"`                       // the bucket's checksum
 		EC          ECConf          `json:"ec"`                             // erasure coding
 		LRU         LRUConf         `json:"lru"`                            // LRU (watermarks and enabled/disabled)
 		Mirror      MirrorConf      `json:"mirror"`                         // mirroring
+		Repl        ReplConf        `json:"replication"`                    // cross-target replication
 		Access      apc.AccessAttrs `json:"access,string"`                  // access permissions
 		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
 		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
@@ -110,9 +114,13 @@ type (
 		Cksum       *CksumConfToSet       `json:"checksum,omitempty"`
 		LRU         *LRUConfToSet         `json:"lru,omitempty"`
 		Mirror      *MirrorConfToSet      `json:"mirror,omitempty"`
+		Repl        *ReplConfToSet        `json:"replication,omitempty"`
 		EC          *ECConfToSet          `json:"ec,omitempty"`
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
+		BlobDl      *BlobDlConfToSet      `json:"blob_download,omitempty"`
+		Readahead   *ReadaheadConfToSet   `json:"readahead,omitempty"`
+		ObjLock     *ObjLockConfToSet     `json:"object_lock,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
@@ -159,6 +167,7 @@ func (bck *Bck) DefaultProps(c *ClusterConfig) *Bprops {
 		Cksum:       cksum,
 		LRU:         lru,
 		Mirror:      c.Mirror,
+		Repl:        c.Repl,
 		Versioning:  c.Versioning,
 		Access:      apc.AccessAll,
 		EC:          c.EC,
@@ -203,10 +212,12 @@ func (bp *Bprops) Validate(targetCnt int) error {
 		}
 	}
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.LRU, &bp.Mirror, &bp.Repl, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.BlobDl, &bp.Readahead, &bp.ObjLock} {
 		var err error
 		if pv == &bp.EC {
 			err = bp.EC.ValidateAsProps(targetCnt)
+		} else if pv == &bp.Repl {
+			err = bp.Repl.ValidateAsProps(targetCnt)
 		} else if pv == &bp.Extra {
 			err = bp.Extra.ValidateAsProps(bp.Provider)
 		} else {
@@ -222,6 +233,9 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		return fmt.Errorf("cannot enable mirroring and ec at the same time for the same bucket")
 	}
+	if bp.Repl.Enabled && bp.EC.Enabled {
+		return fmt.Errorf("cannot enable replication and ec at the same time for the same bucket")
+	}
 	return softErr
 }
 
@@ -312,6 +326,7 @@ func aggr(from, to *BsummResult) {
 	to.TotalSize.OnDisk += from.TotalSize.OnDisk
 	to.TotalSize.PresentObjs += from.TotalSize.PresentObjs
 	to.TotalSize.RemoteObjs += from.TotalSize.RemoteObjs
+	to.TotalSize.PinnedObjs += from.TotalSize.PinnedObjs
 }
 
 func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
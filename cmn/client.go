@@ -6,6 +6,7 @@
 package cmn
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -37,6 +38,9 @@ type (
 		Key         string
 		SkipVerify  bool
 	}
+
+	// e.g., http.Transport.DialContext (and see intra-cluster mTLS)
+	DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
 )
 
 // {TransportArgs + defaults} => http.Transport for a variety of ais clients
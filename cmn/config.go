@@ -58,6 +58,32 @@ type (
 		HostNet   LocalNetConfig `json:"host_net"`
 		FSP       FSPConf        `json:"fspaths"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
+		// failure domain (e.g., rack or zone) this node belongs to;
+		// can be overridden at startup via AIS_FAILURE_DOMAIN (see also meta.Snode.Domain)
+		FailureDomain string `json:"failure_domain,omitempty"`
+		// ais target: weighted HRW (optional)
+		HRW HRWConf `json:"hrw"`
+		// ais target: mountpath tiers (optional; see TieringConf)
+		Tier MpathTierConf `json:"tier"`
+	}
+
+	// ais target: relative weights of the target and its mountpaths to select,
+	// respectively, target for a given object (meta.Smap) and mountpath (fs.Hrw);
+	// weighted selection is used only when all (active) targets or, respectively,
+	// all (available) mountpaths have weights; changes take effect upon restart
+	HRWConf struct {
+		// explicit mountpath weights; otherwise, mountpath weight is its capacity in GiB
+		Mountpaths map[string]uint64 `json:"mountpaths,omitempty"`
+		// explicit target weight; otherwise, the sum of its mountpath weights
+		Target  uint64 `json:"target,omitempty"`
+		Enabled bool   `json:"enabled"`
+	}
+
+	// ais target: mountpath tier labels (apc.TierFast | apc.TierSlow), e.g.:
+	// {"/nvme0": "fast", "/nvme1": "fast"}; unlabeled mountpaths are considered slow;
+	// changes take effect upon restart
+	MpathTierConf struct {
+		Mountpaths map[string]string `json:"mountpaths,omitempty"`
 	}
 
 	// ais node: (local) network config
@@ -91,6 +117,7 @@ type (
 		Ext        any            `json:"ext,omitempty"` // within meta-version extensions
 		Backend    BackendConf    `json:"backend" allow:"cluster"`
 		Mirror     MirrorConf     `json:"mirror" allow:"cluster"`
+		Repl       ReplConf       `json:"replication" allow:"cluster"`
 		EC         ECConf         `json:"ec" allow:"cluster"`
 		Log        LogConf        `json:"log"`
 		Periodic   PeriodConf     `json:"periodic"`
@@ -99,6 +126,7 @@ type (
 		Proxy      ProxyConf      `json:"proxy" allow:"cluster"`
 		Space      SpaceConf      `json:"space"`
 		LRU        LRUConf        `json:"lru"`
+		Tiering    TieringConf    `json:"tiering"`
 		Disk       DiskConf       `json:"disk"`
 		Rebalance  RebalanceConf  `json:"rebalance" allow:"cluster"`
 		Resilver   ResilverConf   `json:"resilver"`
@@ -132,6 +160,7 @@ type (
 		// ClusterConfig
 		Backend     *BackendConf          `json:"backend,omitempty"`
 		Mirror      *MirrorConfToSet      `json:"mirror,omitempty"`
+		Repl        *ReplConfToSet        `json:"replication,omitempty"`
 		EC          *ECConfToSet          `json:"ec,omitempty"`
 		Log         *LogConfToSet         `json:"log,omitempty"`
 		Periodic    *PeriodConfToSet      `json:"periodic,omitempty"`
@@ -139,6 +168,7 @@ type (
 		Client      *ClientConfToSet      `json:"client,omitempty"`
 		Space       *SpaceConfToSet       `json:"space,omitempty"`
 		LRU         *LRUConfToSet         `json:"lru,omitempty"`
+		Tiering     *TieringConfToSet     `json:"tiering,omitempty"`
 		Disk        *DiskConfToSet        `json:"disk,omitempty"`
 		Rebalance   *RebalanceConfToSet   `json:"rebalance,omitempty"`
 		Resilver    *ResilverConfToSet    `json:"resilver,omitempty"`
@@ -185,6 +215,19 @@ type (
 		Enabled *bool  `json:"enabled,omitempty"`
 	}
 
+	// cross-target replication: the object's main (HRW) target followed by the next `copies - 1`
+	// HRW targets (compare with n-way MirrorConf - local copies on different mountpaths)
+	ReplConf struct {
+		Copies  int  `json:"copies"`  // num replicas including the original
+		Sync    bool `json:"sync"`    // when true, PUT returns after all replicas are written (or failed to)
+		Enabled bool `json:"enabled"` // enabled (to replicate)
+	}
+	ReplConfToSet struct {
+		Copies  *int  `json:"copies,omitempty"`
+		Sync    *bool `json:"sync,omitempty"`
+		Enabled *bool `json:"enabled,omitempty"`
+	}
+
 	ECConf struct {
 		ObjSizeLimit int64  `json:"objsize_limit"`     // objects below this size are replicated instead of EC'ed
 		Compression  string `json:"compression"`       // enum { CompressAlways, ... } in api/apc/compression.go
@@ -210,6 +253,7 @@ type (
 		MaxTotal  cos.SizeIEC  `json:"max_total"`  // (sum individual log sizes); exceeding this number triggers cleanup
 		FlushTime cos.Duration `json:"flush_time"` // log flush interval
 		StatsTime cos.Duration `json:"stats_time"` // (not used)
+		Audit     string       `json:"audit"`      // audit log: "" (disabled) | "control" | "writes" | "all" (see apc.Audit*)
 	}
 	LogConfToSet struct {
 		Level     *cos.LogLevel `json:"level,omitempty"`
@@ -217,6 +261,7 @@ type (
 		MaxTotal  *cos.SizeIEC  `json:"max_total,omitempty"`
 		FlushTime *cos.Duration `json:"flush_time,omitempty"`
 		StatsTime *cos.Duration `json:"stats_time,omitempty"`
+		Audit     *string       `json:"audit,omitempty"`
 	}
 
 	// NOTE: StatsTime is a one important timer
@@ -306,13 +351,47 @@ type (
 		// CapacityUpdTimeStr denotes the frequency at which AIStore updates local capacity utilization
 		CapacityUpdTime cos.Duration `json:"capacity_upd_time"`
 
+		// Policy selects the order of eviction (enum apc.EvictPolicy; default: least recently used)
+		Policy apc.EvictPolicy `json:"policy,omitempty" list:"omitempty"`
+
+		// TTL: with apc.EvictTTL policy, objects cold-GET (or PUT) more than TTL ago are evicted first
+		TTL cos.Duration `json:"ttl,omitempty" list:"omitempty"`
+
+		// Priority: buckets with higher priority get evicted first (e.g., scratch buckets before dataset caches)
+		Priority int `json:"priority,omitempty" list:"omitempty"`
+
 		// Enabled: LRU will only run when set to true
 		Enabled bool `json:"enabled"`
 	}
 	LRUConfToSet struct {
-		DontEvictTime   *cos.Duration `json:"dont_evict_time,omitempty"`
-		CapacityUpdTime *cos.Duration `json:"capacity_upd_time,omitempty"`
-		Enabled         *bool         `json:"enabled,omitempty"`
+		DontEvictTime   *cos.Duration    `json:"dont_evict_time,omitempty"`
+		CapacityUpdTime *cos.Duration    `json:"capacity_upd_time,omitempty"`
+		Policy          *apc.EvictPolicy `json:"policy,omitempty"`
+		TTL             *cos.Duration    `json:"ttl,omitempty"`
+		Priority        *int             `json:"priority,omitempty"`
+		Enabled         *bool            `json:"enabled,omitempty"`
+	}
+
+	// storage tiering between fast and slow mountpaths of a given target (see MpathTierConf)
+	TieringConf struct {
+		// promote (i.e., replicate to the fast tier) objects read at least so many times
+		// and last accessed within the ColdTime
+		HotHits int64 `json:"hot_hits"`
+
+		// demote (i.e., remove from the fast tier) promoted objects not accessed for so long
+		ColdTime cos.Duration `json:"cold_time"`
+
+		// how often to run tiering xaction (target housekeeping)
+		Interval cos.Duration `json:"interval"`
+
+		// Enabled: tiering will only run when set to true
+		Enabled bool `json:"enabled"`
+	}
+	TieringConfToSet struct {
+		HotHits  *int64        `json:"hot_hits,omitempty"`
+		ColdTime *cos.Duration `json:"cold_time,omitempty"`
+		Interval *cos.Duration `json:"interval,omitempty"`
+		Enabled  *bool         `json:"enabled,omitempty"`
 	}
 
 	DiskConf struct {
@@ -393,11 +472,13 @@ type (
 	}
 
 	NetConf struct {
-		L4   L4Conf   `json:"l4"`
-		HTTP HTTPConf `json:"http"`
+		L4       L4Conf       `json:"l4"`
+		HTTP     HTTPConf     `json:"http"`
+		IntraTLS IntraTLSConf `json:"intra_tls"`
 	}
 	NetConfToSet struct {
-		HTTP *HTTPConfToSet `json:"http,omitempty"`
+		HTTP     *HTTPConfToSet     `json:"http,omitempty"`
+		IntraTLS *IntraTLSConfToSet `json:"intra_tls,omitempty"`
 	}
 
 	L4Conf struct {
@@ -431,6 +512,23 @@ type (
 		Chunked         *bool   `json:"chunked_transfer,omitempty"`
 	}
 
+	// mutual TLS for the intra-cluster control and data networks, configured separately
+	// from the public one (above); requires both intra-cluster networks (see LocalNetConfig).
+	// Each node presents its own certificate with the subject's CN set to its node ID
+	// (same paths, different content on each node).
+	IntraTLSConf struct {
+		Certificate string `json:"node_crt"` // X509 certificate of this node
+		CertKey     string `json:"node_key"` // X509 key
+		CA          string `json:"ca"`       // CA that signs all node certificates
+		Enabled     bool   `json:"enabled"`
+	}
+	IntraTLSConfToSet struct {
+		Certificate *string `json:"node_crt,omitempty"`
+		CertKey     *string `json:"node_key,omitempty"`
+		CA          *string `json:"ca,omitempty"`
+		Enabled     *bool   `json:"enabled,omitempty" list:"readonly"`
+	}
+
 	FSHCConf struct {
 		TestFileCount int  `json:"test_files"`  // number of files to read/write
 		ErrorLimit    int  `json:"error_limit"` // exceeding err limit causes disabling mountpath
@@ -443,12 +541,41 @@ type (
 	}
 
 	AuthConf struct {
-		Secret  string `json:"secret"`
-		Enabled bool   `json:"enabled"`
+		Secret  string   `json:"secret"`
+		OIDC    OIDCConf `json:"oidc"` // external identity providers (in addition to AuthN)
+		Enabled bool     `json:"enabled"`
 	}
 	AuthConfToSet struct {
-		Secret  *string `json:"secret,omitempty"`
-		Enabled *bool   `json:"enabled,omitempty"`
+		Secret  *string   `json:"secret,omitempty"`
+		OIDC    *OIDCConf `json:"oidc,omitempty"`
+		Enabled *bool     `json:"enabled,omitempty"`
+	}
+
+	// OIDC: RS256/ES256 (and RS384/512, ES384/512) JWTs issued by the configured issuers
+	// are validated with the issuer's JSON Web Key Set (JWKS), and their claims are then
+	// mapped to AIS roles (see OIDCRoleRule)
+	OIDCConf struct {
+		Issuers []OIDCIssuerConf `json:"issuers"`
+	}
+	OIDCIssuerConf struct {
+		Issuer string `json:"issuer"` // expected "iss" claim
+		// JWKS location: URL (http:// or https://) or local filename; the keys are
+		// cached and refreshed every `jwks_refresh` (default: 1h), or sooner -
+		// upon encountering an unknown key ID (key rotation)
+		JWKS          string         `json:"jwks"`
+		Audience      string         `json:"audience,omitempty"`       // expected "aud" claim (none when empty)
+		UsernameClaim string         `json:"username_claim,omitempty"` // default: "sub"
+		Rules         []OIDCRoleRule `json:"rules"`
+		JWKSRefresh   cos.Duration   `json:"jwks_refresh,omitempty"`
+	}
+	// when a given claim (e.g., "groups", "email") contains or matches the value,
+	// the token's holder is granted the role: cluster-wide or, if the bucket is
+	// specified, in the bucket (all matching rules apply)
+	OIDCRoleRule struct {
+		Claim  string `json:"claim"`
+		Value  string `json:"value"` // exact value or glob pattern (see path.Match)
+		Role   string `json:"role"`  // one of: "Admin", "ClusterOwner", "BucketOwner", "Guest"
+		Bucket string `json:"bucket,omitempty"`
 	}
 
 	// keepalive tracker
@@ -517,6 +644,10 @@ type (
 		// fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
 		LZ4BlockMaxSize  cos.SizeIEC `json:"lz4_block"`
 		LZ4FrameChecksum bool        `json:"lz4_frame_checksum"`
+
+		// compression algorithm: one of apc.SupportedCompressors (empty defaults to lz4);
+		// applies to streams configured to compress (e.g., rebalance.compression)
+		Compressor string `json:"compressor"`
 	}
 	TransportConfToSet struct {
 		MaxHeaderSize    *int          `json:"max_header,omitempty" list:"readonly"`
@@ -525,6 +656,7 @@ type (
 		QuiesceTime      *cos.Duration `json:"quiescent,omitempty"`
 		LZ4BlockMaxSize  *cos.SizeIEC  `json:"lz4_block,omitempty"`
 		LZ4FrameChecksum *bool         `json:"lz4_frame_checksum,omitempty"`
+		Compressor       *string       `json:"compressor,omitempty"`
 	}
 
 	MemsysConf struct {
@@ -561,6 +693,44 @@ type (
 		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
+
+	// bucket-only (not inherited from the cluster config):
+	// cold-GET large remote objects in chunks, via concurrent range reads
+	BlobDlConf struct {
+		Threshold  cos.SizeIEC `json:"threshold"`   // minimum object size; zero disables chunked cold GET
+		ChunkSize  cos.SizeIEC `json:"chunk_size"`  // range read size (zero: default)
+		NumWorkers int         `json:"num_workers"` // concurrent range readers (zero: default)
+	}
+	BlobDlConfToSet struct {
+		Threshold  *cos.SizeIEC `json:"threshold,omitempty"`
+		ChunkSize  *cos.SizeIEC `json:"chunk_size,omitempty"`
+		NumWorkers *int         `json:"num_workers,omitempty"`
+	}
+
+	// bucket-only (not inherited from the cluster config):
+	// upon sequential GETs of numbered objects (e.g., "shard-000001.tar", "shard-000002.tar", ...)
+	// cold-GET the next `count` objects in the background
+	ReadaheadConf struct {
+		Count   int  `json:"count"`   // number of objects to read ahead (zero: default)
+		Enabled bool `json:"enabled"` // (remote buckets only)
+	}
+	ReadaheadConfToSet struct {
+		Count   *int  `json:"count,omitempty"`
+		Enabled *bool `json:"enabled,omitempty"`
+	}
+
+	// bucket-only (not inherited from the cluster config):
+	// object lock (WORM) - once enabled, cannot be disabled
+	ObjLockConf struct {
+		Mode      string       `json:"mode"`      // default retention mode: apc.RetentionGovernance | apc.RetentionCompliance (empty: none)
+		Retention cos.Duration `json:"retention"` // default retention period of new objects
+		Enabled   bool         `json:"enabled"`
+	}
+	ObjLockConfToSet struct {
+		Mode      *string       `json:"mode,omitempty"`
+		Retention *cos.Duration `json:"retention,omitempty"`
+		Enabled   *bool         `json:"enabled,omitempty"`
+	}
 )
 
 // assorted named fields that require (cluster | node) restart for changes to make an effect
@@ -602,7 +772,10 @@ var (
 	_ Validator = (*LogConf)(nil)
 	_ Validator = (*LRUConf)(nil)
 	_ Validator = (*SpaceConf)(nil)
+	_ Validator = (*TieringConf)(nil)
+	_ Validator = (*MpathTierConf)(nil)
 	_ Validator = (*MirrorConf)(nil)
+	_ Validator = (*ReplConf)(nil)
 	_ Validator = (*ECConf)(nil)
 	_ Validator = (*VersionConf)(nil)
 	_ Validator = (*KeepaliveConf)(nil)
@@ -618,12 +791,21 @@ var (
 	_ Validator = (*MemsysConf)(nil)
 	_ Validator = (*TCBConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
+	_ Validator = (*BlobDlConf)(nil)
+	_ Validator = (*ReadaheadConf)(nil)
+	_ Validator = (*ObjLockConf)(nil)
+	_ Validator = (*OIDCConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
+	_ PropsValidator = (*LRUConf)(nil)
 	_ PropsValidator = (*MirrorConf)(nil)
+	_ PropsValidator = (*ReplConf)(nil)
 	_ PropsValidator = (*ECConf)(nil)
 	_ PropsValidator = (*WritePolicyConf)(nil)
+	_ PropsValidator = (*BlobDlConf)(nil)
+	_ PropsValidator = (*ReadaheadConf)(nil)
+	_ PropsValidator = (*ObjLockConf)(nil)
 
 	_ json.Marshaler   = (*BackendConf)(nil)
 	_ json.Unmarshaler = (*BackendConf)(nil)
@@ -655,6 +837,9 @@ func (c *Config) Validate() error {
 	if err := c.LocalConfig.TestFSP.Validate(c); err != nil {
 		return err
 	}
+	if !cos.IsAlphaPlus(c.LocalConfig.FailureDomain) {
+		return fmt.Errorf("invalid failure domain %q: %s", c.LocalConfig.FailureDomain, cos.OnlyPlus)
+	}
 
 	opts := IterOpts{VisitAll: true}
 	return IterFields(c, vdate, opts)
@@ -768,6 +953,12 @@ func (c *LogConf) Validate() error {
 	if c.StatsTime.D() > 10*time.Minute {
 		return fmt.Errorf("invalid log.stats_time=%s (expected range [log.stats_time, 10m])", c.StatsTime)
 	}
+	switch c.Audit {
+	case "", apc.AuditControl, apc.AuditWrites, apc.AuditAll:
+	default:
+		return fmt.Errorf("invalid log.audit=%q (expecting one of: %q, %q, %q, or empty to disable)",
+			c.Audit, apc.AuditControl, apc.AuditWrites, apc.AuditAll)
+	}
 	return nil
 }
 
@@ -986,14 +1177,74 @@ func (c *LRUConf) String() string {
 	if !c.Enabled {
 		return "Disabled"
 	}
-	return fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v", c.DontEvictTime, c.CapacityUpdTime)
+	s := fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v", c.DontEvictTime, c.CapacityUpdTime)
+	if !c.Policy.IsLRU() {
+		s += ", lru.policy=" + string(c.Policy)
+		if c.Policy == apc.EvictTTL {
+			s += ", lru.ttl=" + c.TTL.String()
+		}
+	}
+	if c.Priority != 0 {
+		s += ", lru.priority=" + strconv.Itoa(c.Priority)
+	}
+	return s
 }
 
 func (c *LRUConf) Validate() (err error) {
 	if c.CapacityUpdTime.D() < 10*time.Second {
-		err = fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
+		return fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
 	}
-	return
+	return c.ValidateAsProps()
+}
+
+func (c *LRUConf) ValidateAsProps(...any) error {
+	if err := c.Policy.Validate(); err != nil {
+		return err
+	}
+	if c.Policy == apc.EvictTTL && c.TTL <= 0 {
+		return fmt.Errorf("invalid lru.ttl=%v (expecting positive duration with lru.policy=%q)", c.TTL, c.Policy)
+	}
+	return nil
+}
+
+/////////////////
+// TieringConf //
+/////////////////
+
+func (c *TieringConf) Validate() error {
+	if !c.Enabled {
+		return nil // (not configured or older config)
+	}
+	if c.HotHits < 1 {
+		return fmt.Errorf("invalid tiering.hot_hits=%d (expecting positive integer)", c.HotHits)
+	}
+	if c.ColdTime.D() < time.Minute {
+		return fmt.Errorf("invalid tiering.cold_time=%v (expecting >= 1m)", c.ColdTime)
+	}
+	if c.Interval.D() < time.Minute {
+		return fmt.Errorf("invalid tiering.interval=%v (expecting >= 1m)", c.Interval)
+	}
+	return nil
+}
+
+func (c *TieringConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	return fmt.Sprintf("tiering.hot_hits=%d, tiering.cold_time=%v, tiering.interval=%v", c.HotHits, c.ColdTime, c.Interval)
+}
+
+///////////////////
+// MpathTierConf //
+///////////////////
+
+func (c *MpathTierConf) Validate() error {
+	for mpath, tier := range c.Mountpaths {
+		if tier != apc.TierFast && tier != apc.TierSlow {
+			return fmt.Errorf("invalid tier %q for mountpath %q (expecting %q or %q)", tier, mpath, apc.TierFast, apc.TierSlow)
+		}
+	}
+	return nil
 }
 
 ///////////////
@@ -1087,6 +1338,46 @@ func (c *MirrorConf) String() string {
 	return fmt.Sprintf("%d copies", c.Copies)
 }
 
+//////////////
+// ReplConf //
+//////////////
+
+func (c *ReplConf) Validate() error {
+	if c.Copies == 0 && !c.Enabled {
+		return nil // not configured (e.g., older config)
+	}
+	if c.Copies < 2 || c.Copies > 32 {
+		return fmt.Errorf("invalid replication.copies: %d (expected value in range [2, 32])", c.Copies)
+	}
+	return nil
+}
+
+func (c *ReplConf) ValidateAsProps(arg ...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	targetCnt, ok := arg[0].(int)
+	debug.Assert(ok)
+	if c.Copies <= targetCnt {
+		return nil
+	}
+	return NewErrSoft(fmt.Sprintf("%v: replication (copies=%d) requires at least %d targets (have %d)",
+		ErrNotEnoughTargets, c.Copies, c.Copies, targetCnt))
+}
+
+func (c *ReplConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	if c.Sync {
+		return fmt.Sprintf("%d copies (sync)", c.Copies)
+	}
+	return fmt.Sprintf("%d copies", c.Copies)
+}
+
 ////////////
 // ECConf //
 ////////////
@@ -1172,6 +1463,220 @@ func (c *WritePolicyConf) Validate() (err error) {
 
 func (c *WritePolicyConf) ValidateAsProps(...any) error { return c.Validate() }
 
+//////////////
+// OIDCConf //
+//////////////
+
+const OIDCDefaultJWKSRefresh = time.Hour
+
+func (c *OIDCConf) Validate() error {
+	issuers := make(cos.StrSet, len(c.Issuers))
+	for i := range c.Issuers {
+		ic := &c.Issuers[i]
+		if ic.Issuer == "" {
+			return fmt.Errorf("invalid auth.oidc.issuers[%d]: missing issuer", i)
+		}
+		if issuers.Contains(ic.Issuer) {
+			return fmt.Errorf("invalid auth.oidc: duplicate issuer %q", ic.Issuer)
+		}
+		issuers.Add(ic.Issuer)
+		if ic.JWKS == "" {
+			return fmt.Errorf("invalid auth.oidc issuer %q: missing jwks (URL or filename)", ic.Issuer)
+		}
+		if ic.JWKSRefresh < 0 {
+			return fmt.Errorf("invalid auth.oidc issuer %q: negative jwks_refresh %v", ic.Issuer, ic.JWKSRefresh)
+		}
+		for j := range ic.Rules {
+			if err := ic.Rules[j].validate(); err != nil {
+				return fmt.Errorf("invalid auth.oidc issuer %q, rule #%d: %v", ic.Issuer, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *OIDCConf) Issuer(iss string) *OIDCIssuerConf {
+	for i := range c.Issuers {
+		if c.Issuers[i].Issuer == iss {
+			return &c.Issuers[i]
+		}
+	}
+	return nil
+}
+
+func (ic *OIDCIssuerConf) Refresh() time.Duration {
+	if ic.JWKSRefresh == 0 {
+		return OIDCDefaultJWKSRefresh
+	}
+	return ic.JWKSRefresh.D()
+}
+
+func (ic *OIDCIssuerConf) Username() string {
+	if ic.UsernameClaim == "" {
+		return "sub"
+	}
+	return ic.UsernameClaim
+}
+
+func (r *OIDCRoleRule) validate() error {
+	if r.Claim == "" || r.Value == "" {
+		return errors.New("claim and value must be non-empty")
+	}
+	if _, err := path.Match(r.Value, ""); err != nil {
+		return fmt.Errorf("invalid value (pattern) %q: %v", r.Value, err)
+	}
+	switch r.Role {
+	case apc.AdminRole, apc.ClusterOwnerRole:
+		if r.Bucket != "" {
+			return fmt.Errorf("role %q cannot be restricted to a bucket (%q)", r.Role, r.Bucket)
+		}
+	case apc.BucketOwnerRole, apc.GuestRole:
+	default:
+		return fmt.Errorf("invalid role %q (expecting one of: %q, %q, %q, %q)", r.Role,
+			apc.AdminRole, apc.ClusterOwnerRole, apc.BucketOwnerRole, apc.GuestRole)
+	}
+	if r.Bucket != "" {
+		if _, _, err := ParseBckObjectURI(r.Bucket, ParseURIOpts{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matches a single claim value (string) or any of the values (array)
+func (r *OIDCRoleRule) Match(claimVal any) bool {
+	switch v := claimVal.(type) {
+	case string:
+		ok, _ := path.Match(r.Value, v)
+		return ok
+	case []any:
+		for _, vv := range v {
+			if r.Match(vv) {
+				return true
+			}
+		}
+	case []string:
+		for _, vv := range v {
+			if r.Match(vv) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+////////////////
+// BlobDlConf //
+////////////////
+
+const (
+	BlobDlDefaultChunkSize  = 16 * cos.MiB
+	BlobDlMinChunkSize      = cos.MiB
+	BlobDlMaxChunkSize      = 2 * cos.GiB
+	BlobDlDefaultNumWorkers = 4
+	BlobDlMaxNumWorkers     = 64
+)
+
+func (c *BlobDlConf) Validate() error {
+	if c.Threshold < 0 {
+		return fmt.Errorf("invalid blob_download.threshold %d (expecting non-negative)", c.Threshold)
+	}
+	if c.ChunkSize != 0 && (c.ChunkSize < BlobDlMinChunkSize || c.ChunkSize > BlobDlMaxChunkSize) {
+		return fmt.Errorf("invalid blob_download.chunk_size %s (expecting %s thru %s)", c.ChunkSize,
+			cos.ToSizeIEC(BlobDlMinChunkSize, 0), cos.ToSizeIEC(BlobDlMaxChunkSize, 0))
+	}
+	if c.NumWorkers < 0 || c.NumWorkers > BlobDlMaxNumWorkers {
+		return fmt.Errorf("invalid blob_download.num_workers %d (expecting 0 thru %d)", c.NumWorkers,
+			BlobDlMaxNumWorkers)
+	}
+	return nil
+}
+
+func (c *BlobDlConf) ValidateAsProps(...any) error { return c.Validate() }
+
+// with defaults
+func (c *BlobDlConf) Chunk() int64 {
+	if c.ChunkSize == 0 {
+		return BlobDlDefaultChunkSize
+	}
+	return int64(c.ChunkSize)
+}
+
+func (c *BlobDlConf) Workers() int {
+	if c.NumWorkers == 0 {
+		return BlobDlDefaultNumWorkers
+	}
+	return c.NumWorkers
+}
+
+///////////////////
+// ReadaheadConf //
+///////////////////
+
+const (
+	ReadaheadDefaultCount = 32
+	ReadaheadMaxCount     = 1024
+)
+
+func (c *ReadaheadConf) Validate() error {
+	if c.Count < 0 || c.Count > ReadaheadMaxCount {
+		return fmt.Errorf("invalid readahead.count %d (expecting 0 thru %d)", c.Count, ReadaheadMaxCount)
+	}
+	return nil
+}
+
+func (c *ReadaheadConf) ValidateAsProps(...any) error { return c.Validate() }
+
+// with default
+func (c *ReadaheadConf) Num() int {
+	if c.Count == 0 {
+		return ReadaheadDefaultCount
+	}
+	return c.Count
+}
+
+func (c *ReadaheadConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	return fmt.Sprintf("%d objects", c.Num())
+}
+
+/////////////////
+// ObjLockConf //
+/////////////////
+
+func (c *ObjLockConf) Validate() error {
+	if c.Mode != "" && !apc.IsValidRetention(c.Mode) {
+		return fmt.Errorf("invalid object_lock.mode %q (expecting %q or %q)", c.Mode,
+			apc.RetentionGovernance, apc.RetentionCompliance)
+	}
+	if c.Retention < 0 {
+		return fmt.Errorf("invalid object_lock.retention %s (expecting non-negative)", c.Retention)
+	}
+	if (c.Mode == "") != (c.Retention == 0) {
+		return fmt.Errorf("invalid object_lock (mode %q, retention %s): default retention requires both",
+			c.Mode, c.Retention)
+	}
+	if c.Mode != "" && !c.Enabled {
+		return fmt.Errorf("invalid object_lock: default retention (mode %q) requires object lock enabled", c.Mode)
+	}
+	return nil
+}
+
+func (c *ObjLockConf) ValidateAsProps(...any) error { return c.Validate() }
+
+func (c *ObjLockConf) String() string {
+	switch {
+	case !c.Enabled:
+		return "Disabled"
+	case c.Mode == "":
+		return "Enabled"
+	default:
+		return "Enabled | " + c.Mode + " retention " + c.Retention.String()
+	}
+}
+
 ///////////////////
 // KeepaliveConf //
 ///////////////////
@@ -1208,6 +1713,9 @@ func (c *NetConf) Validate() (err error) {
 		return fmt.Errorf("invalid client_auth_tls %d (expecting range [0 - %d])", c.HTTP.ClientAuthTLS,
 			tls.RequireAndVerifyClientCert)
 	}
+	if c.IntraTLS.Enabled && (c.IntraTLS.Certificate == "" || c.IntraTLS.CertKey == "" || c.IntraTLS.CA == "") {
+		return errors.New("intra_tls: node_crt, node_key, and ca must be specified")
+	}
 	return nil
 }
 
@@ -1274,6 +1782,10 @@ func (c *LocalNetConfig) Validate(contextConfig *Config) (err error) {
 	differentPorts = c.Port != c.PortIntraData
 	c.UseIntraData = (contextConfig.TestingEnv() || c.HostnameIntraData != "") &&
 		c.PortIntraData != 0 && (differentIPs || differentPorts)
+
+	if contextConfig.Net.IntraTLS.Enabled && (!c.UseIntraControl || !c.UseIntraData) {
+		return errors.New("intra_tls: requires separate intra-cluster control and data networks")
+	}
 	return
 }
 
@@ -1506,6 +2018,9 @@ func (c *TransportConf) Validate() (err error) {
 	if c.MaxHeaderSize > 0 && c.MaxHeaderSize < 512 {
 		return fmt.Errorf("invalid transport.max_header: %v (expected >= 512)", c.MaxHeaderSize)
 	}
+	if c.Compressor != "" && !cos.StringInSlice(c.Compressor, apc.SupportedCompressors) {
+		return fmt.Errorf("invalid transport.compressor: %q (expecting one of: %v)", c.Compressor, apc.SupportedCompressors)
+	}
 	return nil
 }
 
@@ -0,0 +1,196 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron is a parsed cron expression: standard 5 fields (minute, hour, day of month, month,
+// and day of week) with the usual '*', ',', '-', and '/' syntax and 3-letter month and
+// weekday names; also supported are the following descriptors:
+// @yearly (@annually), @monthly, @weekly, @daily (@midnight), @hourly, and "@every <duration>".
+//
+// When both day of month and day of week are restricted, either one matching will do
+// (as per crontab(5)). All times are UTC.
+
+const cronFields = 5
+
+type (
+	Cron struct {
+		expr                        string
+		minute, hour, dom, mon, dow uint64 // bitsets
+		every                       time.Duration
+		domStar, dowStar            bool
+	}
+	cronRange struct {
+		names    []string
+		min, max int
+	}
+)
+
+var (
+	cronRanges = [cronFields]cronRange{
+		{min: 0, max: 59}, // minute
+		{min: 0, max: 23}, // hour
+		{min: 1, max: 31}, // day of month
+		{min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+		{min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}, // 0 and 7 - both Sunday
+	}
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+func ParseCron(expr string) (*Cron, error) {
+	var (
+		c     = &Cron{expr: expr}
+		spec  = strings.TrimSpace(expr)
+		every = "@every "
+	)
+	if strings.HasPrefix(spec, every) {
+		d, err := time.ParseDuration(strings.TrimSpace(spec[len(every):]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expr, err)
+		}
+		if d < time.Minute {
+			return nil, fmt.Errorf("invalid cron expression %q: interval must be at least 1m", expr)
+		}
+		c.every = d
+		return c, nil
+	}
+	if strings.HasPrefix(spec, "@") {
+		s, ok := cronDescriptors[spec]
+		if !ok {
+			return nil, fmt.Errorf("invalid cron expression %q: unknown descriptor", expr)
+		}
+		spec = s
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != cronFields {
+		return nil, fmt.Errorf("invalid cron expression %q: expecting %d fields, got %d", expr, cronFields, len(fields))
+	}
+	bits := [cronFields]*uint64{&c.minute, &c.hour, &c.dom, &c.mon, &c.dow}
+	for i, field := range fields {
+		b, err := cronRanges[i].parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expr, err)
+		}
+		*bits[i] = b
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1 // Sunday
+	}
+	c.domStar, c.dowStar = fields[2] == "*", fields[4] == "*"
+	return c, nil
+}
+
+func (c *Cron) String() string { return c.expr }
+
+// returns the first activation time strictly after `t` (zero time if there's none within 5 years,
+// e.g. "0 0 30 2 *")
+func (c *Cron) Next(t time.Time) time.Time {
+	if c.every > 0 {
+		return t.Add(c.every)
+	}
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	for limit := t.AddDate(5, 0, 0); t.Before(limit); {
+		switch {
+		case c.mon&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	var (
+		dom = c.dom&(1<<uint(t.Day())) != 0
+		dow = c.dow&(1<<uint(t.Weekday())) != 0
+	)
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+///////////////
+// cronRange //
+///////////////
+
+func (r *cronRange) parse(field string) (bits uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		var (
+			lo, hi = r.min, r.max
+			step   = 1
+			rng    = part
+		)
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			rng = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+		}
+		switch {
+		case rng == "*":
+		case strings.IndexByte(rng, '-') > 0:
+			i := strings.IndexByte(rng, '-')
+			if lo, err = r.value(rng[:i]); err != nil {
+				return
+			}
+			if hi, err = r.value(rng[i+1:]); err != nil {
+				return
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			if lo, err = r.value(rng); err != nil {
+				return
+			}
+			if rng == part { // single value, e.g. "5" (but not "5/10")
+				hi = lo
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	if bits == 0 {
+		err = errors.New("empty field")
+	}
+	return
+}
+
+func (r *cronRange) value(s string) (int, error) {
+	for i, name := range r.names {
+		if strings.EqualFold(s, name) {
+			return r.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < r.min || v > r.max {
+		return 0, fmt.Errorf("value %q out of range [%d, %d]", s, r.min, r.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,50 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron", func() {
+	// Wednesday
+	from := time.Date(2023, time.March, 15, 10, 30, 45, 0, time.UTC)
+
+	DescribeTable("next activation time",
+		func(expr string, expected time.Time) {
+			c, err := cos.ParseCron(expr)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c.Next(from)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2023, time.March, 15, 10, 31, 0, 0, time.UTC)),
+		Entry("minute step", "*/20 * * * *", time.Date(2023, time.March, 15, 10, 40, 0, 0, time.UTC)),
+		Entry("daily", "@daily", time.Date(2023, time.March, 16, 0, 0, 0, 0, time.UTC)),
+		Entry("hour range and list", "15,45 9-11 * * *", time.Date(2023, time.March, 15, 10, 45, 0, 0, time.UTC)),
+		Entry("weekday name", "0 3 * * sun", time.Date(2023, time.March, 19, 3, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 3 * * 7", time.Date(2023, time.March, 19, 3, 0, 0, 0, time.UTC)),
+		Entry("month name", "0 0 1 jun *", time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("dom or dow", "0 0 17 * mon", time.Date(2023, time.March, 17, 0, 0, 0, 0, time.UTC)),
+		Entry("every duration", "@every 90m", from.Add(90*time.Minute)),
+		Entry("impossible date", "0 0 30 2 *", time.Time{}),
+	)
+
+	DescribeTable("invalid expressions",
+		func(expr string) {
+			_, err := cos.ParseCron(expr)
+			Expect(err).Should(HaveOccurred())
+		},
+		Entry("too few fields", "* * * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("inverted range", "0 10-2 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("unknown descriptor", "@sometimes"),
+		Entry("short interval", "@every 10s"),
+	)
+})
@@ -0,0 +1,17 @@
+// Package cos provides common low-level types and utilities for all aistore projects.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import "math"
+
+// Weighted HRW (aka weighted rendezvous hashing): given HRW hash and (positive)
+// weight, returns the score -weight/ln(u), where u = hash/2^64 is in (0, 1).
+// The score is returned as uint64 that preserves the order (positive float64 bits),
+// and can be directly compared with other weighted scores.
+// With equal weights, the resulting order is the same as the one of the hashes.
+func HrwWeighted(hash, weight uint64) uint64 {
+	u := (float64(hash>>11) + 0.5) / (1 << 53)
+	return math.Float64bits(-float64(weight) / math.Log(u))
+}
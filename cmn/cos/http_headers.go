@@ -24,10 +24,13 @@ const (
 	// range to read:
 	HdrRange          = "Range" // Ref: https://www.rfc-editor.org/rfc/rfc7233#section-2.1
 	HdrRangeValPrefix = "bytes="
+	HdrIfRange        = "If-Range" // Ref: https://www.rfc-editor.org/rfc/rfc7233#section-3.2
+	HdrIfMatch        = "If-Match" // (range reads pinned to a given ETag)
 	// range read response:
 	HdrContentRange          = "Content-Range"
 	HdrContentRangeValPrefix = "bytes " // Ref: https://tools.ietf.org/html/rfc7233#section-4.2
 	HdrAcceptRanges          = "Accept-Ranges"
+	HdrLastModified          = "Last-Modified" // (used as If-Range validator when there's no ETag)
 
 	// content length & type
 	HdrContentType        = "Content-Type"
@@ -59,6 +62,12 @@ const (
 	S3HdrContentSHA256 = "x-amz-content-sha256"
 	S3HdrBckRegion     = "x-amz-bucket-region"
 
+	// object lock
+	S3HdrObjLockMode         = "x-amz-object-lock-mode"
+	S3HdrObjLockRetainUntil  = "x-amz-object-lock-retain-until-date"
+	S3HdrObjLockLegalHold    = "x-amz-object-lock-legal-hold"
+	S3HdrBypassGovernanceRet = "x-amz-bypass-governance-retention"
+
 	S3ChecksumCRC32  = "x-amz-checksum-crc32"
 	S3ChecksumCRC32C = "x-amz-checksum-crc32c"
 	S3ChecksumSHA1   = "x-amz-checksum-sha1"
@@ -139,6 +139,13 @@ type (
 		name   string // object's name
 		d1, d2 uint64 // lom.md.(bucket-ID) and lom.bck.(bucket-ID), respectively
 	}
+	ErrObjPinned struct {
+		name string // object's cname
+	}
+	ErrObjLocked struct {
+		name   string // object's cname
+		reason string // legal hold or retention
+	}
 	ErrAborted struct {
 		err  error
 		what string
@@ -533,6 +540,32 @@ func isErrObjDefunct(err error) bool {
 	return ok
 }
 
+// ErrObjPinned
+
+func NewErrObjPinned(name string) *ErrObjPinned { return &ErrObjPinned{name} }
+
+func (e *ErrObjPinned) Error() string {
+	return e.name + " is pinned (to evict, unpin or use force)"
+}
+
+func IsErrObjPinned(err error) bool {
+	_, ok := err.(*ErrObjPinned)
+	return ok
+}
+
+// ErrObjLocked
+
+func NewErrObjLocked(name, reason string) *ErrObjLocked { return &ErrObjLocked{name, reason} }
+
+func (e *ErrObjLocked) Error() string {
+	return e.name + " is locked (" + e.reason + ")"
+}
+
+func IsErrObjLocked(err error) bool {
+	_, ok := err.(*ErrObjLocked)
+	return ok
+}
+
 // ErrAborted
 
 func NewErrAborted(what, ctx string, err error) *ErrAborted {
@@ -32,6 +32,7 @@ const (
 	BmdPrevious = Bmd + ".prev" // bmd previous version
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
+	Sched       = ".ais.sched"  // scheduled jobs (proxy only)
 
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
@@ -49,4 +50,7 @@ const (
 	RebalanceMarker     = "rebalance"
 	NodeRestartedMarker = "node_restarted"
 	NodeRestartedPrev   = "node_restarted.prev"
+
+	// dsort checkpoints: per mountpath, per job
+	DsortDir = ".ais.dsort"
 )
@@ -84,10 +84,14 @@ func MakeRangeHdr(start, length int64) (hdr http.Header) {
 		return hdr
 	}
 	hdr = make(http.Header, 1)
-	hdr.Set(cos.HdrRange, fmt.Sprintf("%s%d-%d", cos.HdrRangeValPrefix, start, start+length-1))
+	hdr.Set(cos.HdrRange, MakeRangeVal(start, length))
 	return
 }
 
+func MakeRangeVal(start, length int64) string {
+	return fmt.Sprintf("%s%d-%d", cos.HdrRangeValPrefix, start, start+length-1)
+}
+
 // ParseURL splits URL path at "/" and matches resulting items against the specified, if any.
 // - splitAfter == true:  strings.Split() the entire path;
 // - splitAfter == false: strings.SplitN(len(itemsPresent)+itemsAfter)
@@ -0,0 +1,234 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Bucket inventory (apc.ActInventory): complete listing of a bucket that all targets
+// generate in parallel and store in the destination bucket, as follows:
+//
+// <dir>/<xid>/<tid>-000001.csv.gz      - gzipped chunk (CSV or JSON lines) containing
+// <dir>/<xid>/<tid>-000002.csv.gz        up to `chunk_entries` objects
+// ...
+// <dir>/<xid>/manifest-<tid>.json      - per-target manifest (see InventoryManifest)
+//
+// Inventory is complete when there's a manifest from each target.
+
+const (
+	InvDefaultDir          = "inventory"
+	InvManifestPrefix      = "manifest-"
+	InvDefaultChunkEntries = 100_000
+	InvMaxChunkEntries     = 10_000_000
+)
+
+// inventory fields (and CSV columns), in order
+var InvFields = []string{
+	apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsVersion, apc.GetPropsAtime, apc.GetPropsCached,
+}
+
+type (
+	InventoryMsg struct {
+		ToBck        Bck    `json:"tobck"`                   // destination bucket
+		Prefix       string `json:"prefix,omitempty"`        // source objects' name prefix
+		Dir          string `json:"dir,omitempty"`           // destination virtual directory (default: "inventory/<provider>/<bucket>")
+		Format       string `json:"format,omitempty"`        // apc.InvFormatCSV (default) or apc.InvFormatJSONL
+		ChunkEntries int    `json:"chunk_entries,omitempty"` // max number of objects per chunk
+		ObjCached    bool   `json:"cached,omitempty"`        // remote bucket: only the objects that are present in the cluster
+	}
+	InventoryEntry struct {
+		Name     string `json:"name"`
+		Checksum string `json:"checksum,omitempty"`
+		Version  string `json:"version,omitempty"`
+		Atime    string `json:"atime,omitempty"` // RFC3339Nano; empty when not cached
+		Size     int64  `json:"size"`
+		Cached   bool   `json:"cached"`
+	}
+	InventoryChunk struct {
+		Name  string `json:"name"`
+		Count int64  `json:"count,string"`
+	}
+	InventoryManifest struct {
+		Bck      Bck              `json:"bck"` // source bucket
+		Xid      string           `json:"xid"`
+		Format   string           `json:"format"`
+		Fields   []string         `json:"fields"`
+		Chunks   []InventoryChunk `json:"chunks"`
+		Count    int64            `json:"count,string"`    // total number of objects
+		Size     int64            `json:"size,string"`     // total size of objects
+		Started  int64            `json:"started,string"`  // unix nano
+		Finished int64            `json:"finished,string"` // ditto
+		Targets  int              `json:"targets"`         // number of merged (per-target) manifests
+	}
+)
+
+//////////////////
+// InventoryMsg //
+//////////////////
+
+// validate and fill-in defaults; `bck` is the source bucket
+func (msg *InventoryMsg) Validate(bck *Bck) error {
+	if msg.ToBck.IsEmpty() {
+		return errors.New("inventory: destination bucket is not specified")
+	}
+	switch msg.Format {
+	case "":
+		msg.Format = apc.InvFormatCSV
+	case apc.InvFormatCSV, apc.InvFormatJSONL:
+	default:
+		return fmt.Errorf("inventory: invalid format %q (expecting %q or %q)", msg.Format, apc.InvFormatCSV, apc.InvFormatJSONL)
+	}
+	if msg.ChunkEntries == 0 {
+		msg.ChunkEntries = InvDefaultChunkEntries
+	} else if msg.ChunkEntries < 0 || msg.ChunkEntries > InvMaxChunkEntries {
+		return fmt.Errorf("inventory: invalid number of entries per chunk %d (expecting 1 to %d)",
+			msg.ChunkEntries, InvMaxChunkEntries)
+	}
+	if msg.Dir = strings.Trim(msg.Dir, "/"); msg.Dir == "" {
+		msg.Dir = path.Join(InvDefaultDir, bck.Provider, bck.Name)
+	}
+	if msg.ToBck.Equal(bck) && strings.HasPrefix(msg.Dir, msg.Prefix) {
+		return fmt.Errorf("inventory: destination %s would be included in the inventory of %s (hint: use prefix)",
+			msg.ToBck.Cname(msg.Dir), bck)
+	}
+	return nil
+}
+
+func (msg *InventoryMsg) ObjDir(xid string) string { return msg.Dir + "/" + xid + "/" }
+
+func (msg *InventoryMsg) ChunkName(xid, tid string, num int) string {
+	return fmt.Sprintf("%s%s-%06d.%s.gz", msg.ObjDir(xid), tid, num, msg.Format)
+}
+
+func (msg *InventoryMsg) ManifestName(xid, tid string) string {
+	return msg.ObjDir(xid) + InvManifestPrefix + tid + ".json"
+}
+
+///////////////////////
+// InventoryManifest //
+///////////////////////
+
+func (m *InventoryManifest) Merge(other *InventoryManifest) {
+	if m.Targets == 0 {
+		m.Bck, m.Xid, m.Format, m.Fields = other.Bck, other.Xid, other.Format, other.Fields
+		m.Started, m.Finished = other.Started, other.Finished
+	}
+	m.Chunks = append(m.Chunks, other.Chunks...)
+	m.Count += other.Count
+	m.Size += other.Size
+	m.Started = min(m.Started, other.Started)
+	m.Finished = max(m.Finished, other.Finished)
+	m.Targets += max(other.Targets, 1)
+}
+
+///////////////
+// InvWriter //
+///////////////
+
+// InvWriter writes gzipped inventory chunk in one of the supported formats
+type InvWriter struct {
+	gzw  *gzip.Writer
+	csvw *csv.Writer
+	jsw  *jsoniter.Encoder
+	rec  []string
+}
+
+func NewInvWriter(w io.Writer, format string) *InvWriter {
+	iw := &InvWriter{gzw: gzip.NewWriter(w)}
+	if format == apc.InvFormatJSONL {
+		iw.jsw = jsoniter.NewEncoder(iw.gzw)
+	} else {
+		iw.csvw = csv.NewWriter(iw.gzw)
+		iw.rec = make([]string, len(InvFields))
+	}
+	return iw
+}
+
+func (iw *InvWriter) Write(e *InventoryEntry) error {
+	if iw.jsw != nil {
+		return iw.jsw.Encode(e)
+	}
+	iw.rec[0], iw.rec[1], iw.rec[2] = e.Name, strconv.FormatInt(e.Size, 10), e.Checksum
+	iw.rec[3], iw.rec[4], iw.rec[5] = e.Version, e.Atime, strconv.FormatBool(e.Cached)
+	return iw.csvw.Write(iw.rec)
+}
+
+// flush and close gzip stream (but not the underlying writer)
+func (iw *InvWriter) Close() error {
+	if iw.csvw != nil {
+		iw.csvw.Flush()
+		if err := iw.csvw.Error(); err != nil {
+			return err
+		}
+	}
+	return iw.gzw.Close()
+}
+
+// ReadInventory reads gzipped inventory chunk and calls back for each entry
+// (the callback must not retain the entry)
+func ReadInventory(r io.Reader, format string, cb func(*InventoryEntry) error) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzr.Close()
+	var e InventoryEntry
+	if format == apc.InvFormatJSONL {
+		br := bufio.NewReader(gzr)
+		for {
+			line, err := br.ReadBytes('\n')
+			if len(line) > 0 {
+				e = InventoryEntry{}
+				if errV := jsoniter.Unmarshal(line, &e); errV != nil {
+					return errV
+				}
+				if errV := cb(&e); errV != nil {
+					return errV
+				}
+			}
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	csvr := csv.NewReader(gzr)
+	csvr.FieldsPerRecord = len(InvFields)
+	csvr.ReuseRecord = true
+	for {
+		rec, err := csvr.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		e.Name, e.Checksum, e.Version, e.Atime = rec[0], rec[2], rec[3], rec[4]
+		if e.Size, err = strconv.ParseInt(rec[1], 10, 64); err != nil {
+			return err
+		}
+		if e.Cached, err = strconv.ParseBool(rec[5]); err != nil {
+			return err
+		}
+		if err := cb(&e); err != nil {
+			return err
+		}
+	}
+}
@@ -16,6 +16,7 @@ const (
 	ActNone = iota
 	ActExit
 	ActRotate
+	ActFlush // flush buffered lines (e.g., prior to reading the log)
 )
 
 var MaxSize int64 = 4 * 1024 * 1024 // usually, config.log.max_size
@@ -40,13 +41,32 @@ func SetTitle(s string)              { title = s }
 func InfoLogName() string { return sname() + ".INFO" }
 func ErrLogName() string  { return sname() + ".ERROR" }
 
+func AuditLogName() string { return sname() + ".AUDIT" }
+
+// Audit appends a single line (JSON, by convention) to the audit log - a separate
+// log that is created upon first write and is flushed and rotated along with the others
+func Audit(line []byte) {
+	onceInitFiles.Do(initFiles)
+	onceInitAudit.Do(initAudit)
+	nlog := auditLog.Load()
+	if nlog == nil {
+		return
+	}
+	nlog.mw.Lock()
+	nlog.line.reset()
+	nlog.line.Write(line)
+	nlog.line.eol()
+	nlog.write(&nlog.line)
+	nlog.mw.Unlock()
+}
+
 func Flush(action int) {
 	now := mono.NanoTime()
-	for _, sev := range []severity{sevInfo, sevErr} {
-		var (
-			nlog = nlogs[sev]
-			oob  bool
-		)
+	for _, nlog := range []*nlog{nlogs[sevInfo], nlogs[sevErr], auditLog.Load()} {
+		var oob bool
+		if nlog == nil {
+			continue // (audit log not used)
+		}
 
 		nlog.mw.Lock()
 		if nlog.file == nil || (nlog.pw.length() == 0 && action != ActRotate) {
@@ -83,5 +103,8 @@ func Since() time.Duration {
 }
 
 func OOB() bool {
+	if audit := auditLog.Load(); audit != nil && audit.oob.Load() {
+		return true
+	}
 	return nlogs[sevInfo].oob.Load() || nlogs[sevErr].oob.Load()
 }
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,7 +24,7 @@ var (
 		"common_stats": 0,
 		"err":          0,
 	}
-	sevText = []string{sevInfo: "INFO", sevErr: "ERROR"}
+	sevText = []string{sevInfo: "INFO", sevErr: "ERROR", sevAudit: "AUDIT"}
 )
 
 var (
@@ -31,6 +32,9 @@ var (
 
 	nlogs [3]*nlog
 
+	// (created upon first write and read by Flush and OOB concurrently - see Audit)
+	auditLog atomic.Pointer[nlog]
+
 	logDir  string
 	arg0    string
 	aisrole string
@@ -39,6 +43,7 @@ var (
 	pid int
 
 	onceInitFiles sync.Once
+	onceInitAudit sync.Once
 
 	toStderr     bool
 	alsoToStderr bool
@@ -62,6 +67,15 @@ func initFiles() {
 	}
 }
 
+func initAudit() {
+	nlog := newNlog(sevAudit)
+	if err := nlog.rotate(time.Now()); err != nil {
+		os.Stderr.WriteString("failed to create audit log: " + err.Error() + "\n")
+		return
+	}
+	auditLog.Store(nlog)
+}
+
 func fcreateAll(sev severity) error {
 	now := time.Now()
 	for s := sev; s >= sevInfo && nlogs[s] == nil; s-- {
@@ -32,6 +32,7 @@ const (
 	sevInfo severity = iota
 	sevWarn
 	sevErr
+	sevAudit // (not a severity - separate log of JSON lines, see Audit)
 )
 
 type (
@@ -226,6 +227,9 @@ func (nlog *nlog) rotate(now time.Time) (err error) {
 
 	nlog.written.Store(0)
 	nlog.erred.Store(false)
+	if nlog.sev == sevAudit {
+		return // (no headers)
+	}
 	if title == "" {
 		line1 = "Started up at " + snow + ", " + s
 		_, err = nlog.file.WriteString(line1)
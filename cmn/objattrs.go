@@ -183,6 +183,33 @@ func (oa *ObjAttrs) FromHeader(hdr http.Header) (cksum *cos.Cksum) {
 	return
 }
 
+// cross-target replicas of the same object: same size and (at least one of) the same
+// version and checksum - compare with (local <=> remote) Equal below
+func (oa *ObjAttrs) SameReplica(rem cos.OAH) bool {
+	if oa.Size != rem.SizeBytes(true) {
+		return false
+	}
+	var count int
+	if remVer := rem.Version(true); oa.Ver != "" && remVer != "" {
+		if oa.Ver != remVer {
+			return false
+		}
+		count++
+	}
+	if !rem.Checksum().IsEmpty() && !oa.Cksum.IsEmpty() && rem.Checksum().Ty() == oa.Cksum.Ty() {
+		if !rem.Checksum().Equal(oa.Cksum) {
+			return false
+		}
+		count++
+	}
+	if remMeta, ok := rem.GetCustomKey(ETag); ok && remMeta != "" {
+		if locMeta, ok := oa.GetCustomKey(ETag); ok && locMeta != "" && remMeta != locMeta {
+			return false
+		}
+	}
+	return count > 0
+}
+
 // local <=> remote equality in the context of cold-GET and download. This function
 // decides whether we need to go ahead and re-read the object from its remote location.
 //
@@ -7,6 +7,7 @@ package tests_test
 import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -183,6 +184,49 @@ var _ = Describe("API", func() {
 					},
 				},
 			),
+			Entry("replication",
+				cmn.Bprops{
+					Repl: cmn.ReplConf{Copies: 2},
+				},
+				cmn.BpropsToSet{
+					Repl: &cmn.ReplConfToSet{
+						Enabled: apc.Bool(true),
+						Sync:    apc.Bool(true),
+					},
+				},
+				cmn.Bprops{
+					Repl: cmn.ReplConf{
+						Copies:  2, // check default value didn't change
+						Sync:    true,
+						Enabled: true,
+					},
+				},
+			),
 		)
 	})
+
+	Describe("Validate", func() {
+		It("should validate replication", func() {
+			props := &cmn.Bprops{
+				Provider: apc.AIS,
+				Cksum:    cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Repl:     cmn.ReplConf{Copies: 3, Enabled: true},
+			}
+			Expect(props.Validate(3)).NotTo(HaveOccurred())
+
+			// not enough targets: can be forced
+			err := props.Validate(2)
+			Expect(err).To(HaveOccurred())
+			Expect(cmn.IsErrSoft(err)).To(BeTrue())
+
+			props.Repl.Copies = 1
+			err = props.Validate(3)
+			Expect(err).To(HaveOccurred())
+			Expect(cmn.IsErrSoft(err)).To(BeFalse())
+
+			props.Repl.Copies = 2
+			props.EC = cmn.ECConf{DataSlices: 1, ParitySlices: 1, Enabled: true}
+			Expect(props.Validate(3)).To(HaveOccurred())
+		})
+	})
 })
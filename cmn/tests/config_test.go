@@ -8,9 +8,11 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -72,3 +74,76 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBlobDl(t *testing.T) {
+	valid := []cmn.BlobDlConf{
+		{},
+		{Threshold: cos.GiB, ChunkSize: cmn.BlobDlMinChunkSize, NumWorkers: 1},
+		{ChunkSize: cmn.BlobDlMaxChunkSize, NumWorkers: cmn.BlobDlMaxNumWorkers},
+	}
+	for i := range valid {
+		tassert.CheckError(t, valid[i].Validate())
+	}
+	invalid := []cmn.BlobDlConf{
+		{Threshold: -1},
+		{ChunkSize: cmn.BlobDlMinChunkSize - 1},
+		{ChunkSize: cmn.BlobDlMaxChunkSize + 1},
+		{NumWorkers: -1},
+		{NumWorkers: cmn.BlobDlMaxNumWorkers + 1},
+	}
+	for i := range invalid {
+		if err := invalid[i].Validate(); err == nil {
+			t.Errorf("validation of invalid blob-download config %+v succeeded", invalid[i])
+		}
+	}
+	conf := cmn.BlobDlConf{}
+	tassert.Errorf(t, conf.Chunk() == cmn.BlobDlDefaultChunkSize, "expected default chunk size, got %d", conf.Chunk())
+	tassert.Errorf(t, conf.Workers() == cmn.BlobDlDefaultNumWorkers, "expected default num workers, got %d", conf.Workers())
+}
+
+func TestValidateReadahead(t *testing.T) {
+	valid := []cmn.ReadaheadConf{
+		{},
+		{Enabled: true},
+		{Count: cmn.ReadaheadMaxCount, Enabled: true},
+	}
+	for i := range valid {
+		tassert.CheckError(t, valid[i].Validate())
+	}
+	invalid := []cmn.ReadaheadConf{
+		{Count: -1},
+		{Count: cmn.ReadaheadMaxCount + 1, Enabled: true},
+	}
+	for i := range invalid {
+		if err := invalid[i].Validate(); err == nil {
+			t.Errorf("validation of invalid readahead config %+v succeeded", invalid[i])
+		}
+	}
+	conf := cmn.ReadaheadConf{}
+	tassert.Errorf(t, conf.Num() == cmn.ReadaheadDefaultCount, "expected default count, got %d", conf.Num())
+}
+
+func TestValidateObjLock(t *testing.T) {
+	day := cos.Duration(24 * time.Hour)
+	valid := []cmn.ObjLockConf{
+		{},
+		{Enabled: true},
+		{Enabled: true, Mode: apc.RetentionGovernance, Retention: day},
+		{Enabled: true, Mode: apc.RetentionCompliance, Retention: 365 * day},
+	}
+	for i := range valid {
+		tassert.CheckError(t, valid[i].Validate())
+	}
+	invalid := []cmn.ObjLockConf{
+		{Enabled: true, Mode: "legal"},
+		{Enabled: true, Mode: apc.RetentionGovernance},
+		{Enabled: true, Retention: day},
+		{Enabled: true, Mode: apc.RetentionCompliance, Retention: -day},
+		{Mode: apc.RetentionGovernance, Retention: day},
+	}
+	for i := range invalid {
+		if err := invalid[i].Validate(); err == nil {
+			t.Errorf("validation of invalid object lock config %+v succeeded", invalid[i])
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inventory", func() {
+	bck := cmn.Bck{Name: "abc", Provider: apc.AWS}
+
+	Describe("Validate", func() {
+		It("should fill-in defaults", func() {
+			msg := &cmn.InventoryMsg{ToBck: cmn.Bck{Name: "inv", Provider: apc.AIS}}
+			Expect(msg.Validate(&bck)).NotTo(HaveOccurred())
+			Expect(msg.Format).To(Equal(apc.InvFormatCSV))
+			Expect(msg.ChunkEntries).To(Equal(cmn.InvDefaultChunkEntries))
+			Expect(msg.Dir).To(Equal("inventory/aws/abc"))
+			Expect(msg.ChunkName("xid", "t1", 2)).To(Equal("inventory/aws/abc/xid/t1-000002.csv.gz"))
+			Expect(msg.ManifestName("xid", "t1")).To(Equal("inventory/aws/abc/xid/manifest-t1.json"))
+		})
+
+		DescribeTable("should fail",
+			func(msg cmn.InventoryMsg) {
+				Expect(msg.Validate(&bck)).To(HaveOccurred())
+			},
+			Entry("no destination", cmn.InventoryMsg{}),
+			Entry("invalid format", cmn.InventoryMsg{ToBck: cmn.Bck{Name: "inv", Provider: apc.AIS}, Format: "xml"}),
+			Entry("invalid chunk entries", cmn.InventoryMsg{ToBck: cmn.Bck{Name: "inv", Provider: apc.AIS}, ChunkEntries: -1}),
+			Entry("destination within source", cmn.InventoryMsg{ToBck: bck}),
+		)
+
+		It("should allow source bucket as destination when the prefix excludes it", func() {
+			msg := &cmn.InventoryMsg{ToBck: bck, Prefix: "data/"}
+			Expect(msg.Validate(&bck)).NotTo(HaveOccurred())
+		})
+	})
+
+	DescribeTable("should write and read back",
+		func(format string) {
+			var (
+				buf     bytes.Buffer
+				entries = []cmn.InventoryEntry{
+					{Name: "a/b/c", Size: 1024, Checksum: "01234567", Version: "3", Atime: "2024-01-02T03:04:05.000000006Z", Cached: true},
+					{Name: "with,comma \"and\" quotes", Size: 0},
+					{Name: "remote", Size: 1 << 40, Version: "v1"},
+				}
+			)
+			iw := cmn.NewInvWriter(&buf, format)
+			for i := range entries {
+				Expect(iw.Write(&entries[i])).NotTo(HaveOccurred())
+			}
+			Expect(iw.Close()).NotTo(HaveOccurred())
+
+			read := make([]cmn.InventoryEntry, 0, len(entries))
+			err := cmn.ReadInventory(&buf, format, func(e *cmn.InventoryEntry) error {
+				read = append(read, *e)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read).To(Equal(entries))
+		},
+		Entry("csv", apc.InvFormatCSV),
+		Entry("jsonl", apc.InvFormatJSONL),
+	)
+
+	It("should stop reading upon callback error", func() {
+		var buf bytes.Buffer
+		iw := cmn.NewInvWriter(&buf, apc.InvFormatCSV)
+		for i := 0; i < 10; i++ {
+			Expect(iw.Write(&cmn.InventoryEntry{Name: fmt.Sprintf("obj-%d", i)})).NotTo(HaveOccurred())
+		}
+		Expect(iw.Close()).NotTo(HaveOccurred())
+
+		var cnt int
+		err := cmn.ReadInventory(&buf, apc.InvFormatCSV, func(*cmn.InventoryEntry) error {
+			if cnt++; cnt == 3 {
+				return fmt.Errorf("stop")
+			}
+			return nil
+		})
+		Expect(err).To(MatchError("stop"))
+		Expect(cnt).To(Equal(3))
+
+		err = cmn.ReadInventory(strings.NewReader("not gzipped"), apc.InvFormatCSV, func(*cmn.InventoryEntry) error { return nil })
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should merge manifests", func() {
+		m := &cmn.InventoryManifest{}
+		m.Merge(&cmn.InventoryManifest{
+			Xid: "xid", Format: apc.InvFormatCSV, Targets: 1, Count: 10, Size: 100, Started: 5, Finished: 20,
+			Chunks: []cmn.InventoryChunk{{Name: "t1-000001", Count: 10}},
+		})
+		m.Merge(&cmn.InventoryManifest{
+			Xid: "xid", Format: apc.InvFormatCSV, Targets: 1, Count: 7, Size: 70, Started: 3, Finished: 15,
+			Chunks: []cmn.InventoryChunk{{Name: "t2-000001", Count: 7}},
+		})
+		Expect(m.Xid).To(Equal("xid"))
+		Expect(m.Targets).To(Equal(2))
+		Expect(m.Count).To(BeEquivalentTo(17))
+		Expect(m.Size).To(BeEquivalentTo(170))
+		Expect(m.Started).To(BeEquivalentTo(3))
+		Expect(m.Finished).To(BeEquivalentTo(20))
+		Expect(m.Chunks).To(HaveLen(2))
+	})
+})
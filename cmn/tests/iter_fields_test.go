@@ -69,6 +69,10 @@ var _ = Describe("IterFields", func() {
 					"mirror.copies":       int64(0),
 					"mirror.burst_buffer": 0,
 
+					"replication.enabled": false,
+					"replication.copies":  0,
+					"replication.sync":    false,
+
 					"ec.enabled":           true,
 					"ec.parity_slices":     1024,
 					"ec.data_slices":       0,
@@ -99,6 +103,17 @@ var _ = Describe("IterFields", func() {
 
 					"write_policy.data": apc.WritePolicy(""),
 					"write_policy.md":   apc.WritePolicy(""),
+
+					"blob_download.threshold":   cos.SizeIEC(0),
+					"blob_download.chunk_size":  cos.SizeIEC(0),
+					"blob_download.num_workers": 0,
+
+					"readahead.count":   0,
+					"readahead.enabled": false,
+
+					"object_lock.mode":      "",
+					"object_lock.retention": cos.Duration(0),
+					"object_lock.enabled":   false,
 				},
 			),
 			Entry("list BpropsToSet fields",
@@ -124,6 +139,10 @@ var _ = Describe("IterFields", func() {
 					"mirror.copies":       (*int64)(nil),
 					"mirror.burst_buffer": (*int)(nil),
 
+					"replication.enabled": (*bool)(nil),
+					"replication.copies":  (*int)(nil),
+					"replication.sync":    (*bool)(nil),
+
 					"ec.enabled":           apc.Bool(true),
 					"ec.parity_slices":     apc.Int(1024),
 					"ec.data_slices":       (*int)(nil),
@@ -144,12 +163,26 @@ var _ = Describe("IterFields", func() {
 					"lru.enabled":           (*bool)(nil),
 					"lru.dont_evict_time":   (*cos.Duration)(nil),
 					"lru.capacity_upd_time": (*cos.Duration)(nil),
+					"lru.policy":            (*apc.EvictPolicy)(nil),
+					"lru.ttl":               (*cos.Duration)(nil),
+					"lru.priority":          (*int)(nil),
 
 					"access": apc.AccAttrs(1024),
 
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   apc.WPolicy(apc.WriteDelayed),
 
+					"blob_download.threshold":   (*cos.SizeIEC)(nil),
+					"blob_download.chunk_size":  (*cos.SizeIEC)(nil),
+					"blob_download.num_workers": (*int)(nil),
+
+					"readahead.count":   (*int)(nil),
+					"readahead.enabled": (*bool)(nil),
+
+					"object_lock.mode":      (*string)(nil),
+					"object_lock.retention": (*cos.Duration)(nil),
+					"object_lock.enabled":   (*bool)(nil),
+
 					"extra.hdfs.ref_directory": (*string)(nil),
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
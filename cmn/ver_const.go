@@ -38,8 +38,10 @@ const (
 	MetaverRMD   = 1 // Rebalance MD (jsp)
 	MetaverVMD   = 1 // Volume MD (jsp)
 	MetaverEtlMD = 1 // ETL MD (jsp)
+	MetaverSched = 1 // scheduled jobs (jsp)
 
-	MetaverLOM = 1 // LOM
+	MetaverLOM   = 2 // LOM (v2: extension records - see cluster/lom_xattr.go)
+	MetaverLOMv1 = 1 // LOM without extension records (still written and read)
 
 	MetaverConfig      = 3 // Global Configuration (jsp)
 	MetaverAuthNConfig = 1 // Authn config (jsp) // ditto
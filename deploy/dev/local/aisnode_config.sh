@@ -19,6 +19,11 @@ cat > $AIS_CONF_FILE <<EOL
 		"burst_buffer": 128,
 		"enabled":      ${AIS_MIRROR_ENABLED:-false}
 	},
+	"replication": {
+		"copies":       2,
+		"sync":         false,
+		"enabled":      false
+	},
 	"ec": {
 		"objsize_limit":	${AIS_OBJ_SIZE_LIMIT:-262144},
 		"compression":		"${AIS_EC_COMPRESSION:-never}",
@@ -70,6 +75,12 @@ cat > $AIS_CONF_FILE <<EOL
 		"capacity_upd_time": "10m",
 		"enabled":           true
 	},
+	"tiering": {
+		"hot_hits":  3,
+		"cold_time": "24h",
+		"interval":  "1h",
+		"enabled":   false
+	},
 	"disk":{
 	    "iostat_time_long":  "${AIS_IOSTAT_TIME_LONG:-2s}",
 	    "iostat_time_short": "${AIS_IOSTAT_TIME_SHORT:-100ms}",
@@ -99,7 +110,8 @@ cat > $AIS_CONF_FILE <<EOL
 		"idle_teardown":	"${AIS_TRANSPORT_IDLE_TEARDOWN:-4s}",
 		"quiescent":		"${AIS_TRANSPORT_QUIESCENT:-10s}",
 		"lz4_block":		"${AIS_TRANSPORT_LZ4_BLOCK:-256kb}",
-		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false}
+		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false},
+		"compressor":		"${AIS_TRANSPORT_COMPRESSOR:-lz4}"
 	},
 	"memsys": {
 		"min_free":		"2gb",
@@ -129,6 +141,12 @@ cat > $AIS_CONF_FILE <<EOL
 			"read_buffer_size":  ${HTTP_READ_BUFFER_SIZE:-0},
 			"chunked_transfer":  ${AIS_HTTP_CHUNKED_TRANSFER:-true},
 			"skip_verify":       ${AIS_SKIP_VERIFY_CRT:-false}
+		},
+		"intra_tls": {
+			"enabled":  ${AIS_INTRA_TLS:-false},
+			"node_crt": "${AIS_INTRA_TLS_CRT}",
+			"node_key": "${AIS_INTRA_TLS_KEY}",
+			"ca":       "${AIS_INTRA_TLS_CA}"
 		}
 	},
 	"fshc": {
@@ -32,6 +32,8 @@ const PrefixJobID = "dnl-"
 
 const DownloadProgressInterval = 10 * time.Second
 
+const MaxVerifyRetries = 10 // (see MultiBody.VerifyRetries)
+
 type (
 	// NOTE: Changing this structure requires changes in `MarshalJSON` and `UnmarshalJSON` methods.
 	Body struct {
@@ -57,6 +59,8 @@ type (
 		Total         int       `json:"total"`          // total number of tasks, negative if unknown
 		AllDispatched bool      `json:"all_dispatched"` // if true, dispatcher has already scheduled all tasks for given job
 		Aborted       bool      `json:"aborted"`
+		// total size of the previously downloaded (resumed, not downloaded again) parts
+		ResumedSize int64 `json:"resumed_size,string,omitempty"`
 	}
 
 	JobInfos []*Job
@@ -97,6 +101,7 @@ type (
 		Name       string    `json:"name"`
 		Downloaded int64     `json:"downloaded,string"`
 		Total      int64     `json:"total,string,omitempty"`
+		Resumed    int64     `json:"resumed,string,omitempty"` // previously downloaded part (not downloaded again)
 		StartTime  time.Time `json:"start_time,omitempty"`
 		EndTime    time.Time `json:"end_time,omitempty"`
 	}
@@ -129,6 +134,10 @@ type (
 	MultiBody struct {
 		Base
 		ObjectsPayload any `json:"objects"`
+		// alternatively, HTTP(S) URL or AIS object (e.g. "ais://abc/manifest.jsonl") - see manifest.go
+		Manifest string `json:"manifest,omitempty"`
+		// number of times to retry download that fails manifest (size, checksum) verification
+		VerifyRetries int `json:"verify_retries,omitempty"`
 	}
 )
 
@@ -146,6 +155,7 @@ func (j *Job) Aggregate(rhs *Job) {
 	j.ScheduledCnt += rhs.ScheduledCnt
 	j.SkippedCnt += rhs.SkippedCnt
 	j.ErrorCnt += rhs.ErrorCnt
+	j.ResumedSize += rhs.ResumedSize
 	j.Total += rhs.Total
 	j.AllDispatched = j.AllDispatched && rhs.AllDispatched
 	j.Aborted = j.Aborted || rhs.Aborted
@@ -412,8 +422,13 @@ func (b *RangeBody) String() string {
 ///////////////
 
 func (b *MultiBody) Validate() error {
-	if b.ObjectsPayload == nil {
+	switch {
+	case b.ObjectsPayload == nil && b.Manifest == "":
 		return errors.New("body should not be empty")
+	case b.ObjectsPayload != nil && b.Manifest != "":
+		return errors.New("'objects' and 'manifest' cannot be defined together (choose one or the other)")
+	case b.VerifyRetries < 0 || b.VerifyRetries > MaxVerifyRetries:
+		return fmt.Errorf("invalid 'verify_retries' %d (expecting 0 thru %d)", b.VerifyRetries, MaxVerifyRetries)
 	}
 	return b.Base.Validate()
 }
@@ -456,6 +471,9 @@ func (b *MultiBody) Describe() string {
 	if b.Description != "" {
 		return b.Description
 	}
+	if b.Manifest != "" {
+		return fmt.Sprintf("%s -> %s", b.Manifest, b.Bck)
+	}
 	return fmt.Sprintf("multi-download -> %s", b.Bck)
 }
 
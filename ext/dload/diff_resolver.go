@@ -46,14 +46,18 @@ type (
 	}
 
 	WebResource struct {
+		Cksum   *cos.Cksum // expected checksum (optional)
 		ObjName string
 		Link    string
+		Size    int64 // expected size (optional)
 	}
 
 	DstElement struct {
+		Cksum   *cos.Cksum
 		ObjName string
 		Version string
 		Link    string
+		Size    int64
 	}
 
 	DiffResolverResult struct {
@@ -169,6 +173,8 @@ func (dr *DiffResolver) PushDst(v any) {
 		d = &DstElement{
 			ObjName: x.ObjName,
 			Link:    x.Link,
+			Size:    x.Size,
+			Cksum:   x.Cksum,
 		}
 	default:
 		debug.FailTypeCast(v)
@@ -261,6 +267,8 @@ func (dr *DiffResolver) push(job jobif, d *dispatcher) {
 				dr.PushDst(&WebResource{
 					ObjName: obj.objName,
 					Link:    obj.link,
+					Size:    obj.size,
+					Cksum:   obj.cksum,
 				})
 			} else {
 				dr.PushDst(&BackendResource{
@@ -228,6 +228,8 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 					objName:    dst.ObjName,
 					link:       dst.Link,
 					fromRemote: dst.Link == "",
+					size:       dst.Size,
+					cksum:      dst.Cksum,
 				}
 			} else {
 				src := result.Src
@@ -255,7 +257,7 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 			if result.Action == DiffResolverDelete {
 				requiresSync := job.Sync()
 				debug.Assert(requiresSync)
-				if _, err := g.t.EvictObject(result.Src); err != nil {
+				if _, err := g.t.EvictObject(result.Src, true /*force*/); err != nil {
 					task.markFailed(err.Error())
 				} else {
 					g.store.incFinished(job.ID())
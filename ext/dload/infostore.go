@@ -96,6 +96,12 @@ func (is *infoStore) incErrorCnt(id string) {
 	dljob.errorCnt.Inc()
 }
 
+func (is *infoStore) addResumed(id string, size int64) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.resumedSize.Add(size)
+}
+
 func (is *infoStore) setAllDispatched(id string, dispatched bool) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
@@ -36,8 +36,10 @@ var (
 
 type (
 	dlObj struct {
+		cksum      *cos.Cksum // expected checksum, if provided (see manifest.go)
 		objName    string
 		link       string
+		size       int64 // expected size, if provided (ditto)
 		fromRemote bool
 	}
 
@@ -68,6 +70,9 @@ type (
 		// via tryAcquire and release
 		throttler() *throttler
 
+		// number of times to retry download that fails integrity verification
+		verifyRetries() int
+
 		// job cleanup
 		cleanup()
 	}
@@ -80,6 +85,7 @@ type (
 		description string
 		timeout     time.Duration
 		throt       throttler
+		vretries    int
 	}
 
 	sliceDlJob struct {
@@ -125,6 +131,7 @@ type (
 		scheduledCnt  atomic.Int32
 		skippedCnt    atomic.Int32
 		errorCnt      atomic.Int32
+		resumedSize   atomic.Int64
 		total         int
 		aborted       atomic.Bool
 		allDispatched atomic.Bool
@@ -191,6 +198,7 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 
 func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
 func (j *baseDlJob) throttler() *throttler { return &j.throt }
+func (j *baseDlJob) verifyRetries() int    { return j.vretries }
 
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
@@ -237,7 +245,16 @@ func newMultiDlJob(t cluster.Target, id string, bck *meta.Bck, payload *MultiBod
 
 	mj = &multiDlJob{}
 	mj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	mj.vretries = payload.VerifyRetries
 
+	if payload.Manifest != "" {
+		var entries []ManifestEntry
+		if entries, err = loadManifest(t, payload.Manifest); err != nil {
+			return nil, err
+		}
+		mj.objs, err = manifestDlObjs(t, bck, entries)
+		return
+	}
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
 	}
@@ -423,6 +440,7 @@ func (j *dljob) clone() Job {
 		ScheduledCnt:  int(j.scheduledCnt.Load()),
 		SkippedCnt:    int(j.skippedCnt.Load()),
 		ErrorCnt:      int(j.errorCnt.Load()),
+		ResumedSize:   j.resumedSize.Load(),
 		Total:         j.total,
 		AllDispatched: j.allDispatched.Load(),
 		Aborted:       j.aborted.Load(),
@@ -0,0 +1,340 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Download manifest: a list of links with (optional) destination object names,
+// expected sizes, and checksums. Two formats are supported:
+//
+//   - JSON lines, one ManifestEntry per line, e.g.:
+//     {"link": "https://a.b/c.tar", "object_name": "c.tar", "size": 1024, "cksum_type": "md5", "cksum_value": "..."}
+//   - CSV: link[,object_name[,size[,cksum_type,cksum_value]]] (header line, if present, must start with "link")
+//
+// The manifest is loaded by each target (from an HTTP(S) URL or an AIS object, e.g. ais://abc/manifest.jsonl);
+// upon download, each object gets verified against its expected size and/or checksum, and mismatches
+// are recorded as the job's task errors (see also MultiBody.VerifyRetries).
+
+const manifestHdr = "link"
+
+type (
+	ManifestEntry struct {
+		Link       string `json:"link"`
+		ObjName    string `json:"object_name,omitempty"`
+		CksumType  string `json:"cksum_type,omitempty"`
+		CksumValue string `json:"cksum_value,omitempty"`
+		Size       int64  `json:"size,omitempty"`
+	}
+	errMismatch struct {
+		err error
+	}
+)
+
+func (e *errMismatch) Error() string { return "integrity verification failed: " + e.err.Error() }
+func (e *errMismatch) Unwrap() error { return e.err }
+
+func isErrMismatch(err error) bool {
+	var e *errMismatch
+	return errors.As(err, &e)
+}
+
+///////////////////
+// ManifestEntry //
+///////////////////
+
+func (e *ManifestEntry) validate() error {
+	if e.Link == "" {
+		return errors.New("missing link")
+	}
+	if e.ObjName == "" {
+		objName := path.Base(e.Link)
+		if objName == "." || objName == "/" {
+			return fmt.Errorf("failed to extract object name from the download %q", e.Link)
+		}
+		e.ObjName = objName
+	}
+	if e.Size < 0 {
+		return fmt.Errorf("%q: invalid size %d", e.Link, e.Size)
+	}
+	if (e.CksumType == "") != (e.CksumValue == "") {
+		return fmt.Errorf("%q: checksum type and value must be specified together", e.Link)
+	}
+	if e.CksumType == "" {
+		return nil
+	}
+	if e.CksumType == cos.ChecksumNone {
+		return fmt.Errorf("%q: invalid checksum type %q", e.Link, e.CksumType)
+	}
+	return cos.ValidateCksumType(e.CksumType)
+}
+
+func (e *ManifestEntry) cksum() *cos.Cksum {
+	if e.CksumType == "" {
+		return nil
+	}
+	return cos.NewCksum(e.CksumType, e.CksumValue)
+}
+
+// ParseManifest reads and validates download manifest (see above).
+func ParseManifest(r io.Reader) (entries []ManifestEntry, err error) {
+	br := bufio.NewReader(r)
+	if first, errP := peekNonSpace(br); errP != nil {
+		return nil, errP
+	} else if first == '{' {
+		entries, err = parseJSONL(br)
+	} else {
+		entries, err = parseCSV(br)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("manifest is empty")
+	}
+	names := make(cos.StrSet, len(entries))
+	for i := range entries {
+		if err := entries[i].validate(); err != nil {
+			return nil, fmt.Errorf("manifest entry #%d: %v", i+1, err)
+		}
+		if names.Contains(entries[i].ObjName) {
+			return nil, fmt.Errorf("manifest entry #%d: duplicate object name %q", i+1, entries[i].ObjName)
+		}
+		names.Add(entries[i].ObjName)
+	}
+	return entries, nil
+}
+
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				return 0, errors.New("manifest is empty")
+			}
+			return 0, err
+		}
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return b, br.UnreadByte()
+		}
+	}
+}
+
+func parseJSONL(br *bufio.Reader) (entries []ManifestEntry, err error) {
+	var (
+		scanner = bufio.NewScanner(br)
+		num     int
+	)
+	scanner.Buffer(nil, cos.MiB)
+	for scanner.Scan() {
+		num++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var e ManifestEntry
+		if err := jsoniter.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("manifest line %d: %v", num, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
+
+func parseCSV(br *bufio.Reader) (entries []ManifestEntry, err error) {
+	cr := csv.NewReader(br)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 && strings.EqualFold(rec[0], manifestHdr) {
+			continue // header
+		}
+		if len(rec) > 5 {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("manifest line %d: too many fields (%d)", line, len(rec))
+		}
+		e := ManifestEntry{Link: rec[0]}
+		if len(rec) > 1 {
+			e.ObjName = rec[1]
+		}
+		if len(rec) > 2 && rec[2] != "" {
+			if e.Size, err = strconv.ParseInt(rec[2], 10, 64); err != nil {
+				line, _ := cr.FieldPos(2)
+				return nil, fmt.Errorf("manifest line %d: invalid size: %v", line, err)
+			}
+		}
+		if len(rec) > 3 {
+			e.CksumType = rec[3]
+		}
+		if len(rec) > 4 {
+			e.CksumValue = rec[4]
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// load manifest from HTTP(S) URL or AIS object (in the latter case, via GET from the object's HRW target)
+func loadManifest(t cluster.Target, src string) ([]ManifestEntry, error) {
+	var (
+		req         *http.Request
+		client      *http.Client
+		err         error
+		ctx, cancel = context.WithTimeout(context.Background(), cmn.GCO.Get().Timeout.MaxHostBusy.D())
+	)
+	defer cancel()
+	if cos.IsHTTP(src) || cos.IsHTTPS(src) {
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, src, http.NoBody)
+		client = clientForURL(src)
+	} else {
+		req, err = manifestObjReq(ctx, t, src)
+		client = t.DataClient()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return _loadManifest(client, req, src)
+}
+
+func manifestObjReq(ctx context.Context, t cluster.Target, src string) (*http.Request, error) {
+	bck, objName, err := cmn.ParseBckObjectURI(src, cmn.ParseURIOpts{})
+	if err != nil {
+		return nil, err
+	}
+	if objName == "" {
+		return nil, fmt.Errorf("invalid manifest %q: expecting HTTP(S) URL or object name", src)
+	}
+	mbck := meta.CloneBck(&bck)
+	if err := mbck.Init(t.Bowner()); err != nil {
+		return nil, err
+	}
+	tsi, err := t.Sowner().Get().HrwName2T(mbck.MakeUname(objName))
+	if err != nil {
+		return nil, err
+	}
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Path = apc.URLPathObjects.Join(mbck.Name, objName)
+		reqArgs.Query = mbck.NewQuery()
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.String()},
+		}
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithContext(ctx), nil
+}
+
+func _loadManifest(client *http.Client, req *http.Request, src string) ([]ManifestEntry, error) {
+	resp, err := client.Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, cmn.NewErrHTTP(req, fmt.Errorf("failed to load manifest %q: status %d", src, resp.StatusCode),
+			resp.StatusCode)
+	}
+	entries, err := ParseManifest(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest %q: %v", src, err)
+	}
+	return entries, nil
+}
+
+// (compare with buildDlObjs)
+func manifestDlObjs(t cluster.Target, bck *meta.Bck, entries []ManifestEntry) ([]dlObj, error) {
+	var (
+		smap = t.Sowner().Get()
+		sid  = t.SID()
+		objs = make([]dlObj, 0, len(entries))
+	)
+	for i := range entries {
+		e := &entries[i]
+		obj, err := makeDlObj(smap, sid, bck, e.ObjName, e.Link)
+		if err != nil {
+			if err == errInvalidTarget {
+				continue
+			}
+			return nil, err
+		}
+		obj.size, obj.cksum = e.Size, e.cksum()
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// verify downloaded object against the manifest-provided size and/or checksum
+func (task *singleTask) verify(lom *cluster.LOM) error {
+	obj := &task.obj
+	if obj.size == 0 && obj.cksum == nil {
+		return nil
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	if obj.size > 0 && lom.SizeBytes() != obj.size {
+		return &errMismatch{fmt.Errorf("%s: size %d differs from the expected %d", lom, lom.SizeBytes(), obj.size)}
+	}
+	if obj.cksum == nil {
+		return nil
+	}
+	cksum := lom.Checksum()
+	if cksum == nil || cksum.Type() != obj.cksum.Type() {
+		cksumHash, err := lom.ComputeCksum(obj.cksum.Type())
+		if err != nil {
+			return err
+		}
+		cksum = cksumHash.Clone()
+	}
+	if !cksum.Equal(obj.cksum) {
+		return &errMismatch{cos.NewErrDataCksum(obj.cksum, cksum, lom.Cname())}
+	}
+	return nil
+}
+
+// remove the object that failed verification (locally - remote backend, if any, is not affected)
+func (task *singleTask) removeBad(lom *cluster.LOM) {
+	lom.Lock(true)
+	if err := lom.Remove(); err != nil {
+		nlog.Errorln(task.String(), err)
+	}
+	lom.Unlock(true)
+}
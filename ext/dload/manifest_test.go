@@ -0,0 +1,58 @@
+// Package dloader_test is a unit test
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseManifest(t *testing.T) {
+	const (
+		md5   = "0cc175b9c0f1b6a831c399e269772661"
+		jsonl = `
+{"link": "https://a.b/c/d.tar", "size": 1024, "cksum_type": "md5", "cksum_value": "` + md5 + `"}
+
+{"link": "https://a.b/e.tar", "object_name": "x/e.tar"}
+`
+		csv = `link,object_name,size,cksum_type,cksum_value
+https://a.b/c/d.tar,,1024,md5,` + md5 + `
+# comment
+https://a.b/e.tar,x/e.tar
+`
+	)
+	for _, manifest := range []string{jsonl, csv} {
+		entries, err := dload.ParseManifest(strings.NewReader(manifest))
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(entries) == 2, "expected 2 entries, got %d", len(entries))
+		tassert.Errorf(t, entries[0] == dload.ManifestEntry{
+			Link: "https://a.b/c/d.tar", ObjName: "d.tar", Size: 1024, CksumType: cos.ChecksumMD5, CksumValue: md5,
+		}, "unexpected entry %+v", entries[0])
+		tassert.Errorf(t, entries[1] == dload.ManifestEntry{Link: "https://a.b/e.tar", ObjName: "x/e.tar"},
+			"unexpected entry %+v", entries[1])
+	}
+
+	invalid := []string{
+		"",
+		"\n\n",
+		`{"object_name": "abc"}`,
+		`{"link": "https://a.b/c", "cksum_type": "md5"}`,
+		`{"link": "https://a.b/c", "cksum_type": "none", "cksum_value": "abc"}`,
+		`{"link": "https://a.b/c", "size": -1}`,
+		"https://a.b/c,c,abc",
+		"https://a.b/c,c,1,xxhash,abc,extra",
+		"https://a.b/c,c,1,unknown,abc",
+		"https://a.b/c\nhttps://a.b/d,c",
+	}
+	for _, manifest := range invalid {
+		if _, err := dload.ParseManifest(strings.NewReader(manifest)); err == nil {
+			t.Errorf("parsing invalid manifest %q succeeded", manifest)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Resumable downloads: when the source advertises byte-range support (`Accept-Ranges: bytes`)
+// and provides a validator (ETag or Last-Modified), the object gets downloaded into a
+// persistent workfile (fs.WorkfileDload), with the link and the validator stored as the
+// workfile's xattr. Subsequent retries - including retries after target restart - request
+// only the remaining range (`Range` + `If-Range`), and append to the workfile.
+// Source that has changed in the meantime responds with the entire content (200), and
+// the download restarts from scratch.
+// Partial workfiles that are never resumed get removed by space cleanup, as any other
+// workfile of a previous run (see fs.WorkfileContentResolver).
+
+const xattrDload = "user.ais.dload"
+
+type partial struct {
+	fqn     string
+	Link    string `json:"link"`
+	ETag    string `json:"etag,omitempty"`
+	LastMod string `json:"last_modified,omitempty"`
+	size    int64  // bytes downloaded so far
+}
+
+// find the partial workfile of a previous download attempt, if any
+func findPartial(lom *cluster.LOM) string {
+	var (
+		resolver = fs.CSM.Resolver(fs.WorkfileType)
+		wfqn     = lom.Mountpath().MakePathFQN(lom.Bucket(), fs.WorkfileType, lom.ObjName)
+		dir      = filepath.Dir(wfqn)
+		prefix   = fs.WorkfileDload + "." + filepath.Base(wfqn) + "."
+	)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if orig, _, ok := resolver.ParseUniqueFQN(name); ok && orig == filepath.Base(wfqn) {
+			return filepath.Join(dir, name)
+		}
+	}
+	return ""
+}
+
+// load previously downloaded part, if any, and request the remaining range
+func (task *singleTask) loadPartial(lom *cluster.LOM, req *http.Request) (p *partial) {
+	fqn := findPartial(lom)
+	if fqn == "" {
+		return nil
+	}
+	p = &partial{fqn: fqn}
+	finfo, err := os.Stat(p.fqn)
+	if err != nil {
+		return nil
+	}
+	b, err := fs.GetXattr(p.fqn, xattrDload)
+	if err == nil {
+		err = jsoniter.Unmarshal(b, p)
+	}
+	if err != nil || p.Link != task.obj.link || (p.ETag == "" && p.LastMod == "") || finfo.Size() == 0 {
+		p.remove()
+		return nil
+	}
+	// (left over by a previous run) take it over, so that space cleanup won't remove it
+	if _, old, _ := fs.CSM.Resolver(fs.WorkfileType).ParseUniqueFQN(filepath.Base(fqn)); old {
+		p.fqn = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDload)
+		if err := os.Rename(fqn, p.fqn); err != nil {
+			nlog.Errorln(err)
+			p.fqn = fqn
+			p.remove()
+			return nil
+		}
+	}
+	p.size = finfo.Size()
+	req.Header.Set(cos.HdrRange, fmt.Sprintf("%s%d-", cos.HdrRangeValPrefix, p.size))
+	if p.ETag != "" {
+		req.Header.Set(cos.HdrIfRange, p.ETag)
+	} else {
+		req.Header.Set(cos.HdrIfRange, p.LastMod)
+	}
+	return p
+}
+
+func resumable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(cos.HdrAcceptRanges) != "bytes" {
+		return false
+	}
+	return resp.Header.Get(cos.HdrETag) != "" || resp.Header.Get(cos.HdrLastModified) != ""
+}
+
+func (p *partial) remove() {
+	if err := cos.RemoveFile(p.fqn); err != nil {
+		nlog.Errorln(err)
+	}
+}
+
+// download into (or resume, if p != nil) the partial workfile and, when done, finalize the object
+func (task *singleTask) _dpart(lom *cluster.LOM, req *http.Request, resp *http.Response, p *partial) (bool /*err is fatal*/, error) {
+	var (
+		flag  = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+		total int64
+	)
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		start, size, err := parseContentRange(resp.Header.Get(cos.HdrContentRange))
+		if err != nil || start != p.size {
+			p.remove()
+			return false, cmn.NewErrHTTP(req, fmt.Errorf("failed to resume %q: invalid content range (%v)",
+				task.obj.link, err), resp.StatusCode)
+		}
+		flag, total = os.O_WRONLY|os.O_APPEND, size
+		task.resumed.Store(start)
+		task.currentSize.Store(start)
+		g.store.addResumed(task.jobID(), start)
+	case http.StatusRequestedRangeNotSatisfiable:
+		// (the source must've shrunk) - start over
+		p.remove()
+		return false, cmn.NewErrHTTP(req, fmt.Errorf("failed to resume %q at offset %d", task.obj.link, p.size),
+			resp.StatusCode)
+	case http.StatusOK:
+		// new download (or the source has changed)
+		p = &partial{fqn: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDload), Link: task.obj.link, ETag: resp.Header.Get(cos.HdrETag),
+			LastMod: resp.Header.Get(cos.HdrLastModified)}
+		total = resp.ContentLength
+	default:
+		return false, cmn.NewErrHTTP(req, fmt.Errorf("failed to download %q: status %d", task.obj.link,
+			resp.StatusCode), resp.StatusCode)
+	}
+	attrsFromLink(task.obj.link, resp, lom)
+	task.setTotalSize(total)
+
+	fh, err := os.OpenFile(p.fqn, flag, cos.PermRWR)
+	if err != nil && os.IsNotExist(err) && flag&os.O_CREATE != 0 {
+		if err = cos.CreateDir(filepath.Dir(p.fqn)); err == nil {
+			fh, err = os.OpenFile(p.fqn, flag, cos.PermRWR)
+		}
+	}
+	if err != nil {
+		return true, err
+	}
+	if flag&os.O_TRUNC != 0 {
+		if err := fs.SetXattr(p.fqn, xattrDload, cos.MustMarshal(p)); err != nil {
+			cos.Close(fh)
+			p.remove()
+			return true, err
+		}
+	}
+	_, err = io.Copy(fh, task.wrapReader(resp.Body))
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		return false, err // keep the partial workfile to resume
+	}
+	size := task.currentSize.Load()
+	if total > 0 && size != total {
+		p.remove()
+		return false, fmt.Errorf("%s: downloaded size %d differs from the expected %d", task, size, total)
+	}
+	return task.finalize(lom, p, size)
+}
+
+func (task *singleTask) finalize(lom *cluster.LOM, p *partial, size int64) (bool /*err is fatal*/, error) {
+	if err := fs.RemoveXattr(p.fqn, xattrDload); err != nil {
+		p.remove()
+		return true, err
+	}
+	if ty := lom.CksumType(); ty != cos.ChecksumNone {
+		fh, err := os.Open(p.fqn)
+		if err != nil {
+			return true, err
+		}
+		_, cksum, err := cos.CopyAndChecksum(io.Discard, fh, nil, ty)
+		cos.Close(fh)
+		if err != nil {
+			p.remove()
+			return true, err
+		}
+		lom.SetCksum(cksum.Clone())
+	}
+	lom.SetSize(size)
+	lom.SetAtimeUnix(task.started.Load().UnixNano())
+	if _, err := g.t.FinalizeObj(lom, p.fqn, task.xdl, cmn.OwtFinalize); err != nil {
+		return true, err
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return true, err
+	}
+	return false, nil
+}
+
+// "bytes <start>-<end>/<size>"
+func parseContentRange(v string) (start, size int64, err error) {
+	rng, ok := strings.CutPrefix(v, cos.HdrContentRangeValPrefix)
+	if !ok {
+		return 0, 0, errors.New("missing " + cos.HdrContentRange)
+	}
+	i, j := strings.IndexByte(rng, '-'), strings.IndexByte(rng, '/')
+	if i <= 0 || j < i {
+		return 0, 0, fmt.Errorf("malformed %s %q", cos.HdrContentRange, v)
+	}
+	if start, err = strconv.ParseInt(rng[:i], 10, 64); err != nil {
+		return
+	}
+	if rng[j+1:] == "*" {
+		return start, 0, nil // unknown size
+	}
+	size, err = strconv.ParseInt(rng[j+1:], 10, 64)
+	return
+}
@@ -0,0 +1,122 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/tools/trand"
+)
+
+const testLink = "https://example.com/data/shard-0001.tar"
+
+// (compare with tools.PrepareObjects - not usable here due to import cycle)
+func newTestLOM(t *testing.T, objName string) *cluster.LOM {
+	bck := cmn.Bck{Name: trand.String(10), Provider: apc.AIS, Ns: cmn.NsGlobal, Props: &cmn.Bprops{BID: 0xa5b6e7d8}}
+	fs.TestNew(mock.NewIOS())
+	fs.TestDisableValidation()
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	_ = mock.NewTarget(mock.NewBaseBownerMock((*meta.Bck)(&bck)))
+	if errs := fs.CreateBucket(&bck, false /*nilbmd*/); len(errs) > 0 {
+		tassert.CheckFatal(t, errs[0])
+	}
+	lom := &cluster.LOM{ObjName: objName}
+	tassert.CheckFatal(t, lom.InitBck(&bck))
+	return lom
+}
+
+// write partial workfile along with its xattr
+func writePartial(t *testing.T, fqn, data string, p *partial) {
+	tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fqn)))
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(data), cos.PermRWR))
+	tassert.CheckFatal(t, fs.SetXattr(fqn, xattrDload, cos.MustMarshal(p)))
+}
+
+// partial workfile left over by a previous run (different PID)
+func oldWorkfile(lom *cluster.LOM) string {
+	fqn := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDload)
+	return fqn[:strings.LastIndexByte(fqn, '.')] + ".1"
+}
+
+func TestResumePartial(t *testing.T) {
+	var (
+		lom  = newTestLOM(t, "train/part-1/shard-0001.tar")
+		task = &singleTask{obj: dlObj{objName: lom.ObjName, link: testLink}}
+		fqn  = oldWorkfile(lom)
+	)
+	writePartial(t, fqn, "0123456789", &partial{Link: testLink, ETag: "\"abc\""})
+
+	req, err := http.NewRequest(http.MethodGet, testLink, http.NoBody)
+	tassert.CheckFatal(t, err)
+	p := task.loadPartial(lom, req)
+	if p == nil {
+		t.Fatal("expected to resume partial download")
+	}
+	tassert.Errorf(t, p.size == 10, "expected size 10, got %d", p.size)
+	tassert.Errorf(t, p.ETag == "\"abc\"", "expected ETag %q, got %q", "\"abc\"", p.ETag)
+	tassert.Errorf(t, req.Header.Get(cos.HdrRange) == cos.HdrRangeValPrefix+"10-",
+		"unexpected range %q", req.Header.Get(cos.HdrRange))
+	tassert.Errorf(t, req.Header.Get(cos.HdrIfRange) == p.ETag, "unexpected If-Range %q", req.Header.Get(cos.HdrIfRange))
+
+	// taken over by the current run (so that space cleanup won't remove it)
+	tassert.Errorf(t, p.fqn != fqn, "expected workfile %q to be renamed", fqn)
+	_, old, ok := fs.CSM.Resolver(fs.WorkfileType).ParseUniqueFQN(filepath.Base(p.fqn))
+	tassert.Errorf(t, ok && !old, "expected current-run workfile, got %q", p.fqn)
+	b, err := os.ReadFile(p.fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "0123456789", "unexpected content %q", string(b))
+}
+
+func TestResumePartialMismatch(t *testing.T) {
+	var (
+		lom  = newTestLOM(t, "shard-0002.tar")
+		task = &singleTask{obj: dlObj{objName: lom.ObjName, link: testLink}}
+		fqn  = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDload)
+	)
+	// different link: not resumable and removed
+	writePartial(t, fqn, "0123456789", &partial{Link: testLink + ".bak", ETag: "\"abc\""})
+
+	req, err := http.NewRequest(http.MethodGet, testLink, http.NoBody)
+	tassert.CheckFatal(t, err)
+	p := task.loadPartial(lom, req)
+	tassert.Errorf(t, p == nil, "expected no resume")
+	tassert.Errorf(t, req.Header.Get(cos.HdrRange) == "", "unexpected range %q", req.Header.Get(cos.HdrRange))
+	_, err = os.Stat(fqn)
+	tassert.Errorf(t, os.IsNotExist(err), "expected %q to be removed, err: %v", fqn, err)
+}
+
+func TestFindPartialNested(t *testing.T) {
+	var (
+		lom   = newTestLOM(t, "a/b/c/shard.tar")
+		other = &cluster.LOM{ObjName: "a/b/c/shard.tar.idx"}
+	)
+	tassert.CheckFatal(t, other.InitBck(lom.Bucket()))
+	tassert.Errorf(t, findPartial(lom) == "", "expected no partial workfile")
+
+	// same directory, different object
+	writePartial(t, fs.CSM.Gen(other, fs.WorkfileType, fs.WorkfileDload), "x", &partial{Link: testLink})
+	tassert.Errorf(t, findPartial(lom) == "", "expected no partial workfile for %s", lom)
+
+	fqn := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDload)
+	tassert.Errorf(t, strings.Contains(fqn, filepath.Join("a", "b", "c")), "expected nested workfile, got %q", fqn)
+	writePartial(t, fqn, "x", &partial{Link: testLink})
+	tassert.Errorf(t, findPartial(lom) == fqn, "expected %q, got %q", fqn, findPartial(lom))
+	tassert.Errorf(t, findPartial(other) != fqn, "unexpected partial workfile for %s", other)
+}
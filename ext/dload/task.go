@@ -40,6 +40,7 @@ type singleTask struct {
 	ended       atomic.Time
 	currentSize atomic.Int64       // current file size (updated as the download progresses)
 	totalSize   atomic.Int64       // total size (nonzero iff Content-Length header was provided by the source)
+	resumed     atomic.Int64       // size of the previously downloaded part (see resume.go)
 	downloadCtx context.Context    // w/ cancel function
 	getCtx      context.Context    // w/ timeout and size
 	cancel      context.CancelFunc // to cancel in-progress download
@@ -82,10 +83,24 @@ func (task *singleTask) download(lom *cluster.LOM, config *cmn.Config) {
 
 	task.started.Store(time.Now())
 	lom.SetAtimeUnix(task.started.Load().UnixNano())
-	if task.obj.fromRemote {
-		err = task.downloadRemote(lom)
-	} else {
-		err = task.downloadLocal(lom)
+	for i := 0; ; i++ {
+		if task.obj.fromRemote {
+			err = task.downloadRemote(lom)
+		} else {
+			err = task.downloadLocal(lom)
+		}
+		if err == nil {
+			err = task.verify(lom)
+		}
+		if !isErrMismatch(err) {
+			break
+		}
+		task.removeBad(lom)
+		if i >= task.job.verifyRetries() {
+			break
+		}
+		nlog.Warningf("%s [retries: %d/%d]: %v - retrying...", task, i, task.job.verifyRetries(), err)
+		task.reset()
 	}
 	task.ended.Store(time.Now())
 
@@ -120,12 +135,28 @@ func (task *singleTask) _dlocal(lom *cluster.LOM, timeout time.Duration) (bool /
 		req.Header.Add("User-Agent", gcsUA)
 	}
 
+	p := task.loadPartial(lom, req)
+
 	resp, err := clientForURL(task.obj.link).Do(req) //nolint:bodyclose // cos.Close
 	if err != nil {
 		return false, err
 	}
 
-	fatal, err := task._dput(lom, req, resp)
+	var fatal bool
+	switch {
+	case p != nil && (resp.StatusCode == http.StatusPartialContent || resp.StatusCode == http.StatusRequestedRangeNotSatisfiable):
+		fatal, err = task._dpart(lom, req, resp, p)
+	case resumable(resp):
+		if p != nil {
+			p.remove() // the source has changed
+		}
+		fatal, err = task._dpart(lom, req, resp, nil)
+	default:
+		if p != nil {
+			p.remove() // can't resume
+		}
+		fatal, err = task._dput(lom, req, resp)
+	}
 	cos.Close(resp.Body)
 	return fatal, err
 }
@@ -271,6 +302,7 @@ func (task *singleTask) ToTaskDlInfo() TaskDlInfo {
 		Name:       task.obj.objName,
 		Downloaded: task.currentSize.Load(),
 		Total:      task.totalSize.Load(),
+		Resumed:    task.resumed.Load(),
 		StartTime:  task.started.Load(),
 		EndTime:    ended,
 	}
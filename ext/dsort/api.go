@@ -19,9 +19,13 @@ const (
 	MD5          = "md5"          // compare md5(name)
 	Shuffle      = "shuffle"      // random shuffle (use with the same seed to reproduce)
 	Content      = "content"      // extract (int, string, float) from a given file, and compare
+	Composite    = "composite"    // multi-level: compare by the first key, then by the second, etc. (see `SortKey`)
 )
 
-var algorithms = []string{algDefault, Alphanumeric, MD5, Shuffle, Content, None}
+var algorithms = []string{algDefault, Alphanumeric, MD5, Shuffle, Content, None, Composite}
+
+// (sorting) kinds that can be used as a single level of the composite key
+var keyKinds = []string{algDefault, Alphanumeric, MD5, Content}
 
 type Algorithm struct {
 	// one of the `algorithms` above
@@ -41,6 +45,24 @@ type Algorithm struct {
 	// ditto: Content only
 	// `shard.contentKeyTypes` enum values: {"int", "string", "float" }
 	ContentKeyType string `json:"content_key_type"`
+
+	// usage: exclusively for Composite sorting
+	// e.g.: [{content ".cls" int}, {alphanumeric}] - group samples by label, and then by name
+	Keys []SortKey `json:"keys,omitempty"`
+}
+
+// SortKey is a single level of the composite (multi-level) sorting key;
+// each level has its own kind, direction, and (content) type.
+type SortKey struct {
+	// one of the `keyKinds` above
+	Kind string `json:"kind"`
+
+	// direction of this (and only this) level
+	Decreasing bool `json:"decreasing"`
+
+	// Content only (see Algorithm above)
+	Ext            string `json:"extension"`
+	ContentKeyType string `json:"content_key_type"`
 }
 
 // RequestSpec defines the user specification for requests to the endpoint /v1/sort.
@@ -54,6 +76,16 @@ type RequestSpec struct {
 	// Desirable
 	InputExtension string `json:"input_extension" yaml:"input_extension"`
 
+	// Output sharding policy (alternatives to `OutputShardSize`)
+	// Default: 0 (not used)
+	OutputShardRecords int `json:"output_shard_records" yaml:"output_shard_records"` // fixed number of records per output shard
+	// Default: 0 (not used)
+	OutputShardCount int `json:"output_shard_count" yaml:"output_shard_count"` // fixed total number of output shards
+	// Default: "" (records are never grouped)
+	// Records that share the same name prefix up to (and excluding) the last `OutputGroupSepa` are never split
+	// across output shards - e.g., with "/" all "video-1/frame-*" records end up in the same shard
+	OutputGroupSepa string `json:"output_group_sepa" yaml:"output_group_sepa"`
+
 	// Optional
 	// Default: InputExtension
 	OutputExtension string `json:"output_extension" yaml:"output_extension"`
@@ -64,6 +96,7 @@ type RequestSpec struct {
 	// Default: alphanumeric, increasing
 	Algorithm Algorithm `json:"algorithm" yaml:"algorithm"`
 	// Default: ""
+	// Either URL (e.g. "http://example.com/ekm.json") or bucket object (e.g. "s3://bucket/ekm.txt")
 	OrderFileURL string `json:"order_file" yaml:"order_file"`
 	// Default: "\t"
 	OrderFileSep string `json:"order_file_sep" yaml:"order_file_sep"`
@@ -0,0 +1,337 @@
+// Package dsort provides distributed massively parallel resharding for very large datasets.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Each target checkpoints its part of the job under `<mountpath>/.ais.dsort/<job ID>`:
+// - ckpt:     job parameters and completed phases (jsp)
+// - records:  locally extracted records (msgp)
+// - metadata: creation phase metadata received from the final target (msgp)
+// - created:  names of the output shards created by this target, one per line (append-only)
+//
+// Records and creation metadata are saved only when all the respective record objects
+// are stored as offsets into input shards, i.e., when they survive a restart.
+// Checkpointing is best-effort: failures are logged and the job keeps running.
+
+const (
+	ckptFname     = "ckpt"
+	ckptRecords   = "records"
+	ckptMetadata  = "metadata"
+	ckptCreated   = "created"
+	ckptTmpSuffix = ".tmp"
+
+	ckptMetaver = 1
+)
+
+// resume phases (see PresumeHandler)
+const (
+	ResumeExtraction = "extraction" // start over, reusing checkpointed records and skipping created shards
+	ResumeCreation   = "creation"   // all targets have creation phase metadata - skip phases 1 through 3
+)
+
+type (
+	checkpoint struct {
+		Pars               *parsedReqSpec `json:"pars"`
+		TotalShardSize     int64          `json:"total_shard_size,string"`
+		TotalExtractedSize int64          `json:"total_extracted_size,string"`
+		Extracted          bool           `json:"extracted"`
+		Sorted             bool           `json:"sorted"`
+
+		dir     string
+		mu      sync.Mutex
+		created *os.File
+	}
+	// returned by target to proxy (see PresumeHandler)
+	ckptInfo struct {
+		Pars      *parsedReqSpec `json:"pars"`
+		Created   int            `json:"created"`
+		Extracted bool           `json:"extracted"`
+		Sorted    bool           `json:"sorted"`
+		Running   bool           `json:"running"`
+	}
+	resumeCtx struct {
+		created cos.StrSet
+		phase   string
+	}
+)
+
+// PRECONDITION: `m.mu` must be locked.
+func (m *Manager) initCheckpoint(phase string) error {
+	if phase == "" {
+		ck, err := newCheckpoint(m.ManagerUUID, m.Pars)
+		if err != nil {
+			nlog.Errorf("%s: [dsort] %s failed to checkpoint (resume won't be possible): %v", g.t, m.ManagerUUID, err)
+			return nil
+		}
+		m.ckpt = ck
+		return nil
+	}
+
+	ck, err := loadCheckpoint(m.ManagerUUID)
+	if err != nil {
+		if !cos.IsErrNotFound(err) || phase == ResumeCreation {
+			return err
+		}
+		// e.g., joined the cluster after the job had started
+		m.resumed = &resumeCtx{phase: phase, created: cos.NewStrSet()}
+		return m.initCheckpoint("")
+	}
+	created, err := ck.loadCreated()
+	if err != nil {
+		return err
+	}
+	if phase == ResumeCreation && !ck.Sorted {
+		return fmt.Errorf("%s: [dsort] %s cannot resume from %s phase: no creation metadata", g.t, m.ManagerUUID, phase)
+	}
+	ck.Pars = m.Pars
+	if err := ck.save(); err != nil {
+		return err
+	}
+	if err := ck.open(); err != nil {
+		return err
+	}
+	m.ckpt = ck
+	m.resumed = &resumeCtx{phase: phase, created: created}
+	nlog.Infof("%s: [dsort] %s resuming from %s phase: %d shard%s already created", g.t, m.ManagerUUID, phase,
+		len(created), cos.Plural(len(created)))
+	return nil
+}
+
+// skip output shards that were created prior to resuming
+func (m *Manager) skipCreated() {
+	var (
+		md     = &m.creationPhase.metadata
+		shards = md.Shards[:0]
+	)
+	for _, s := range md.Shards {
+		if !m.resumed.created.Contains(s.Name) {
+			shards = append(shards, s)
+		}
+	}
+	if skipped := len(md.Shards) - len(shards); skipped > 0 {
+		nlog.Infof("%s: [dsort] %s skipping %d already created shard%s", g.t, m.ManagerUUID, skipped, cos.Plural(skipped))
+	}
+	md.Shards = shards
+}
+
+func ckptDir(mpath, managerUUID string) string {
+	return filepath.Join(mpath, fname.DsortDir, managerUUID)
+}
+
+func newCheckpoint(managerUUID string, pars *parsedReqSpec) (*checkpoint, error) {
+	mi, _, err := fs.Hrw(managerUUID)
+	if err != nil {
+		return nil, err
+	}
+	ck := &checkpoint{Pars: pars, dir: ckptDir(mi.Path, managerUUID)}
+	if err := cos.CreateDir(ck.dir); err != nil {
+		return nil, err
+	}
+	if err := ck.save(); err != nil {
+		return nil, err
+	}
+	return ck, ck.open()
+}
+
+// mountpaths may have changed since the checkpoint was taken - check them all
+func loadCheckpoint(managerUUID string) (*checkpoint, error) {
+	for _, mi := range fs.GetAvail() {
+		dir := ckptDir(mi.Path, managerUUID)
+		if err := cos.Stat(filepath.Join(dir, ckptFname)); err != nil {
+			continue
+		}
+		ck := &checkpoint{dir: dir}
+		if _, err := jsp.Load(filepath.Join(dir, ckptFname), ck, jsp.CksumSign(ckptMetaver)); err != nil {
+			return nil, err
+		}
+		return ck, nil
+	}
+	return nil, cos.NewErrNotFound("%s checkpoint", managerUUID)
+}
+
+func removeCheckpoint(managerUUID string) {
+	for _, mi := range fs.GetAvail() {
+		if err := os.RemoveAll(ckptDir(mi.Path, managerUUID)); err != nil {
+			nlog.Errorln(err)
+		}
+	}
+}
+
+func (ck *checkpoint) open() (err error) {
+	ck.created, err = os.OpenFile(filepath.Join(ck.dir, ckptCreated), os.O_WRONLY|os.O_CREATE|os.O_APPEND, cos.PermRWR)
+	return
+}
+
+func (ck *checkpoint) save() error {
+	return jsp.Save(filepath.Join(ck.dir, ckptFname), ck, jsp.CksumSign(ckptMetaver), nil)
+}
+
+func (ck *checkpoint) info() *ckptInfo {
+	var created int
+	if names, err := ck.loadCreated(); err == nil {
+		created = len(names)
+	}
+	return &ckptInfo{Pars: ck.Pars, Created: created, Extracted: ck.Extracted, Sorted: ck.Sorted}
+}
+
+func (ck *checkpoint) addCreated(shardName string) {
+	ck.mu.Lock()
+	if ck.created != nil {
+		if _, err := ck.created.WriteString(shardName + "\n"); err != nil {
+			nlog.Errorf("[dsort] failed to checkpoint created shard %q: %v", shardName, err)
+		}
+	}
+	ck.mu.Unlock()
+}
+
+// the last line may be incomplete (e.g., the node crashed while writing it) - skip it
+func (ck *checkpoint) loadCreated() (cos.StrSet, error) {
+	b, err := os.ReadFile(filepath.Join(ck.dir, ckptCreated))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cos.NewStrSet(), nil
+		}
+		return nil, err
+	}
+	lines := strings.Split(string(b), "\n")
+	names := cos.NewStrSet(lines[:len(lines)-1]...)
+	names.Delete("")
+	return names, nil
+}
+
+func (ck *checkpoint) saveExtracted(records *shard.Records, totalShardSize, totalExtractedSize int64) {
+	if !offsetOnly(records.All()) {
+		return
+	}
+	if err := ck.saveMsg(ckptRecords, records); err != nil {
+		nlog.Errorf("[dsort] failed to checkpoint extracted records: %v", err)
+		return
+	}
+	ck.mu.Lock()
+	ck.Extracted = true
+	ck.TotalShardSize, ck.TotalExtractedSize = totalShardSize, totalExtractedSize
+	err := ck.save()
+	ck.mu.Unlock()
+	if err != nil {
+		nlog.Errorf("[dsort] failed to checkpoint extraction: %v", err)
+	}
+}
+
+func (ck *checkpoint) saveSorted(md *CreationPhaseMetadata) {
+	for _, s := range md.Shards {
+		if !offsetOnly(s.Records.All()) {
+			return
+		}
+	}
+	if err := ck.saveMsg(ckptMetadata, md); err != nil {
+		nlog.Errorf("[dsort] failed to checkpoint creation phase metadata: %v", err)
+		return
+	}
+	ck.mu.Lock()
+	ck.Sorted = true
+	err := ck.save()
+	ck.mu.Unlock()
+	if err != nil {
+		nlog.Errorf("[dsort] failed to checkpoint sorting: %v", err)
+	}
+}
+
+func (ck *checkpoint) loadExtracted() (*shard.Records, error) {
+	records := shard.NewRecords(0)
+	return records, ck.loadMsg(ckptRecords, records)
+}
+
+func (ck *checkpoint) loadSorted(md *CreationPhaseMetadata) error {
+	return ck.loadMsg(ckptMetadata, md)
+}
+
+func (ck *checkpoint) saveMsg(name string, v msgp.Encodable) error {
+	var (
+		fpath = filepath.Join(ck.dir, name)
+		tmp   = fpath + ckptTmpSuffix
+	)
+	fh, err := cos.CreateFile(tmp)
+	if err != nil {
+		return err
+	}
+	buf, slab := g.mm.AllocSize(serializationBufSize)
+	mw := msgp.NewWriterBuf(fh, buf)
+	err = v.EncodeMsg(mw)
+	if err == nil {
+		err = mw.Flush()
+	}
+	slab.Free(buf)
+	if errC := cos.FlushClose(fh); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = os.Rename(tmp, fpath)
+	}
+	if err != nil {
+		cos.RemoveFile(tmp)
+	}
+	return err
+}
+
+func (ck *checkpoint) loadMsg(name string, v msgp.Decodable) error {
+	fh, err := os.Open(filepath.Join(ck.dir, name))
+	if err != nil {
+		return err
+	}
+	defer cos.Close(fh)
+	buf, slab := g.mm.AllocSize(serializationBufSize)
+	defer slab.Free(buf)
+	if err := v.DecodeMsg(msgp.NewReaderBuf(fh, buf)); err != nil {
+		return fmt.Errorf(cmn.FmtErrUnmarshal, apc.ActDsort, "checkpoint "+name, "-", err)
+	}
+	return nil
+}
+
+func (ck *checkpoint) close() {
+	ck.mu.Lock()
+	if ck.created != nil {
+		if err := ck.created.Close(); err != nil {
+			nlog.Errorln(err)
+		}
+		ck.created = nil
+	}
+	ck.mu.Unlock()
+}
+
+func (ck *checkpoint) remove() {
+	ck.close()
+	if err := os.RemoveAll(ck.dir); err != nil {
+		nlog.Errorln(err)
+	}
+}
+
+// offsets into input shards are the only record objects that survive a restart
+func offsetOnly(records []*shard.Record) bool {
+	for _, r := range records {
+		for _, obj := range r.Objects {
+			if obj.StoreType != shard.OffsetStoreType {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// Package dsort provides APIs for distributed archive file shuffling.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checkpoint", func() {
+	const jobID = "srt-ckpt"
+
+	newRecords := func(storeType string, names ...string) *shard.Records {
+		records := shard.NewRecords(len(names))
+		for _, name := range names {
+			records.Insert(&shard.Record{
+				Key:      name,
+				Name:     name,
+				DaemonID: "t1",
+				Objects: []*shard.RecordObj{{
+					ContentPath: "input.tar",
+					StoreType:   storeType,
+					Offset:      512,
+					Size:        1024,
+					Extension:   ".jpg",
+				}},
+			})
+		}
+		return records
+	}
+
+	BeforeEach(func() {
+		err := cos.CreateDir(testingConfigDir)
+		Expect(err).ShouldNot(HaveOccurred())
+		fs.TestNew(mock.NewIOS())
+		_, _ = fs.Add(testingConfigDir, "daeID")
+		g.mm = memsys.PageMM()
+	})
+
+	AfterEach(func() {
+		err := os.RemoveAll(testingConfigDir)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should persist and load phases and created shards", func() {
+		pars := &parsedReqSpec{Description: "resumable", OutputShardSize: cos.MiB}
+		ck, err := newCheckpoint(jobID, pars)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ck.saveExtracted(newRecords(shard.OffsetStoreType, "a", "b"), 10, 20)
+		ck.saveSorted(&CreationPhaseMetadata{Shards: []*shard.Shard{
+			{Name: "out-0.tar", Records: newRecords(shard.OffsetStoreType, "a")},
+			{Name: "out-1.tar", Records: newRecords(shard.OffsetStoreType, "b")},
+		}})
+		ck.addCreated("out-0.tar")
+		ck.close()
+
+		loaded, err := loadCheckpoint(jobID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(loaded.Pars.Description).To(Equal(pars.Description))
+		Expect(loaded.Extracted).To(BeTrue())
+		Expect(loaded.Sorted).To(BeTrue())
+		Expect(loaded.TotalExtractedSize).To(BeEquivalentTo(20))
+
+		records, err := loaded.loadExtracted()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(records.Len()).To(Equal(2))
+
+		md := &CreationPhaseMetadata{}
+		Expect(loaded.loadSorted(md)).To(Succeed())
+		Expect(md.Shards).To(HaveLen(2))
+
+		created, err := loaded.loadCreated()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(created).To(Equal(cos.NewStrSet("out-0.tar")))
+
+		removeCheckpoint(jobID)
+		_, err = loadCheckpoint(jobID)
+		Expect(cos.IsErrNotFound(err)).To(BeTrue())
+	})
+
+	It("should not checkpoint records that do not survive restart", func() {
+		ck, err := newCheckpoint(jobID, &parsedReqSpec{})
+		Expect(err).ShouldNot(HaveOccurred())
+		defer ck.remove()
+
+		ck.saveExtracted(newRecords(shard.SGLStoreType, "a"), 10, 20)
+		Expect(ck.Extracted).To(BeFalse())
+	})
+
+	It("should skip incomplete last line of created shards", func() {
+		ck, err := newCheckpoint(jobID, &parsedReqSpec{})
+		Expect(err).ShouldNot(HaveOccurred())
+		defer ck.remove()
+
+		ck.addCreated("out-1.tar")
+		_, err = ck.created.WriteString("out-1")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		created, err := ck.loadCreated()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(created).To(Equal(cos.NewStrSet("out-1.tar")))
+		Expect(filepath.Join(ck.dir, ckptCreated)).To(BeAnExistingFile())
+	})
+
+	It("should skip created shards when resuming", func() {
+		m := &Manager{ManagerUUID: jobID, resumed: &resumeCtx{created: cos.NewStrSet("out-1.tar")}}
+		m.creationPhase.metadata.Shards = []*shard.Shard{{Name: "out-0.tar"}, {Name: "out-1.tar"}, {Name: "out-2.tar"}}
+		m.skipCreated()
+		Expect(m.creationPhase.metadata.Shards).To(HaveLen(2))
+		Expect(m.creationPhase.metadata.Shards[1].Name).To(Equal("out-2.tar"))
+	})
+})
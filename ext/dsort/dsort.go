@@ -85,12 +85,54 @@ func (m *Manager) start() (err error) {
 		return err
 	}
 
-	// Phase 1.
-	nlog.Infof("%s: %s started extraction stage", g.t, m.ManagerUUID)
-	if err := m.extractLocalShards(); err != nil {
+	if m.resumed != nil && m.resumed.phase == ResumeCreation {
+		nlog.Infof("%s: %s resuming creation stage", g.t, m.ManagerUUID)
+		if err := m.ckpt.loadSorted(&m.creationPhase.metadata); err != nil {
+			return err
+		}
+		if m.ckpt.Extracted {
+			if err := m.restoreExtracted(); err != nil {
+				return err
+			}
+		}
+	} else if err := m.sortAndDistribute(); err != nil {
+		return err
+	}
+	// NOTE: with no sorting (algorithm "none") the order of records - and therefore
+	// the content of output shards - is reproducible only when resuming creation
+	if m.resumed != nil && (m.resumed.phase == ResumeCreation || m.Pars.Algorithm.Kind != None) {
+		m.skipCreated()
+	}
+
+	// After each target participates in the cluster-wide record distribution,
+	// start listening for the signal to start creating shards locally.
+	nlog.Infof("%s: %s started creation stage", g.t, m.ManagerUUID)
+	if err := m.dsorter.createShardsLocally(); err != nil {
 		return err
 	}
 
+	nlog.Infof("%s: %s finished successfully", g.t, m.ManagerUUID)
+	return nil
+}
+
+// phases 1 through 3 - returns upon receiving creation phase metadata
+func (m *Manager) sortAndDistribute() error {
+	// Phase 1.
+	if m.resumed != nil && m.ckpt != nil && m.ckpt.Extracted {
+		nlog.Infof("%s: %s restoring extracted records", g.t, m.ManagerUUID)
+		if err := m.restoreExtracted(); err != nil {
+			return err
+		}
+	} else {
+		nlog.Infof("%s: %s started extraction stage", g.t, m.ManagerUUID)
+		if err := m.extractLocalShards(); err != nil {
+			return err
+		}
+		if m.ckpt != nil {
+			m.ckpt.saveExtracted(m.recm.Records, m.totalShardSize(), m.totalExtractedSize())
+		}
+	}
+
 	s := binary.BigEndian.Uint64(m.Pars.TargetOrderSalt)
 	targetOrder := _torder(s, m.smap.Tmap)
 	if m.config.FastV(4, cos.SmoduleDsort) {
@@ -128,20 +170,10 @@ func (m *Manager) start() (err error) {
 	// notice that the specification for shards to be created locally was received.
 	select {
 	case <-m.startShardCreation:
-		break
+		return nil
 	case <-m.listenAborted():
 		return m.newErrAborted()
 	}
-
-	// After each target participates in the cluster-wide record distribution,
-	// start listening for the signal to start creating shards locally.
-	nlog.Infof("%s: %s started creation stage", g.t, m.ManagerUUID)
-	if err := m.dsorter.createShardsLocally(); err != nil {
-		return err
-	}
-
-	nlog.Infof("%s: %s finished successfully", g.t, m.ManagerUUID)
-	return nil
 }
 
 // returns a slice of targets in a pseudorandom order
@@ -201,6 +233,22 @@ func (m *Manager) extractLocalShards() (err error) {
 	return
 }
 
+// (resuming) instead of extracting, load local records from the checkpoint
+func (m *Manager) restoreExtracted() error {
+	m.Metrics.Extraction.begin()
+	records, err := m.ckpt.loadExtracted()
+	if err != nil {
+		return err
+	}
+	m.recm.Records = records
+	m.compression.totalShardSize.Store(m.ckpt.TotalShardSize)
+	m.compression.totalExtractedSize.Store(m.ckpt.TotalExtractedSize)
+	m.dsorter.postExtraction()
+	m.Metrics.Extraction.finish()
+	m.incrementRef(int64(records.TotalObjectCount()))
+	return nil
+}
+
 func (m *Manager) iterRange(ctx context.Context, group *errgroup.Group) error {
 	var (
 		metrics = m.Metrics.Extraction
@@ -399,6 +447,9 @@ func (m *Manager) createShard(s *shard.Shard, lom *cluster.LOM) (err error) {
 	}
 
 exit:
+	if m.ckpt != nil {
+		m.ckpt.addCreated(shardName)
+	}
 	metrics.mu.Lock()
 	metrics.CreatedCnt++
 	if si.ID() != g.t.SID() {
@@ -554,24 +605,56 @@ func (m *Manager) generateShardsWithTemplate(maxSize int64) ([]*shard.Shard, err
 	var (
 		start           int
 		curShardSize    int64
+		curShardCnt     int
 		n               = m.recm.Records.Len()
 		pt              = m.Pars.Pot.Template
 		shardCount      = pt.Count()
 		shards          = make([]*shard.Shard, 0)
 		numLocalRecords = make(map[string]int, m.smap.CountActiveTs())
+		perShard        = m.Pars.OutputShardRecords
+		extra           int
+		sepa            = m.Pars.OutputGroupSepa
 	)
 	pt.InitIter()
 
-	if maxSize <= 0 {
+	switch {
+	case m.Pars.OutputShardCount > 0:
+		// spread the remainder: the first (n % count) shards get one extra record
+		perShard, extra = n/m.Pars.OutputShardCount, n%m.Pars.OutputShardCount
+		if perShard == 0 {
+			perShard, extra = 1, 0
+		}
+	case perShard > 0:
+		// fixed number of records per shard
+	case maxSize <= 0:
 		// Heuristic: shard size when maxSize not specified.
 		maxSize = int64(math.Ceil(float64(m.totalExtractedSize()) / float64(shardCount)))
 	}
+	if sepa != "" {
+		m.recm.Records.Group(func(r *shard.Record) string { return groupKey(r, sepa) })
+	}
 
-	for i, r := range m.recm.Records.All() {
+	records := m.recm.Records.All()
+	for i, r := range records {
 		numLocalRecords[r.DaemonID]++
 		curShardSize += r.TotalSize()
-		if curShardSize < maxSize && i < n-1 {
-			continue
+		curShardCnt++
+		if i < n-1 {
+			if perShard > 0 {
+				limit := perShard
+				if len(shards) < extra {
+					limit++
+				}
+				if curShardCnt < limit {
+					continue
+				}
+			} else if curShardSize < maxSize {
+				continue
+			}
+			// never split a group of records
+			if sepa != "" && groupKey(records[i+1], sepa) == groupKey(r, sepa) {
+				continue
+			}
 		}
 
 		name, hasNext := pt.Next()
@@ -594,7 +677,7 @@ func (m *Manager) generateShardsWithTemplate(maxSize int64) ([]*shard.Shard, err
 		shards = append(shards, shard)
 
 		start = i + 1
-		curShardSize = 0
+		curShardSize, curShardCnt = 0, 0
 		for k := range numLocalRecords {
 			numLocalRecords[k] = 0
 		}
@@ -603,49 +686,38 @@ func (m *Manager) generateShardsWithTemplate(maxSize int64) ([]*shard.Shard, err
 	return shards, nil
 }
 
+// group key: record name up to (and excluding) the last separator
+func groupKey(r *shard.Record, sepa string) string {
+	name := r.Basename()
+	if i := strings.LastIndex(name, sepa); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
 func (m *Manager) generateShardsWithOrderingFile(maxSize int64) ([]*shard.Shard, error) {
 	var (
 		shards         = make([]*shard.Shard, 0)
 		externalKeyMap = make(map[string]string)
 		shardsBuilder  = make(map[string][]*shard.Shard)
 	)
-	if maxSize <= 0 {
+	if maxSize <= 0 && m.Pars.OutputShardRecords == 0 {
 		return nil, fmt.Errorf(fmtErrInvalidMaxSize, maxSize)
 	}
-	parsedURL, err := url.Parse(m.Pars.OrderFileURL)
-	if err != nil {
-		return nil, fmt.Errorf(fmtErrOrderURL, m.Pars.OrderFileURL, err)
-	}
-
-	req, err := http.NewRequest(http.MethodGet, m.Pars.OrderFileURL, http.NoBody)
-	if err != nil {
-		return nil, err
-	}
-	// is intra-call
-	tsi := g.t.Snode()
-	req.Header.Set(apc.HdrCallerID, tsi.ID())
-	req.Header.Set(apc.HdrCallerName, tsi.String())
-
-	resp, err := m.client.Do(req) //nolint:bodyclose // closed by cos.Close below
+	ekmr, ext, err := m.openEKM()
 	if err != nil {
 		return nil, err
 	}
-	defer cos.Close(resp.Body)
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"unexpected status code (%d) when requesting order file from %q",
-			resp.StatusCode, m.Pars.OrderFileURL,
-		)
-	}
+	defer cos.Close(ekmr)
 
 	// TODO: handle very large files > GB - in case the file is very big we
 	//  need to save file to the disk and operate on the file directly rather
 	//  than keeping everything in memory.
 
-	switch filepath.Ext(parsedURL.Path) {
+	switch ext {
 	case ".json":
 		var ekm map[string][]string
-		if err := jsoniter.NewDecoder(resp.Body).Decode(&ekm); err != nil {
+		if err := jsoniter.NewDecoder(ekmr).Decode(&ekm); err != nil {
 			return nil, err
 		}
 
@@ -655,7 +727,7 @@ func (m *Manager) generateShardsWithOrderingFile(maxSize int64) ([]*shard.Shard,
 			}
 		}
 	default:
-		lineReader := bufio.NewReader(resp.Body)
+		lineReader := bufio.NewReader(ekmr)
 		for idx := 0; ; idx++ {
 			l, _, err := lineReader.ReadLine()
 			if err == io.EOF {
@@ -696,7 +768,7 @@ func (m *Manager) generateShardsWithOrderingFile(maxSize int64) ([]*shard.Shard,
 		shards := shardsBuilder[shardNameFmt]
 		recordSize := r.TotalSize() + m.shardRW.MetadataSize()*int64(len(r.Objects))
 		shardCount := len(shards)
-		if shardCount == 0 || shards[shardCount-1].Size > maxSize {
+		if shardCount == 0 || m.ekmShardFull(shards[shardCount-1], maxSize) {
 			shard := &shard.Shard{
 				Name:    fmt.Sprintf(shardNameFmt, shardCount),
 				Size:    recordSize,
@@ -719,6 +791,72 @@ func (m *Manager) generateShardsWithOrderingFile(maxSize int64) ([]*shard.Shard,
 	return shards, nil
 }
 
+func (m *Manager) ekmShardFull(s *shard.Shard, maxSize int64) bool {
+	if m.Pars.OutputShardRecords > 0 {
+		return s.Records.Len() >= m.Pars.OutputShardRecords
+	}
+	return s.Size > maxSize
+}
+
+// open external key map (aka order file) that is either:
+// - URL, or
+// - object in any (ais, remote ais, or cloud) bucket - via its HRW target
+func (m *Manager) openEKM() (io.ReadCloser, string /*ext*/, error) {
+	var (
+		req *http.Request
+		ext string
+		err error
+	)
+	if m.Pars.EKMBck.IsEmpty() {
+		var parsedURL *url.URL
+		if parsedURL, err = url.Parse(m.Pars.OrderFileURL); err != nil {
+			return nil, "", fmt.Errorf(fmtErrOrderURL, m.Pars.OrderFileURL, err)
+		}
+		if req, err = http.NewRequest(http.MethodGet, m.Pars.OrderFileURL, http.NoBody); err != nil {
+			return nil, "", err
+		}
+		ext = filepath.Ext(parsedURL.Path)
+	} else {
+		var (
+			tsi *meta.Snode
+			bck = meta.CloneBck(&m.Pars.EKMBck)
+		)
+		if err = bck.Init(g.t.Bowner()); err != nil {
+			return nil, "", err
+		}
+		if tsi, err = m.smap.HrwName2T(bck.MakeUname(m.Pars.EKMObjName)); err != nil {
+			return nil, "", err
+		}
+		reqArgs := &cmn.HreqArgs{
+			Method: http.MethodGet,
+			Base:   tsi.URL(cmn.NetIntraData),
+			Path:   apc.URLPathObjects.Join(bck.Name, m.Pars.EKMObjName),
+			Query:  bck.NewQuery(),
+		}
+		if req, err = reqArgs.Req(); err != nil {
+			return nil, "", err
+		}
+		ext = cos.Ext(m.Pars.EKMObjName)
+	}
+
+	// is intra-call
+	tsi := g.t.Snode()
+	req.Header.Set(apc.HdrCallerID, tsi.ID())
+	req.Header.Set(apc.HdrCallerName, tsi.String())
+
+	resp, err := m.client.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+		return nil, "", fmt.Errorf("unexpected status code (%d) when requesting order file %q",
+			resp.StatusCode, m.Pars.OrderFileURL)
+	}
+	return resp.Body, ext, nil
+}
+
 // Create `maxSize` output shard structures in the order defined by dsortManager.Records.
 // Each output shard structure is "distributed" (via m._dist below)
 // to one of the targets - to create the corresponding output shard.
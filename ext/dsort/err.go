@@ -13,17 +13,26 @@ import (
 )
 
 const (
-	fmtErrInvalidAlg     = "invalid sorting algorithm (expecting one of: %+v)" // <--- supportedAlgorithms
+	fmtErrInvalidAlg     = "invalid sorting algorithm (expecting one of: %+v)"      // <--- supportedAlgorithms
+	fmtErrInvalidKey     = "invalid sorting key %d kind %q (expecting one of: %+v)" // <--- keyKinds
 	fmtErrInvalidMaxSize = "invalid max shard size (%d) for usage with external key map"
 	fmtErrNegOutputSize  = "output shard size must be >= 0 (got %d)"
+	fmtErrNegOutputCnt   = "output shard records and output shard count must be >= 0 (got %d, %d)"
+	fmtErrOutputCount    = "output shard count (%d) exceeds the number of names in the output template (%d)"
 	fmtErrOrderURL       = "failed to parse order file ('order_file') URL %q: %v"
 	fmtErrSeed           = "invalid seed %q (expecting integer value)"
 )
 
 var (
 	errAlgExt            = errors.New("algorithm: invalid extension")
+	errAlgKeys           = errors.New("algorithm: sorting keys require \"composite\" kind")
+	errAlgNoKeys         = errors.New("algorithm: composite sorting requires at least one key")
+	errMissingEKMObj     = errors.New("missing object name of the order file")
 	errNegConcLimit      = errors.New("negative concurrency limit")
-	errMissingOutputSize = errors.New("output shard size must be set (cannot be 0 and cannot be omitted)")
+	errMissingOutputSize = errors.New("output shard size (or number of records) must be set (cannot be 0 and cannot be omitted)")
+	errShardingPolicy    = errors.New("output shard size, output shard records, and output shard count are mutually exclusive")
+	errEKMOutputCount    = errors.New("output shard count cannot be used with external key map (order file)")
+	errEKMGroupSepa      = errors.New("output group separator cannot be used with external key map (order file)")
 	errMissingSrcBucket  = errors.New("missing source bucket")
 )
 
@@ -0,0 +1,99 @@
+// Package dsort provides distributed massively parallel resharding for very large datasets.
+/*
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GenerateShards", func() {
+	newManager := func(pars *parsedReqSpec, names ...string) *Manager {
+		m := &Manager{smap: &meta.Smap{}, Pars: pars}
+		m.recm = shard.NewRecordManager(cmn.Bck{Name: "test"}, nil, nil, nil)
+		for _, name := range names {
+			m.recm.Records.Insert(&shard.Record{
+				Key:     name,
+				Name:    "input.tar|" + name,
+				Objects: []*shard.RecordObj{{Size: 10, Extension: ".jpg"}},
+			})
+		}
+		return m
+	}
+	newPars := func() *parsedReqSpec {
+		pt, err := cos.NewParsedTemplate("output-{0..99}")
+		Expect(err).NotTo(HaveOccurred())
+		return &parsedReqSpec{Pot: &parsedOutputTemplate{Template: pt}, OutputExtension: archive.ExtTar}
+	}
+	counts := func(shards []*shard.Shard) (cnts []int) {
+		for _, s := range shards {
+			cnts = append(cnts, s.Records.Len())
+		}
+		return
+	}
+
+	It("should generate shards with fixed number of records", func() {
+		pars := newPars()
+		pars.OutputShardRecords = 2
+		m := newManager(pars, "a", "b", "c", "d", "e")
+		shards, err := m.generateShardsWithTemplate(0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts(shards)).To(Equal([]int{2, 2, 1}))
+		Expect(shards[0].Name).To(Equal("output-0.tar"))
+	})
+
+	It("should generate fixed number of shards", func() {
+		pars := newPars()
+		pars.OutputShardCount = 2
+		m := newManager(pars, "a", "b", "c", "d", "e")
+		shards, err := m.generateShardsWithTemplate(0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts(shards)).To(Equal([]int{3, 2}))
+	})
+
+	It("should spread the remainder when shard count does not divide evenly", func() {
+		pars := newPars()
+		pars.OutputShardCount = 4
+		m := newManager(pars, "a", "b", "c", "d", "e", "f", "g", "h", "i", "j")
+		shards, err := m.generateShardsWithTemplate(0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts(shards)).To(Equal([]int{3, 3, 2, 2}))
+
+		m = newManager(pars, "a", "b", "c", "d", "e", "f", "g", "h", "i")
+		shards, err = m.generateShardsWithTemplate(0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts(shards)).To(Equal([]int{3, 2, 2, 2}))
+	})
+
+	It("should never split groups of records", func() {
+		pars := newPars()
+		pars.OutputShardRecords = 2
+		pars.OutputGroupSepa = "/"
+		m := newManager(pars, "v1/f1", "v2/f1", "v1/f2", "v1/f3", "v3/f1", "v2/f2")
+		shards, err := m.generateShardsWithTemplate(0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts(shards)).To(Equal([]int{3, 2, 1}))
+		for _, s := range shards {
+			key := groupKey(s.Records.All()[0], "/")
+			for _, r := range s.Records.All() {
+				Expect(groupKey(r, "/")).To(Equal(key))
+			}
+		}
+	})
+
+	It("should never split groups when sharding by size", func() {
+		pars := newPars()
+		pars.OutputGroupSepa = "_"
+		m := newManager(pars, "a_1", "a_2", "a_3", "b_1")
+		shards, err := m.generateShardsWithTemplate(15)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts(shards)).To(Equal([]int{3, 1}))
+	})
+})
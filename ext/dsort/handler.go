@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
@@ -48,6 +49,10 @@ func PstartHandler(w http.ResponseWriter, r *http.Request, parsc *ParsedReq) {
 		pars = parsc.pars
 	)
 	pars.TargetOrderSalt = []byte(cos.FormatNowStamp())
+	if pars.Algorithm.Kind == Shuffle && pars.Algorithm.Seed == "" {
+		// fixed seed makes the output reproducible when resuming (see PresumeHandler)
+		pars.Algorithm.Seed = strconv.FormatInt(time.Now().Unix(), 10)
+	}
 
 	// TODO: handle case when bucket was removed during dsort job - this should
 	// stop whole operation. Maybe some listeners as we have on smap change?
@@ -123,6 +128,82 @@ func _handleResp(w http.ResponseWriter, r *http.Request, smap *meta.Smap, manage
 	return nil
 }
 
+// PUT /v1/sort/resume
+// Resume aborted job under the same ID:
+//   - from the creation phase when all targets have checkpointed creation phase metadata;
+//   - otherwise, from the extraction phase, whereby each target reuses its checkpointed
+//     records (if any).
+//
+// Either way, output shards that were already created are skipped.
+func PresumeHandler(w http.ResponseWriter, r *http.Request) {
+	if !checkHTTPMethod(w, r, http.MethodPut) {
+		return
+	}
+	_, err := parseURL(w, r, 0, apc.URLPathdSortResume.L)
+	if err != nil {
+		return
+	}
+
+	var (
+		pars        *parsedReqSpec
+		smap        = psi.Sowner().Get()
+		managerUUID = r.URL.Query().Get(apc.QparamUUID)
+		phase       = ResumeCreation
+		path        = apc.URLPathdSortCkpt.Join(managerUUID)
+		responses   = bcast(http.MethodGet, path, nil, nil, smap)
+	)
+	for _, resp := range responses {
+		if resp.statusCode == http.StatusNotFound {
+			// e.g., new target
+			phase = ResumeExtraction
+			continue
+		}
+		if resp.err != nil {
+			cmn.WriteErr(w, r, resp.err, resp.statusCode)
+			return
+		}
+		info := &ckptInfo{}
+		if err := js.Unmarshal(resp.res, info); err != nil {
+			cmn.WriteErr(w, r, err, http.StatusInternalServerError)
+			return
+		}
+		if info.Running {
+			s := fmt.Sprintf("%s job %q is still running on %s - abort it first", apc.ActDsort, managerUUID, resp.si)
+			cmn.WriteErrMsg(w, r, s)
+			return
+		}
+		if !info.Sorted {
+			phase = ResumeExtraction
+		}
+		pars = info.Pars
+	}
+	if pars == nil {
+		err := cos.NewErrNotFound("%s job %q checkpoint", apc.ActDsort, managerUUID)
+		cmn.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+
+	// only general dsorter can skip output shards
+	pars.DsorterType = GeneralType
+	b, err := js.Marshal(pars)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+
+	nlog.Infof("[dsort] %s resuming from %s phase", managerUUID, phase)
+	query := url.Values{apc.QparamResumePhase: []string{phase}}
+	responses = bcast(http.MethodPost, apc.URLPathdSortInit.Join(managerUUID), query, b, smap)
+	if err := _handleResp(w, r, smap, managerUUID, responses); err != nil {
+		return
+	}
+	responses = bcast(http.MethodPost, apc.URLPathdSortStart.Join(managerUUID), nil, nil, smap)
+	if err := _handleResp(w, r, smap, managerUUID, responses); err != nil {
+		return
+	}
+	w.Write([]byte(managerUUID))
+}
+
 // GET /v1/sort
 func PgetHandler(w http.ResponseWriter, r *http.Request) {
 	if !checkHTTPMethod(w, r, http.MethodGet) {
@@ -419,6 +500,8 @@ func TargetHandler(w http.ResponseWriter, r *http.Request) {
 		tmetricsHandler(w, r)
 	case apc.FinishedAck:
 		tfiniHandler(w, r)
+	case apc.Checkpoint:
+		tckptHandler(w, r)
 	default:
 		cmn.WriteErrMsg(w, r, "invalid path")
 	}
@@ -455,13 +538,26 @@ func tinitHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	managerUUID := apiItems[0]
+	var (
+		managerUUID = apiItems[0]
+		phase       = r.URL.Query().Get(apc.QparamResumePhase)
+	)
+	if phase != "" {
+		// resuming under the same job ID - remove the previous (archived) run
+		if err := Managers.Remove(managerUUID); err != nil {
+			cmn.WriteErr(w, r, err)
+			return
+		}
+	}
 	m, err := Managers.Add(managerUUID) // NOTE: returns manager locked iff err == nil
 	if err != nil {
 		cmn.WriteErr(w, r, err)
 		return
 	}
-	if err = m.init(pars); err != nil {
+	if err = m.init(pars); err == nil {
+		err = m.initCheckpoint(phase)
+	}
+	if err != nil {
 		cmn.WriteErr(w, r, err)
 	} else {
 		// setup xaction
@@ -579,6 +675,9 @@ func (managers *ManagerGroup) shardsHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if m.ckpt != nil {
+		m.ckpt.saveSorted(tmpMetadata)
+	}
 	m.creationPhase.metadata = *tmpMetadata
 	m.startShardCreation <- struct{}{}
 }
@@ -700,6 +799,41 @@ func tremoveHandler(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErr(w, r, err)
 		return
 	}
+	removeCheckpoint(managerUUID)
+}
+
+// /v1/sort/checkpoint.
+// A valid GET to this endpoint returns local checkpoint of a given job (see PresumeHandler).
+func tckptHandler(w http.ResponseWriter, r *http.Request) {
+	if !checkHTTPMethod(w, r, http.MethodGet) {
+		return
+	}
+	apiItems, err := parseURL(w, r, 1, apc.URLPathdSortCkpt.L)
+	if err != nil {
+		return
+	}
+
+	var (
+		info        = &ckptInfo{}
+		managerUUID = apiItems[0]
+	)
+	if m, exists := Managers.Get(managerUUID, false /*incl. archived*/); exists && !m.Metrics.Archived.Load() {
+		info.Running = true
+	}
+	ck, err := loadCheckpoint(managerUUID)
+	if err == nil {
+		running := info.Running
+		info = ck.info()
+		info.Running = running
+	} else if !info.Running {
+		if cos.IsErrNotFound(err) {
+			cmn.WriteErr(w, r, err, http.StatusNotFound)
+		} else {
+			cmn.WriteErr(w, r, err)
+		}
+		return
+	}
+	w.Write(cos.MustMarshal(info))
 }
 
 func tlistHandler(w http.ResponseWriter, r *http.Request) {
@@ -118,6 +118,8 @@ type (
 		callTimeout    time.Duration // max time to wait for another node to respond
 		config         *cmn.Config
 		xctn           *xaction
+		ckpt           *checkpoint // nil when failed to checkpoint
+		resumed        *resumeCtx  // non-nil when resuming (see PresumeHandler)
 	}
 )
 
@@ -370,6 +372,15 @@ func (m *Manager) finalCleanup() {
 	// recm.Cleanup => gmm.freeMemToOS => cos.FreeMemToOS to forcefully free memory to the OS
 	m.recm.Cleanup()
 
+	// keep checkpoint for (possible) resume when aborted
+	if m.ckpt != nil {
+		if m.aborted() {
+			m.ckpt.close()
+		} else {
+			m.ckpt.remove()
+		}
+	}
+
 	m.creationPhase.metadata.SendOrder = nil
 	m.creationPhase.metadata.Shards = nil
 
@@ -452,6 +463,8 @@ func (m *Manager) onDupRecs(msg string) error { return m.react(m.Pars.Duplicated
 func (m *Manager) setRW() (err error) {
 	var ke shard.KeyExtractor
 	switch m.Pars.Algorithm.Kind {
+	case Composite:
+		ke, err = newCompositeKeyExtractor(m.Pars.Algorithm.Keys)
 	case Content:
 		ke, err = shard.NewContentKeyExtractor(m.Pars.Algorithm.ContentKeyType, m.Pars.Algorithm.Ext)
 	case MD5:
@@ -477,13 +490,39 @@ func (m *Manager) setRW() (err error) {
 	return nil
 }
 
+func newCompositeKeyExtractor(keys []SortKey) (shard.KeyExtractor, error) {
+	levels := make([]shard.KeyExtractor, len(keys))
+	for i, key := range keys {
+		var err error
+		switch key.Kind {
+		case Content:
+			levels[i], err = shard.NewContentKeyExtractor(key.ContentKeyType, key.Ext)
+		case MD5:
+			levels[i], err = shard.NewMD5KeyExtractor()
+		default:
+			levels[i], err = shard.NewNameKeyExtractor()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return shard.NewCompositeKeyExtractor(levels...)
+}
+
 // updateFinishedAck marks tid as finished. If all daemons ack then the
 // finalCleanup is dispatched in separate goroutine.
 func (m *Manager) updateFinishedAck(tid string) {
 	m.finishedAck.mu.Lock()
 	delete(m.finishedAck.m, tid)
-	if len(m.finishedAck.m) == 0 {
+	switch len(m.finishedAck.m) {
+	case 0:
 		go m.finalCleanup()
+	case 1:
+		// When resuming, local records that belong to the skipped (already created)
+		// shards won't be requested - release them once all other targets are done.
+		if _, ok := m.finishedAck.m[g.t.SID()]; ok && m.resumed != nil {
+			go m.decrementRef(m.refCount.Load())
+		}
 	}
 	m.finishedAck.mu.Unlock()
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
 	"github.com/NVIDIA/aistore/fs"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -290,6 +291,68 @@ var _ = Describe("RequestSpec", func() {
 			_, err = rs.parse()
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+		It("should parse composite sorting keys", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm: Algorithm{Keys: []SortKey{
+					{Kind: Content, Ext: " .cls ", ContentKeyType: shard.ContentKeyInt, Decreasing: true},
+					{},
+				}},
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(pars.Algorithm.Kind).To(Equal(Composite))
+			Expect(pars.Algorithm.Keys).To(Equal([]SortKey{
+				{Kind: Content, Ext: ".cls", ContentKeyType: shard.ContentKeyInt, Decreasing: true},
+				{Kind: Alphanumeric, ContentKeyType: shard.ContentKeyString},
+			}))
+		})
+
+		It("should parse output sharding policies", func() {
+			rs := RequestSpec{
+				InputBck:           cmn.Bck{Name: "test"},
+				InputExtension:     archive.ExtTar,
+				InputFormat:        newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:       "prefix-%06d-suffix",
+				OutputShardRecords: 100,
+				OutputGroupSepa:    "/",
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pars.OutputShardRecords).To(Equal(100))
+			Expect(pars.OutputGroupSepa).To(Equal("/"))
+
+			rs.OutputFormat, rs.OutputShardRecords, rs.OutputShardCount = "prefix-{0010..0111}-suffix", 0, 10
+			pars, err = rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pars.OutputShardCount).To(Equal(10))
+		})
+
+		It("should parse order file in a bucket", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputShardSize: "10KB",
+				OrderFileURL:    "s3://ekm-bucket/path/to/ekm.json",
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(pars.EKMBck).To(Equal(cmn.Bck{Provider: apc.AWS, Name: "ekm-bucket"}))
+			Expect(pars.EKMObjName).To(Equal("path/to/ekm.json"))
+			Expect(pars.OrderFileSep).To(Equal("\t"))
+
+			rs.OrderFileURL = "https://example.com/ekm.json"
+			pars, err = rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pars.EKMBck.IsEmpty()).To(BeTrue())
+		})
 	})
 
 	Context("request specs which shall NOT pass", func() {
@@ -478,6 +541,73 @@ var _ = Describe("RequestSpec", func() {
 			Expect(err).Should(HaveOccurred())
 		})
 
+		It("should fail due to invalid composite sorting keys", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm:       Algorithm{Kind: Composite},
+			}
+			_, err := rs.parse()
+			Expect(errors.Is(err, errAlgNoKeys)).To(BeTrue())
+
+			rs.Algorithm = Algorithm{Kind: Shuffle, Keys: []SortKey{{Kind: MD5}}}
+			_, err = rs.parse()
+			Expect(errors.Is(err, errAlgKeys)).To(BeTrue())
+
+			rs.Algorithm = Algorithm{Keys: []SortKey{{Kind: Shuffle}}}
+			_, err = rs.parse()
+			Expect(err).Should(HaveOccurred())
+
+			rs.Algorithm = Algorithm{Keys: []SortKey{{Kind: Content, Ext: "cls", ContentKeyType: shard.ContentKeyInt}}}
+			_, err = rs.parse()
+			Expect(errors.Is(err, errAlgExt)).To(BeTrue())
+		})
+
+		It("should fail due to multiple output sharding policies", func() {
+			rs := RequestSpec{
+				InputBck:           cmn.Bck{Name: "test"},
+				InputExtension:     archive.ExtTar,
+				InputFormat:        newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:       "prefix-{0010..0111}-suffix",
+				OutputShardSize:    "10KB",
+				OutputShardRecords: 100,
+			}
+			_, err := rs.parse()
+			Expect(errors.Is(err, errShardingPolicy)).To(BeTrue())
+
+			rs.OutputShardSize, rs.OutputShardRecords, rs.OutputShardCount = "", 0, 1000
+			_, err = rs.parse()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should fail when group separator is used with order file", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputShardSize: "10KB",
+				OutputGroupSepa: "/",
+				OrderFileURL:    "ais://ekm-bucket/ekm.json",
+			}
+			_, err := rs.parse()
+			Expect(errors.Is(err, errEKMGroupSepa)).To(BeTrue())
+		})
+
+		It("should fail when order file bucket has no object name", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputShardSize: "10KB",
+				OrderFileURL:    "ais://ekm-bucket",
+			}
+			_, err := rs.parse()
+			Expect(err).Should(HaveOccurred())
+		})
+
 		It("should fail when output shard size is empty and output format is %06d", func() {
 			rs := RequestSpec{
 				InputBck:       cmn.Bck{Name: "test"},
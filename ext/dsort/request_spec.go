@@ -32,6 +32,7 @@ type parsedOutputTemplate struct {
 type ParsedReq struct {
 	InputBck  cmn.Bck
 	OutputBck cmn.Bck
+	EKMBck    cmn.Bck // when external key map (aka order file) is a bucket object; empty otherwise
 	pars      *parsedReqSpec
 }
 
@@ -42,11 +43,16 @@ type parsedReqSpec struct {
 	InputExtension      string                `json:"input_extension"`
 	OutputExtension     string                `json:"output_extension"`
 	OutputShardSize     int64                 `json:"output_shard_size,string"`
+	OutputShardRecords  int                   `json:"output_shard_records"`
+	OutputShardCount    int                   `json:"output_shard_count"`
+	OutputGroupSepa     string                `json:"output_group_sepa"`
 	Pit                 *parsedInputTemplate  `json:"pit"`
 	Pot                 *parsedOutputTemplate `json:"pot"`
 	Algorithm           *Algorithm            `json:"algorithm"`
 	OrderFileURL        string                `json:"order_file"`
 	OrderFileSep        string                `json:"order_file_sep"`
+	EKMBck              cmn.Bck               `json:"ekm_bck"`     // order file (EKM) in a bucket
+	EKMObjName          string                `json:"ekm_objname"` // (ditto)
 	MaxMemUsage         cos.ParsedQuantity    `json:"max_mem_usage"`
 	TargetOrderSalt     []byte                `json:"target_order_salt"`
 	ExtractConcMaxLimit int                   `json:"extract_concurrency_max_limit"`
@@ -68,7 +74,10 @@ func specErr(s string, err error) error { return fmt.Errorf("[dsort] parse-spec:
 
 func (rs *RequestSpec) ParseCtx() (*ParsedReq, error) {
 	pars, err := rs.parse()
-	return &ParsedReq{pars.InputBck, pars.OutputBck, pars}, err
+	if err != nil {
+		return nil, err
+	}
+	return &ParsedReq{pars.InputBck, pars.OutputBck, pars.EKMBck, pars}, nil
 }
 
 func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
@@ -143,24 +152,25 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	if pars.OutputShardSize < 0 {
 		return nil, fmt.Errorf(fmtErrNegOutputSize, pars.OutputShardSize)
 	}
+	if err := pars.parseSharding(rs); err != nil {
+		return nil, err
+	}
 	pars.Algorithm, err = parseAlgorithm(rs.Algorithm)
 	if err != nil {
 		return nil, specErr("algorithm", err)
 	}
 
-	var isOrder bool
-	if isOrder, err = validateOrderFileURL(rs.OrderFileURL); err != nil {
-		return nil, fmt.Errorf(fmtErrOrderURL, rs.OrderFileURL, err)
-	}
-	if isOrder {
+	if rs.OrderFileURL == "" {
 		if pars.Pot, err = parseOutputFormat(rs.OutputFormat); err != nil {
 			return nil, err
 		}
 		if pars.Pot.Template.Count() > math.MaxInt32 {
-			// If the count is not defined the output shard size must be
-			if pars.OutputShardSize == 0 {
+			// If the count is not defined the output shard size (or number of records) must be
+			if pars.OutputShardSize == 0 && pars.OutputShardRecords == 0 && pars.OutputShardCount == 0 {
 				return nil, errMissingOutputSize
 			}
+		} else if int64(pars.OutputShardCount) > pars.Pot.Template.Count() {
+			return nil, fmt.Errorf(fmtErrOutputCount, pars.OutputShardCount, pars.Pot.Template.Count())
 		}
 		if rs.OutputFormat != "" {
 			// (ditto)
@@ -172,10 +182,20 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 			}
 		}
 	} else {
-		// For the order file the output shard size must be set.
-		if pars.OutputShardSize == 0 {
+		// For the order file the output shard size (or number of records) must be set.
+		if pars.OutputShardCount > 0 {
+			return nil, errEKMOutputCount
+		}
+		if pars.OutputGroupSepa != "" {
+			return nil, errEKMGroupSepa
+		}
+		if pars.OutputShardSize == 0 && pars.OutputShardRecords == 0 {
 			return nil, errMissingOutputSize
 		}
+		pars.EKMBck, pars.EKMObjName, err = parseOrderFile(rs.OrderFileURL)
+		if err != nil {
+			return nil, fmt.Errorf(fmtErrOrderURL, rs.OrderFileURL, err)
+		}
 		pars.OrderFileURL = rs.OrderFileURL
 		pars.OrderFileSep = rs.OrderFileSep
 		if pars.OrderFileSep == "" {
@@ -242,10 +262,38 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	return pars, nil
 }
 
+// output sharding policy: at most one of (size, number of records, number of shards),
+// optionally combined with never splitting record groups
+func (pars *parsedReqSpec) parseSharding(rs *RequestSpec) error {
+	if rs.OutputShardRecords < 0 || rs.OutputShardCount < 0 {
+		return fmt.Errorf(fmtErrNegOutputCnt, rs.OutputShardRecords, rs.OutputShardCount)
+	}
+	var n int
+	if pars.OutputShardSize > 0 {
+		n++
+	}
+	if rs.OutputShardRecords > 0 {
+		n++
+	}
+	if rs.OutputShardCount > 0 {
+		n++
+	}
+	if n > 1 {
+		return errShardingPolicy
+	}
+	pars.OutputShardRecords = rs.OutputShardRecords
+	pars.OutputShardCount = rs.OutputShardCount
+	pars.OutputGroupSepa = rs.OutputGroupSepa
+	return nil
+}
+
 func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 	if !cos.StringInSlice(alg.Kind, algorithms) {
 		return nil, fmt.Errorf(fmtErrInvalidAlg, algorithms)
 	}
+	if len(alg.Keys) > 0 || alg.Kind == Composite {
+		return parseComposite(alg)
+	}
 	if alg.Seed != "" {
 		if value, err := strconv.ParseInt(alg.Seed, 10, 64); value < 0 || err != nil {
 			return nil, fmt.Errorf(fmtErrSeed, alg.Seed)
@@ -266,11 +314,58 @@ func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 	return &alg, nil
 }
 
-func validateOrderFileURL(orderURL string) (empty bool, err error) {
-	if orderURL == "" {
-		return true, nil
+func parseComposite(alg Algorithm) (*Algorithm, error) {
+	if alg.Kind != algDefault && alg.Kind != Composite {
+		return nil, fmt.Errorf("%w: %q", errAlgKeys, alg.Kind)
+	}
+	if len(alg.Keys) == 0 {
+		return nil, errAlgNoKeys
+	}
+	alg.Kind = Composite
+	alg.ContentKeyType = shard.ContentKeyString // n/a
+	keys := make([]SortKey, len(alg.Keys))
+	for i, key := range alg.Keys {
+		if !cos.StringInSlice(key.Kind, keyKinds) {
+			return nil, fmt.Errorf(fmtErrInvalidKey, i, key.Kind, keyKinds)
+		}
+		if key.Kind == algDefault {
+			key.Kind = Alphanumeric
+		}
+		if key.Kind == Content {
+			key.Ext = strings.TrimSpace(key.Ext)
+			if key.Ext == "" || key.Ext[0] != '.' {
+				return nil, fmt.Errorf("%w %q (key %d)", errAlgExt, key.Ext, i)
+			}
+			if err := shard.ValidateContentKeyTy(key.ContentKeyType); err != nil {
+				return nil, err
+			}
+		} else {
+			key.ContentKeyType = shard.ContentKeyString
+		}
+		keys[i] = key
+	}
+	alg.Keys = keys
+	return &alg, nil
+}
+
+// order file (EKM) is either URL or bucket object, e.g.:
+// - "https://example.com/ekm.json"
+// - "ais://@uuid#ns/bucket/ekm.txt"
+// - "gs://bucket/path/ekm.json"
+func parseOrderFile(orderFile string) (bck cmn.Bck, objName string, err error) {
+	scheme, _ := cmn.ParseURLScheme(orderFile)
+	if scheme == "" || apc.NormalizeProvider(scheme) == "" {
+		_, err = url.ParseRequestURI(orderFile)
+		return
+	}
+	if bck, objName, err = cmn.ParseBckObjectURI(orderFile, cmn.ParseURIOpts{}); err != nil {
+		return
+	}
+	if objName == "" {
+		err = errMissingEKMObj
+		return
 	}
-	_, err = url.ParseRequestURI(orderURL)
+	err = bck.Validate()
 	return
 }
 
@@ -9,12 +9,14 @@ import (
 	"bytes"
 	"crypto/md5"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
 	"strconv"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
 )
 
 const (
@@ -25,8 +27,9 @@ const (
 
 type (
 	SingleKeyExtractor struct {
-		name string
-		buf  *bytes.Buffer
+		name   string
+		buf    *bytes.Buffer
+		levels []*SingleKeyExtractor // composite key only
 	}
 
 	KeyExtractor interface {
@@ -46,6 +49,13 @@ type (
 		ext string // file with this extension provides sorting key (of the type `ty`)
 	}
 
+	// multi-level key: a slice of keys extracted by the respective (per-level) extractors;
+	// levels that cannot be extracted from a given record's file are nil
+	// (and get filled in when record's files are merged - see Record.mergeKey)
+	compositeKeyExtractor struct {
+		levels []KeyExtractor
+	}
+
 	ErrSortingKeyType struct {
 		ty string
 	}
@@ -127,6 +137,44 @@ func (ke *contentKeyExtractor) ExtractKey(ske *SingleKeyExtractor) (any, error)
 	}
 }
 
+///////////////////////////
+// compositeKeyExtractor //
+///////////////////////////
+
+func NewCompositeKeyExtractor(levels ...KeyExtractor) (KeyExtractor, error) {
+	if len(levels) == 0 {
+		return nil, errors.New("composite key: no levels")
+	}
+	return &compositeKeyExtractor{levels: levels}, nil
+}
+
+// NOTE: each content level (possibly) tees the reader returned by the previous one
+func (ke *compositeKeyExtractor) PrepareExtractor(name string, r cos.ReadSizer, ext string) (cos.ReadSizer, *SingleKeyExtractor, bool) {
+	var (
+		needRead bool
+		ske      = &SingleKeyExtractor{name: name, levels: make([]*SingleKeyExtractor, len(ke.levels))}
+	)
+	for i, lke := range ke.levels {
+		var read bool
+		r, ske.levels[i], read = lke.PrepareExtractor(name, r, ext)
+		needRead = needRead || read
+	}
+	return r, ske, needRead
+}
+
+func (ke *compositeKeyExtractor) ExtractKey(ske *SingleKeyExtractor) (any, error) {
+	debug.Assert(ske != nil && len(ske.levels) == len(ke.levels))
+	keys := make([]any, len(ke.levels))
+	for i, lke := range ke.levels {
+		key, err := lke.ExtractKey(ske.levels[i])
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
 func ValidateContentKeyTy(ty string) error {
 	switch ty {
 	case ContentKeyInt, ContentKeyFloat, ContentKeyString:
@@ -388,8 +388,7 @@ func cosExt(path string) (ext string) {
 }
 
 func genRecordUname(shardName, recordName string) string {
-	recordWithoutExt := strings.TrimSuffix(recordName, cosExt(recordName))
-	return shardName + recSepa + recordWithoutExt
+	return shardName + recSepa + SampleKey(recordName)
 }
 
 func parseRecordUname(recordUniqueName string) (shardName, recordName string) {
@@ -7,6 +7,7 @@ package shard
 
 import (
 	"encoding/json"
+	"strings"
 	"sync"
 	"unsafe"
 
@@ -90,10 +91,30 @@ type (
 // is actually merged.
 func (r *Record) mergeObjects(other *Record) {
 	debug.Assert(r.Name == other.Name, r.Name+" vs "+other.Name)
-	if r.Key == nil && other.Key != nil {
+	r.mergeKey(other)
+	r.Objects = append(r.Objects, other.Objects...)
+}
+
+func (r *Record) mergeKey(other *Record) {
+	if r.Key == nil {
 		r.Key = other.Key
+		return
+	}
+	// composite key: fill in the levels that are still missing
+	keys, ok := r.Key.([]any)
+	if !ok {
+		return
+	}
+	okeys, ok := other.Key.([]any)
+	if !ok {
+		return
+	}
+	debug.Assert(len(keys) == len(okeys), len(keys), " vs ", len(okeys))
+	for i := range keys {
+		if keys[i] == nil {
+			keys[i] = okeys[i]
+		}
 	}
-	r.Objects = append(r.Objects, other.Objects...)
 }
 
 func (r *Record) find(ext string) int {
@@ -131,6 +152,14 @@ func (r *Record) MakeUniqueName(obj *RecordObj) string {
 	return r.Name + obj.Extension
 }
 
+// record name without (input) shard name - see genRecordUname
+func (r *Record) Basename() string {
+	if i := strings.Index(r.Name, recSepa); i >= 0 {
+		return r.Name[i+1:]
+	}
+	return r.Name
+}
+
 /////////////
 // Records //
 /////////////
@@ -215,6 +244,28 @@ func (r *Records) Slice(start, end int) *Records {
 	}
 }
 
+// Group reorders records so that all records with the same group key become adjacent;
+// groups follow the order of their first appearance, and records within each group
+// retain their relative order.
+func (r *Records) Group(groupKey func(*Record) string) {
+	var (
+		order  = make([]string, 0, 64)
+		groups = make(map[string][]*Record, 64)
+	)
+	for _, record := range r.arr {
+		key := groupKey(record)
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], record)
+	}
+	arr := r.arr[:0]
+	for _, key := range order {
+		arr = append(arr, groups[key]...)
+	}
+	r.arr = arr
+}
+
 func (r *Records) Len() int {
 	return len(r.arr)
 }
@@ -228,13 +279,40 @@ func (r *Records) Less(i, j int, keyType string) (bool, error) {
 	} else if rhs == nil {
 		return false, errors.Errorf("key is missing for %q", r.arr[j].Name)
 	}
+	return less(lhs, rhs, keyType), nil
+}
 
+// same as above for a given level of the composite key
+func (r *Records) LessAt(i, j, level int, keyType string) (bool, error) {
+	lhs, err := r.keyAt(i, level)
+	if err != nil {
+		return false, err
+	}
+	rhs, err := r.keyAt(j, level)
+	if err != nil {
+		return false, err
+	}
+	return less(lhs, rhs, keyType), nil
+}
+
+func (r *Records) keyAt(i, level int) (any, error) {
+	keys, ok := r.arr[i].Key.([]any)
+	if !ok || level >= len(keys) {
+		return nil, errors.Errorf("composite key is missing for %q", r.arr[i].Name)
+	}
+	if keys[level] == nil {
+		return nil, errors.Errorf("key (level %d) is missing for %q", level, r.arr[i].Name)
+	}
+	return keys[level], nil
+}
+
+func less(lhs, rhs any, keyType string) bool {
 	switch keyType {
 	case ContentKeyInt:
 		ilhs, lok := lhs.(int64)
 		irhs, rok := rhs.(int64)
 		if lok && rok {
-			return ilhs < irhs, nil
+			return ilhs < irhs
 		}
 		// (motivation: javascript does not support int64 type)
 		if !lok {
@@ -242,23 +320,23 @@ func (r *Records) Less(i, j int, keyType string) (bool, error) {
 		} else {
 			irhs = int64(rhs.(float64))
 		}
-		return ilhs < irhs, nil
+		return ilhs < irhs
 	case ContentKeyFloat:
 		flhs, lok := lhs.(float64)
 		frhs, rok := rhs.(float64)
 		debug.Assert(lok, lhs)
 		debug.Assert(rok, rhs)
-		return flhs < frhs, nil
+		return flhs < frhs
 	case ContentKeyString:
 		slhs, lok := lhs.(string)
 		srhs, rok := rhs.(string)
 		debug.Assert(lok, lhs)
 		debug.Assert(rok, rhs)
-		return slhs < srhs, nil
+		return slhs < srhs
 	}
 
-	debug.Assertf(false, "lhs: %v, rhs: %v, arr[i]: %v, arr[j]: %v", lhs, rhs, r.arr[i], r.arr[j])
-	return false, nil
+	debug.Assertf(false, "lhs: %v, rhs: %v, key type: %q", lhs, rhs, keyType)
+	return false
 }
 
 func (r *Records) TotalObjectCount() int {
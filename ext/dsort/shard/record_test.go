@@ -100,6 +100,23 @@ var _ = Describe("Records", func() {
 			Expect(r.TotalSize()).To(BeEquivalentTo(len(r.Objects) * objectSize))
 		})
 
+		It("should merge composite keys", func() {
+			records := shard.NewRecords(0)
+			records.Insert(&shard.Record{
+				Key:     []any{nil, "some_key.jpg"},
+				Name:    "some_key",
+				Objects: []*shard.RecordObj{{Size: objectSize, Extension: ".jpg"}},
+			})
+			records.Insert(&shard.Record{
+				Key:     []any{int64(7), "some_key.cls"},
+				Name:    "some_key",
+				Objects: []*shard.RecordObj{{Size: objectSize, Extension: ".cls"}},
+			})
+
+			Expect(records.Len()).To(Equal(1))
+			Expect(records.All()[0].Key).To(Equal([]any{int64(7), "some_key.jpg"}))
+		})
+
 		It("should delete record obj", func() {
 			records := shard.NewRecords(0)
 			records.Insert(&shard.Record{
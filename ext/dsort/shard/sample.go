@@ -0,0 +1,291 @@
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all suppported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// WebDataset samples
+//
+// A sample is a group of archived files that share the same name sans extension, whereby
+// the extension starts at the first '.' of the basename - the same convention that dsort
+// uses to group archived files into records (see `cosExt` and `genRecordUname`).
+// As per WebDataset, files of the same sample are expected to be stored contiguously.
+
+const sidecarExt = ".json"
+
+type (
+	Sample struct {
+		Key  string   `json:"key"`
+		Exts []string `json:"exts"`
+		Size int64    `json:"size"`
+	}
+
+	// sample that is currently being read (and buffered)
+	sampleCtx struct {
+		sgl     *memsys.SGL
+		key     string
+		files   []sampleFile
+		sidecar []byte
+	}
+	sampleFile struct {
+		name  string
+		size  int64
+		mtime int64
+	}
+
+	samplesDP struct {
+		flt *apc.SampleFilter
+		mm  *memsys.MMSA
+	}
+)
+
+// interface guard
+var _ cluster.DP = (*samplesDP)(nil)
+
+func SampleKey(name string) string { return strings.TrimSuffix(name, cosExt(name)) }
+
+// groups (listed) archived files into samples sorted by sample key
+func Samples(entries []*archive.Entry) []*Sample {
+	var (
+		samples = make([]*Sample, 0, len(entries))
+		keys    = make(map[string]*Sample, len(entries))
+	)
+	for _, e := range entries {
+		key := SampleKey(e.Name)
+		s, ok := keys[key]
+		if !ok {
+			s = &Sample{Key: key}
+			keys[key] = s
+			samples = append(samples, s)
+		}
+		s.Exts = append(s.Exts, cosExt(e.Name))
+		s.Size += e.Size
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Key < samples[j].Key })
+	return samples
+}
+
+// returns true if the archive contains the sample `key` (without reading archived content)
+func HasSample(ar archive.Reader, key string) (found bool, err error) {
+	_, err = ar.Range("", func(name string, reader cos.ReadCloseSizer, hdr any) (bool, error) {
+		reader.Close()
+		found = !isDirEntry(name, hdr) && SampleKey(name) == key
+		return found, nil
+	})
+	return
+}
+
+// writes all files of the sample `key` into `aw`; returns the number of files written
+// (zero when the sample is not found)
+func WriteSample(ar archive.Reader, aw archive.Writer, key string) (n int, err error) {
+	_, err = ar.Range("", func(name string, reader cos.ReadCloseSizer, hdr any) (bool, error) {
+		defer reader.Close()
+		if isDirEntry(name, hdr) || SampleKey(name) != key {
+			return n > 0, nil // stop upon reaching the end of the (contiguous) sample
+		}
+		n++
+		oah := &cmn.ObjAttrs{Size: reader.Size(), Atime: mtime(hdr)}
+		return false, aw.Write(name, oah, reader)
+	})
+	return
+}
+
+// copies archived files from `ar` to `aw` while dropping the samples that match the filter;
+// returns the numbers of kept and dropped samples
+func FilterSamples(ar archive.Reader, aw archive.Writer, flt *apc.SampleFilter, mm *memsys.MMSA) (kept, dropped int, err error) {
+	ctx := &sampleCtx{sgl: mm.NewSGL(0)}
+	defer ctx.sgl.Free()
+
+	flush := func() error {
+		if len(ctx.files) == 0 {
+			return nil
+		}
+		var err error
+		if ctx.match(flt) {
+			dropped++
+		} else {
+			kept++
+			err = ctx.write(aw)
+		}
+		ctx.reset()
+		return err
+	}
+	_, err = ar.Range("", func(name string, reader cos.ReadCloseSizer, hdr any) (bool, error) {
+		defer reader.Close()
+		if isDirEntry(name, hdr) {
+			return false, nil
+		}
+		if key := SampleKey(name); key != ctx.key {
+			if err := flush(); err != nil {
+				return true, err
+			}
+			ctx.key = key
+		}
+		return false, ctx.add(name, reader, hdr, flt.Field != "")
+	})
+	if err == nil {
+		err = flush()
+	}
+	return
+}
+
+///////////////
+// sampleCtx //
+///////////////
+
+func (ctx *sampleCtx) add(name string, reader cos.ReadCloseSizer, hdr any, sidecar bool) (err error) {
+	if sidecar && cosExt(name) == sidecarExt {
+		if ctx.sidecar, err = io.ReadAll(reader); err == nil {
+			_, err = ctx.sgl.Write(ctx.sidecar)
+		}
+	} else {
+		_, err = io.Copy(ctx.sgl, reader)
+	}
+	ctx.files = append(ctx.files, sampleFile{name: name, size: reader.Size(), mtime: mtime(hdr)})
+	return
+}
+
+func (ctx *sampleCtx) match(flt *apc.SampleFilter) bool {
+	for _, f := range ctx.files {
+		ext := cosExt(f.name)
+		for _, e := range flt.Exts {
+			if strings.HasSuffix(ext, e) { // e.g. ".cls.txt" matches both ".cls.txt" and ".txt"
+				return true
+			}
+		}
+	}
+	if flt.Field == "" || ctx.sidecar == nil {
+		return false
+	}
+	return matchField(ctx.sidecar, flt.Field, flt.Value)
+}
+
+func (ctx *sampleCtx) write(aw archive.Writer) error {
+	r := memsys.NewReader(ctx.sgl)
+	for _, f := range ctx.files {
+		oah := &cmn.ObjAttrs{Size: f.size, Atime: f.mtime}
+		if err := aw.Write(f.name, oah, io.LimitReader(r, f.size)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctx *sampleCtx) reset() {
+	ctx.sgl.Reset()
+	ctx.files = ctx.files[:0]
+	ctx.sidecar = nil
+}
+
+// invalid (non-JSON) sidecar does not match
+func matchField(sidecar []byte, field, value string) bool {
+	var v any
+	if err := jsoniter.Unmarshal(sidecar, &v); err != nil {
+		return false
+	}
+	for _, k := range strings.Split(field, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return false
+		}
+		if v, ok = m[k]; !ok {
+			return false
+		}
+	}
+	return value == "" || fmt.Sprint(v) == value
+}
+
+func isDirEntry(name string, hdr any) bool {
+	if h, ok := hdr.(*tar.Header); ok {
+		return h.Typeflag == tar.TypeDir
+	}
+	return strings.HasSuffix(name, "/")
+}
+
+func mtime(hdr any) int64 {
+	switch h := hdr.(type) {
+	case *tar.Header:
+		return h.ModTime.UnixNano()
+	case *zip.FileHeader:
+		return h.Modified.UnixNano()
+	default:
+		return time.Now().UnixNano()
+	}
+}
+
+///////////////
+// samplesDP //
+///////////////
+
+// Returns data provider that streams shards while dropping the samples that match the filter
+// (see FilterSamples). Objects that are not shards are read as is; zip shards (that require
+// io.ReaderAt) are not supported.
+func NewSamplesDP(flt *apc.SampleFilter, mm *memsys.MMSA) (cluster.DP, error) {
+	if err := flt.Validate(); err != nil {
+		return nil, err
+	}
+	return &samplesDP{flt: flt, mm: mm}, nil
+}
+
+// Returns a reader of the filtered shard, whereby the filtering is done on the fly
+// (and the resulting size is therefore unknown).
+func (dp *samplesDP) Reader(lom *cluster.LOM) (cos.ReadOpenCloser, cos.OAH, error) {
+	var ldp cluster.LDP
+	mime, err := archive.Mime("", lom.ObjName)
+	if err != nil {
+		return ldp.Reader(lom) // not a shard
+	}
+	if mime == archive.ExtZip {
+		return nil, nil, cmn.NewErrUnsupp("filter samples in", lom.Cname()+" (zip shard)")
+	}
+	roc, _, err := ldp.Reader(lom)
+	if err != nil {
+		return nil, nil, err
+	}
+	ar, err := archive.NewReader(mime, roc)
+	if err != nil {
+		roc.Close()
+		return nil, nil, cmn.NewErrFailedTo(nil, "open", lom.Cname(), err)
+	}
+	var (
+		cname  = lom.Cname()
+		pr, pw = io.Pipe()
+	)
+	go func() {
+		aw := archive.NewWriter(mime, pw, nil /*cksum*/, nil /*opts*/)
+		_, _, err := FilterSamples(ar, aw, dp.flt, dp.mm)
+		aw.Fini()
+		roc.Close()
+		if err != nil {
+			err = cmn.NewErrFailedTo(nil, "filter samples", cname, err)
+		}
+		pw.CloseWithError(err)
+	}()
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	oah := &cmn.ObjAttrs{
+		Size:  cos.ContentLengthUnknown,
+		Ver:   "",            // filtered shard - current version does not apply
+		Cksum: cos.NoneCksum, // ditto
+		Atime: lom.AtimeUnix(),
+	}
+	return cos.NopOpener(pr), oah, nil
+}
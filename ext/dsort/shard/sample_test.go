@@ -0,0 +1,121 @@
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all suppported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	"github.com/NVIDIA/aistore/memsys"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Samples", func() {
+	files := [][2]string{
+		{"a/0001.jpg", "jpg-1"},
+		{"a/0001.cls.txt", "cat"},
+		{"a/0001.json", `{"meta": {"label": "cat", "score": 3}}`},
+		{"a/0002.jpg", "jpg-2"},
+		{"a/0002.cls.txt", "dog"},
+		{"a/0002.json", `{"meta": {"label": "dog"}}`},
+		{"a/0003.png", "png-3"},
+	}
+
+	newShard := func() *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		aw := archive.NewWriter(archive.ExtTar, buf, nil, nil)
+		for _, f := range files {
+			err := aw.Write(f[0], &cmn.ObjAttrs{Size: int64(len(f[1]))}, strings.NewReader(f[1]))
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		aw.Fini()
+		return buf
+	}
+
+	list := func(r *bytes.Buffer) (names []string) {
+		ar, err := archive.NewReader(archive.ExtTar, r)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = ar.Range("", func(name string, _ cos.ReadCloseSizer, _ any) (bool, error) {
+			names = append(names, name)
+			return false, nil
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return
+	}
+
+	filter := func(flt *apc.SampleFilter) (kept, dropped int, names []string) {
+		Expect(flt.Validate()).To(Succeed())
+		ar, err := archive.NewReader(archive.ExtTar, newShard())
+		Expect(err).ShouldNot(HaveOccurred())
+		out := &bytes.Buffer{}
+		aw := archive.NewWriter(archive.ExtTar, out, nil, nil)
+		kept, dropped, err = shard.FilterSamples(ar, aw, flt, memsys.PageMM())
+		aw.Fini()
+		Expect(err).ShouldNot(HaveOccurred())
+		return kept, dropped, list(out)
+	}
+
+	It("should group files into samples", func() {
+		entries := make([]*archive.Entry, 0, len(files))
+		for _, f := range files {
+			entries = append(entries, &archive.Entry{Name: f[0], Size: int64(len(f[1]))})
+		}
+		samples := shard.Samples(entries)
+		Expect(samples).To(HaveLen(3))
+		Expect(samples[0].Key).To(Equal("a/0001"))
+		Expect(samples[0].Exts).To(ConsistOf(".jpg", ".cls.txt", ".json"))
+		Expect(samples[2].Size).To(BeEquivalentTo(len("png-3")))
+	})
+
+	It("should write a single sample", func() {
+		ar, err := archive.NewReader(archive.ExtTar, newShard())
+		Expect(err).ShouldNot(HaveOccurred())
+		out := &bytes.Buffer{}
+		aw := archive.NewWriter(archive.ExtTar, out, nil, nil)
+		n, err := shard.WriteSample(ar, aw, "a/0002")
+		aw.Fini()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(n).To(Equal(3))
+		Expect(list(out)).To(Equal([]string{"a/0002.jpg", "a/0002.cls.txt", "a/0002.json"}))
+	})
+
+	It("should find samples", func() {
+		for key, expected := range map[string]bool{"a/0001": true, "a/0003": true, "a/0004": false, "a": false} {
+			ar, err := archive.NewReader(archive.ExtTar, newShard())
+			Expect(err).ShouldNot(HaveOccurred())
+			found, err := shard.HasSample(ar, key)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(found).To(Equal(expected), key)
+		}
+	})
+
+	It("should drop samples by extension", func() {
+		kept, dropped, names := filter(&apc.SampleFilter{Exts: []string{"png"}})
+		Expect(kept).To(Equal(2))
+		Expect(dropped).To(Equal(1))
+		Expect(names).To(HaveLen(6))
+		Expect(names).NotTo(ContainElement("a/0003.png"))
+	})
+
+	It("should drop samples by JSON sidecar field", func() {
+		kept, dropped, names := filter(&apc.SampleFilter{Field: "meta.label", Value: "cat"})
+		Expect(kept).To(Equal(2))
+		Expect(dropped).To(Equal(1))
+		Expect(names[0]).To(Equal("a/0002.jpg"))
+
+		_, dropped, _ = filter(&apc.SampleFilter{Field: "meta.score", Value: "3"})
+		Expect(dropped).To(Equal(1))
+
+		_, dropped, _ = filter(&apc.SampleFilter{Field: "meta.label"})
+		Expect(dropped).To(Equal(2))
+	})
+})
@@ -21,10 +21,27 @@ type (
 		keyType    string
 		decreasing bool
 	}
+	byName struct {
+		records *shard.Records
+	}
+	// composite (multi-level) key
+	byKeys struct {
+		err     error
+		records *shard.Records
+		keys    []SortKey
+	}
 )
 
 // interface guard
-var _ sort.Interface = (*alphaByKey)(nil)
+var (
+	_ sort.Interface = (*alphaByKey)(nil)
+	_ sort.Interface = (*byKeys)(nil)
+	_ sort.Interface = (*byName)(nil)
+)
+
+func (s *byName) Len() int           { return s.records.Len() }
+func (s *byName) Swap(i, j int)      { s.records.Swap(i, j) }
+func (s *byName) Less(i, j int) bool { return s.records.All()[i].Name < s.records.All()[j].Name }
 
 func (s *alphaByKey) Len() int      { return s.records.Len() }
 func (s *alphaByKey) Swap(i, j int) { s.records.Swap(i, j) }
@@ -45,11 +62,50 @@ func (s *alphaByKey) Less(i, j int) bool {
 	return less
 }
 
+func (s *byKeys) Len() int      { return s.records.Len() }
+func (s *byKeys) Swap(i, j int) { s.records.Swap(i, j) }
+
+// compare level by level until the first inequality
+func (s *byKeys) Less(i, j int) bool {
+	if s.err != nil {
+		return false
+	}
+	for level, key := range s.keys {
+		lhs, rhs := i, j
+		if key.Decreasing {
+			lhs, rhs = j, i
+		}
+		less, err := s.records.LessAt(lhs, rhs, level, key.ContentKeyType)
+		if err != nil {
+			s.err = err
+			return false
+		}
+		if less {
+			return true
+		}
+		if less, _ = s.records.LessAt(rhs, lhs, level, key.ContentKeyType); less {
+			return false
+		}
+	}
+	return false
+}
+
 // sorts records by each Record.Key in the order determined by the `alg` algorithm.
+// Except for `None`, the resulting order does not depend on the order in which
+// records were extracted and merged, which makes it reproducible when resuming.
 func sortRecords(r *shard.Records, alg *Algorithm) (err error) {
-	switch alg.Kind {
-	case None:
+	if alg.Kind == None {
 		return nil
+	}
+	// Records arrive in the order they were extracted and merged, which differs from run to run.
+	// Shuffle permutes whatever order it is given, and the stable sorts keep it for equal
+	// keys (content, composite), so we first sort by name to make the output reproducible -
+	// a resumed job must regenerate the very same shards (see checkpoint.go).
+	// Alphanumeric and md5 keys derive from the (unique) record names - no ties to break.
+	if alg.Kind != Alphanumeric && alg.Kind != algDefault && alg.Kind != MD5 {
+		sort.Sort(&byName{r})
+	}
+	switch alg.Kind {
 	case Shuffle:
 		var (
 			rnd  *rand.Rand
@@ -64,9 +120,13 @@ func sortRecords(r *shard.Records, alg *Algorithm) (err error) {
 			j := rnd.Intn(i + 1)
 			r.Swap(i, j)
 		}
+	case Composite:
+		keys := &byKeys{records: r, keys: alg.Keys}
+		sort.Stable(keys)
+		err = keys.err
 	default:
 		keys := &alphaByKey{records: r, decreasing: alg.Decreasing, keyType: alg.ContentKeyType}
-		sort.Sort(keys)
+		sort.Stable(keys)
 		err = keys.err
 	}
 	return
@@ -87,6 +87,36 @@ var _ = Describe("SortRecords", func() {
 		Expect(fm).To(Equal(expected))
 	})
 
+	It("should sort records by composite keys", func() {
+		fm := shard.NewRecords(4)
+		for i, key := range [][]any{{int64(2), "b"}, {int64(1), "z"}, {int64(2), "a"}, {int64(1), "y"}} {
+			fm.Insert(&shard.Record{Key: key, Name: fmt.Sprintf("r%d", i)})
+		}
+		alg := &Algorithm{Kind: Composite, Keys: []SortKey{
+			{Kind: Content, ContentKeyType: shard.ContentKeyInt},
+			{Kind: Alphanumeric, ContentKeyType: shard.ContentKeyString, Decreasing: true},
+		}}
+		err := sortRecords(fm, alg)
+		Expect(err).ToNot(HaveOccurred())
+
+		names := make([]string, 0, fm.Len())
+		for _, r := range fm.All() {
+			names = append(names, r.Name)
+		}
+		Expect(names).To(Equal([]string{"r1", "r3", "r0", "r2"}))
+	})
+
+	It("should return error when some composite key levels are missing", func() {
+		fm := shard.NewRecords(2)
+		fm.Insert(&shard.Record{Key: []any{int64(2), "b"}, Name: "r0"})
+		fm.Insert(&shard.Record{Key: []any{nil, "a"}, Name: "r1"})
+		alg := &Algorithm{Kind: Composite, Keys: []SortKey{
+			{Kind: Content, ContentKeyType: shard.ContentKeyInt},
+			{Kind: Alphanumeric, ContentKeyType: shard.ContentKeyString},
+		}}
+		Expect(sortRecords(fm, alg)).To(HaveOccurred())
+	})
+
 	It("should return error when some keys are missing", func() {
 		fm := createRecords("def", "abc")
 		fm.All()[0].Key = nil
@@ -32,6 +32,9 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileDload        = "dload"          // partially downloaded object (to resume)
+	WorkfileBlobDl       = "blob-dl"        // chunked (blob) download
+	WorkfileInventory    = "inventory"      // bucket inventory chunk (or manifest)
 )
 
 type ParsedFQN struct {
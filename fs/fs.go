@@ -55,6 +55,8 @@ type (
 		Disks      []string // owned disks (ios.FsDisks map => slice)
 		flags      uint64   // bit flags (set/get atomic)
 		PathDigest uint64   // (HRW logic)
+		Weight     uint64   // weighted HRW (config.HRW); zero when not weighted
+		Tier       string   // apc.TierFast | apc.TierSlow (config.Tier)
 		capacity   Capacity
 	}
 	MPI map[string]*Mountpath
@@ -154,6 +156,8 @@ func (mi *Mountpath) String() string {
 
 func (mi *Mountpath) LomCache(idx int) *sync.Map { return mi.lomCaches.Get(idx) }
 
+func (mi *Mountpath) IsFast() bool { return mi.Tier == apc.TierFast }
+
 func LcacheIdx(digest uint64) int { return int(digest & cos.MultiSyncMapMask) }
 
 func (mi *Mountpath) IsIdle(config *cmn.Config) bool {
@@ -405,11 +409,46 @@ func (mi *Mountpath) _addEnabled(tid string, avail MPI, config *cmn.Config) erro
 		}
 	}
 	mi._setDisks(disks)
+	if err := mi.setWeight(config); err != nil {
+		return err
+	}
+	mi.setTier(config)
 	_ = mi.String() // assign mi.info if not yet
 	avail[mi.Path] = mi
 	return nil
 }
 
+// weighted HRW: explicitly configured or else capacity-derived (GiB)
+func (mi *Mountpath) setWeight(config *cmn.Config) error {
+	mi.Weight = 0
+	if !config.HRW.Enabled {
+		return nil
+	}
+	for mpath, w := range config.HRW.Mountpaths {
+		if w > 0 && filepath.Clean(mpath) == mi.Path {
+			mi.Weight = w
+			return nil
+		}
+	}
+	statfs := &syscall.Statfs_t{}
+	if err := syscall.Statfs(mi.Path, statfs); err != nil {
+		return err
+	}
+	mi.Weight = max(statfs.Blocks*uint64(statfs.Bsize)/cos.GiB, 1)
+	return nil
+}
+
+// tier label: explicitly configured or else slow
+func (mi *Mountpath) setTier(config *cmn.Config) {
+	mi.Tier = apc.TierSlow
+	for mpath, tier := range config.Tier.Mountpaths {
+		if filepath.Clean(mpath) == mi.Path {
+			mi.Tier = tier
+			return
+		}
+	}
+}
+
 // under lock: clones and adds self to available
 func (mi *Mountpath) _cloneAddEnabled(tid string, config *cmn.Config) (err error) {
 	debug.Assert(!mi.IsAnySet(FlagWaitingDD)) // m.b. new
@@ -695,7 +734,7 @@ func Remove(mpath string, cb ...func()) (*Mountpath, error) {
 	defer mfs.mu.Unlock()
 
 	// Clear target ID if set
-	if err := removeXattr(cleanMpath, nodeXattrID); err != nil {
+	if err := RemoveXattr(cleanMpath, nodeXattrID); err != nil {
 		return nil, err
 	}
 	avail, disabled := Get()
@@ -853,6 +892,19 @@ func GetAvail() MPI {
 	return *avail
 }
 
+// true when available mountpaths include both fast and slow tiers (see MpathTierConf)
+func HasTiers() bool {
+	var fast, slow bool
+	for _, mi := range GetAvail() {
+		if mi.IsFast() {
+			fast = true
+		} else {
+			slow = true
+		}
+	}
+	return fast && slow
+}
+
 func CreateBucket(bck *cmn.Bck, nilbmd bool) (errs []error) {
 	var (
 		avail            = GetAvail()
@@ -13,12 +13,15 @@ import (
 
 // A variant of consistent hash based on rendezvous algorithm by Thaler and Ravishankar,
 // aka highest random weight (HRW)
+// Weighted (see Mountpath.Weight and cos.HrwWeighted) when all available mountpaths have weights.
 // See also: cluster/meta/hrw.go
 
 func Hrw(uname string) (mi *Mountpath, digest uint64, err error) {
 	var (
-		max   uint64
-		avail = GetAvail()
+		max, wmax uint64
+		wmi       *Mountpath
+		weighted  = true
+		avail     = GetAvail()
 	)
 	digest = xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
 	for _, mpathInfo := range avail {
@@ -30,6 +33,18 @@ func Hrw(uname string) (mi *Mountpath, digest uint64, err error) {
 			max = cs
 			mi = mpathInfo
 		}
+		if !weighted {
+			continue
+		}
+		if mpathInfo.Weight == 0 {
+			weighted = false
+		} else if ws := cos.HrwWeighted(cs, mpathInfo.Weight); ws >= wmax {
+			wmax = ws
+			wmi = mpathInfo
+		}
+	}
+	if weighted && wmi != nil {
+		mi = wmi
 	}
 	if mi == nil {
 		err = cmn.ErrNoMountpaths
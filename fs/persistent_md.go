@@ -30,6 +30,8 @@ var mdFilesDirs = []string{
 	fname.BmdPrevious,
 
 	fname.Vmd,
+
+	fname.DsortDir,
 }
 
 func MarkerExists(marker string) bool {
@@ -36,8 +36,8 @@ func SetXattr(fqn, attrName string, data []byte) (err error) {
 	return unix.Setxattr(fqn, attrName, data, 0)
 }
 
-// removeXattr removes xattr
-func removeXattr(fqn, attrName string) error {
+// RemoveXattr removes xattr
+func RemoveXattr(fqn, attrName string) error {
 	err := unix.Removexattr(fqn, attrName)
 	if err != nil && !cos.IsErrXattrNotFound(err) {
 		nlog.Errorf("failed to remove %q from %s: %v", attrName, fqn, err)
@@ -14,4 +14,5 @@ func Init() {
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActETLBck})
 	xreg.RegBckXact(&mncFactory{})
 	xreg.RegBckXact(&putFactory{})
+	xreg.RegNonBckXact(&tierFactory{})
 }
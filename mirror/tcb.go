@@ -83,7 +83,7 @@ func (p *tcbFactory) Start() error {
 	p.xctn.wg.Add(1)
 
 	var sizePDU int32
-	if p.kind == apc.ActETLBck {
+	if p.kind == apc.ActETLBck || p.args.Msg.Samples != nil { // unknown (transformed or filtered) sizes
 		sizePDU = memsys.DefaultBufSize
 	}
 	err = p.newDM(config, p.UUID(), sizePDU)
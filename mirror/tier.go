@@ -0,0 +1,232 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Storage tiering between fast and slow mountpaths of a given target
+// (see cmn.MpathTierConf and cmn.TieringConf).
+//
+// Objects always remain at their respective HRW locations (see fs.Hrw). Promoting
+// a hot object means replicating it to the (least utilized) fast-tier mountpath
+// and recording the replica in the object's metadata (see lom.TierFQN) - GET then
+// reads the replica (see lom.LBGet). Demoting means removing the fast-tier replica.
+
+type (
+	tierFactory struct {
+		xreg.RenewBase
+		xctn *XactTier
+	}
+	// XactTier runs in the background, traverses all (or selected) buckets on all
+	// local mountpaths, and:
+	// - promotes slow-tier objects read at least tiering.hot_hits times and last
+	//   accessed within tiering.cold_time;
+	// - demotes promoted objects not accessed for tiering.cold_time.
+	XactTier struct {
+		xact.BckJog
+		now      int64
+		promoted atomic.Int64
+		demoted  atomic.Int64
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactTier)(nil)
+	_ xreg.Renewable = (*tierFactory)(nil)
+)
+
+/////////////////
+// tierFactory //
+/////////////////
+
+func (*tierFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &tierFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *tierFactory) Start() error {
+	slab, err := p.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	var bcks []cmn.Bck
+	if p.Args.Custom != nil {
+		bcks = p.Args.Custom.([]cmn.Bck)
+	}
+	p.xctn = newTier(p, slab, bcks)
+	return nil
+}
+
+func (*tierFactory) Kind() string        { return apc.ActTiering }
+func (p *tierFactory) Get() cluster.Xact { return p.xctn }
+
+func (*tierFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+//////////////
+// XactTier //
+//////////////
+
+// NOTE: always throttling
+func newTier(p *tierFactory, slab *memsys.Slab, bcks []cmn.Bck) (r *XactTier) {
+	r = &XactTier{}
+	mpopts := &mpather.JgroupOpts{
+		T:        p.T,
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Slab:     slab,
+		Buckets:  bcks, // all buckets when empty
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	r.BckJog.Init(p.UUID(), apc.ActTiering, nil, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactTier) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	r.now = time.Now().UnixNano()
+	r.BckJog.Run()
+	nlog.Infoln(r.Name())
+	err := r.BckJog.Wait()
+	r.AddErr(err)
+	r.Finish()
+	nlog.Infof("%s: promoted %d, demoted %d", r.Name(), r.promoted.Load(), r.demoted.Load())
+}
+
+func (r *XactTier) visitObj(lom *cluster.LOM, buf []byte) error {
+	var (
+		tconf = &r.Config.Tiering
+		tfqn  = lom.TierFQN()
+	)
+	switch {
+	case lom.Mountpath().IsFast():
+		if tfqn == "" {
+			return nil
+		}
+		// e.g., upon resilvering: fast-tier replica is no longer needed
+	case tfqn != "":
+		if !r.isCold(lom) && _isFast(tfqn) {
+			return nil
+		}
+	default:
+		if r.isCold(lom) || lom.Hits() < uint64(tconf.HotHits) {
+			return nil
+		}
+		return r.promote(lom, buf)
+	}
+	return r.demote(lom)
+}
+
+func (r *XactTier) isCold(lom *cluster.LOM) bool {
+	return lom.AtimeUnix()+r.Config.Tiering.ColdTime.D().Nanoseconds() < r.now
+}
+
+// (mountpath may have been relabeled, disabled, or detached)
+func _isFast(fqn string) bool {
+	mi, _, err := fs.FQN2Mpath(fqn)
+	return err == nil && mi.IsFast()
+}
+
+func (r *XactTier) promote(lom *cluster.LOM, buf []byte) (err error) {
+	if !lom.TryLock(true) {
+		return nil // busy - skipping until next time
+	}
+	defer lom.Unlock(true)
+
+	lom.UncacheUnless()
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return r.loadErr(err)
+	}
+	if lom.TierFQN() != "" {
+		return nil
+	}
+	if err = lom.CopyToFastTier(buf); err != nil {
+		return r._err(lom, err)
+	}
+	r.promoted.Inc()
+	r.ObjsAdd(1, lom.SizeBytes())
+	if r.Config.FastV(5, cos.SmoduleMirror) {
+		nlog.Infof("%s: promoted %s => %s", r.Base.Name(), lom, lom.TierFQN())
+	}
+	return nil
+}
+
+func (r *XactTier) demote(lom *cluster.LOM) (err error) {
+	if !lom.TryLock(true) {
+		return nil
+	}
+	defer lom.Unlock(true)
+
+	lom.UncacheUnless()
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return r.loadErr(err)
+	}
+	tfqn := lom.TierFQN()
+	if tfqn == "" {
+		return nil
+	}
+	if err = lom.DelFastTierCopy(); err == nil {
+		err = lom.Persist()
+	}
+	if err != nil {
+		return r._err(lom, err)
+	}
+	r.demoted.Inc()
+	r.ObjsAdd(1, 0)
+	if r.Config.FastV(5, cos.SmoduleMirror) {
+		nlog.Infof("%s: demoted %s (removed %s)", r.Base.Name(), lom, tfqn)
+	}
+	return nil
+}
+
+func (r *XactTier) loadErr(err error) error {
+	if !cmn.IsObjNotExist(err) {
+		r.AddErr(err)
+	}
+	return nil
+}
+
+// same as mncXact: abort upon out-of-space, otherwise keep going
+func (r *XactTier) _err(lom *cluster.LOM, err error) error {
+	if cos.IsErrOOS(err) {
+		r.Abort(err)
+		return err
+	}
+	cs := fs.Cap()
+	if errCap := cs.Err(); errCap != nil {
+		r.Abort(fmt.Errorf("errors: [%w] and [%w]", err, errCap))
+		return err
+	}
+	r.AddErr(fmt.Errorf("%s: %v", lom, err))
+	return nil
+}
+
+func (r *XactTier) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
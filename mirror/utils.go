@@ -20,14 +20,19 @@ func delCopies(lom *cluster.LOM, copies int) (size int64, err error) {
 		return 0, err
 	}
 
+	// (fast-tier replica is not a mirror copy - see tier.go)
+	tfqn := lom.TierFQN()
 	ndel := lom.NumCopies() - copies
+	if tfqn != "" {
+		ndel--
+	}
 	if ndel <= 0 {
 		return
 	}
 
 	copiesFQN := make([]string, 0, ndel)
 	for copyFQN := range lom.GetCopies() {
-		if copyFQN == lom.FQN {
+		if copyFQN == lom.FQN || copyFQN == tfqn {
 			continue
 		}
 		copiesFQN = append(copiesFQN, copyFQN)
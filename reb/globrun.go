@@ -821,6 +821,9 @@ func (rj *rebJogger) _lwalk(lom *cluster.LOM, fqn string) error {
 	if err != nil {
 		return err
 	}
+	if lom.Bck().Props.Repl.Enabled && rj.replicate(lom, tsi) {
+		return cmn.ErrSkip
+	}
 	if tsi.ID() == rj.m.t.SID() {
 		return cmn.ErrSkip
 	}
@@ -848,6 +851,64 @@ func (rj *rebJogger) _lwalk(lom *cluster.LOM, fqn string) error {
 	return nil
 }
 
+// replicated bucket: make sure that each of the object's replica targets has a copy
+// (the main target `tsi` - via ACK-ed migration below, unless this target is not one of them);
+// returns true when the local replica is no longer needed and has been removed
+func (rj *rebJogger) replicate(lom *cluster.LOM, tsi *meta.Snode) (removed bool) {
+	copies := min(lom.Bck().Props.Repl.Copies, rj.smap.CountActiveTs())
+	if copies < 2 {
+		return
+	}
+	sis, err := rj.smap.HrwTargetList(lom.Uname(), copies)
+	if err != nil {
+		nlog.Warningln(rj.xreb.Name(), err)
+		return
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return
+	}
+	var (
+		tid      = rj.m.t.SID()
+		extra    = true
+		complete = true
+	)
+	for _, si := range sis {
+		if si.ID() == tid {
+			extra = false
+			break
+		}
+	}
+	for _, si := range sis {
+		if si.ID() == tid || (si.ID() == tsi.ID() && !extra) {
+			continue
+		}
+		oa, _, err := rj.m.t.HeadObjAttrsT2T(lom, si)
+		if err == nil {
+			// never overwrite (and never remove the local replica in favor of) a different one
+			complete = complete && oa.SameReplica(lom)
+			continue
+		}
+		if err := rj.m.t.PutObjT2T(lom, si); err != nil {
+			rj.xreb.AddErr(err)
+			complete = false
+			continue
+		}
+		rj.xreb.OutObjsAdd(1, lom.SizeBytes())
+	}
+	if !extra || !complete {
+		return
+	}
+	// outside the (new) replica set, and all the replicas are in place
+	lom.Lock(true)
+	err = lom.Remove()
+	lom.Unlock(true)
+	if err != nil {
+		nlog.Warningln(rj.xreb.Name(), "failed to remove extra replica", lom.Cname(), err)
+		return
+	}
+	return true
+}
+
 // takes rlock and keeps it _iff_ successful
 func _getReader(lom *cluster.LOM) (roc cos.ReadOpenCloser, err error) {
 	lom.Lock(false)
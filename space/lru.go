@@ -9,6 +9,7 @@ package space
 import (
 	"container/heap"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -32,7 +33,9 @@ import (
 // config.Space.HighWM (section "space" in the cluster config).
 //
 // When and if exceeded, AIS target will start gradually evicting objects from its
-// stable storage: oldest first access-time wise.
+// stable storage: oldest first access-time wise - or else, in accordance with the
+// bucket's eviction policy (lru.policy, see apc.EvictPolicy). Buckets with higher
+// lru.priority get evicted first. Pinned objects (see apc.ActPinObjects) are never evicted.
 //
 // LRU is implemented as eXtended Action (xaction, see xact/README.md) that gets
 // triggered when/if a used local capacity exceeds high watermark (config.Space.HighWM). LRU then
@@ -68,8 +71,13 @@ type (
 
 // private
 type (
-	// minHeap keeps fileInfo sorted by access time with oldest on top of the heap.
-	minHeap []*cluster.LOM
+	// minHeap keeps eviction candidates sorted by their respective keys (see lruJ.key)
+	// with the first to evict on top of the heap.
+	minHeap []*lruItem
+	lruItem struct {
+		lom *cluster.LOM
+		key float64
+	}
 
 	// parent (contains mpath joggers)
 	lruP struct {
@@ -83,10 +91,11 @@ type (
 	lruJ struct {
 		// runtime
 		curSize   int64
-		totalSize int64 // difference between lowWM size and used size
-		newest    int64
+		totalSize int64   // difference between lowWM size and used size
+		last      float64 // the greatest (last to evict) key in the heap
 		heap      *minHeap
 		bck       cmn.Bck
+		lconf     cmn.LRUConf // bucket's
 		now       int64
 		// init-time
 		p       *lruP
@@ -256,7 +265,7 @@ func (j *lruJ) jogBcks(bcks []cmn.Bck, force bool) (err error) {
 		return
 	}
 	if len(bcks) > 1 {
-		j.sortBsize(bcks)
+		j.sortBcks(bcks)
 	}
 	for _, bck := range bcks { // for each bucket under a given provider
 		var size int64
@@ -289,6 +298,7 @@ func (j *lruJ) jogBck() (size int64, err error) {
 	h := (*j.heap)[:0]
 	j.heap = &h
 	heap.Init(j.heap)
+	j.curSize, j.last = 0, math.Inf(-1)
 
 	// 2. collect
 	opts := &fs.WalkOpts{
@@ -327,22 +337,52 @@ func (j *lruJ) _visit(lom *cluster.LOM) (pushed bool) {
 	if lom.AtimeUnix()+int64(j.config.LRU.DontEvictTime) > j.now {
 		return
 	}
+	if lom.IsPinned() || lom.CheckLocked(false) != nil {
+		return
+	}
 	if lom.HasCopies() && lom.IsCopy() {
 		return
 	}
+	key := j.key(lom)
 	// do nothing if the heap's curSize >= totalSize and
-	// the file is more recent then the the heap's newest.
-	if j.curSize >= j.totalSize && lom.AtimeUnix() > j.newest {
+	// the object is to be evicted later than the heap's last.
+	if j.curSize >= j.totalSize && key > j.last {
 		return
 	}
-	heap.Push(j.heap, lom)
+	heap.Push(j.heap, &lruItem{lom: lom, key: key})
 	j.curSize += lom.SizeBytes()
-	if lom.AtimeUnix() > j.newest {
-		j.newest = lom.AtimeUnix()
+	if key > j.last {
+		j.last = key
 	}
 	return true
 }
 
+// eviction key: the smaller the sooner - by access time (default), access frequency,
+// access time weighted by size, or (with TTL) time of cold GET
+func (j *lruJ) key(lom *cluster.LOM) float64 {
+	switch j.lconf.Policy {
+	case apc.EvictLFU:
+		return float64(lom.Hits())
+	case apc.EvictSize:
+		atime := lom.AtimeUnix()
+		if atime < 0 {
+			atime = -atime // prefetched but not yet accessed
+		}
+		idle := max(j.now-atime, 0)
+		return -float64(idle) * float64(lom.SizeBytes())
+	case apc.EvictTTL:
+		// expired objects first (oldest cold GET first), followed by all the rest in
+		// the order of their access times (including objects with no recorded cold GET);
+		// the offset places the former ahead of any (possibly negative) atime
+		if cold := lom.ColdGetUnix(); cold != 0 && cold+int64(j.lconf.TTL) <= j.now {
+			return float64(cold) - 2*float64(j.now)
+		}
+		return float64(lom.AtimeUnix())
+	default:
+		return float64(lom.AtimeUnix())
+	}
+}
+
 func (j *lruJ) walk(fqn string, de fs.DirEntry) error {
 	if de.IsDir() {
 		return nil
@@ -371,7 +411,7 @@ func (j *lruJ) evict() (size int64, err error) {
 
 	// evict(sic!) and house-keep
 	for h.Len() > 0 && j.totalSize > 0 {
-		lom := heap.Pop(h).(*cluster.LOM)
+		lom := heap.Pop(h).(*lruItem).lom
 		if !j.evictObj(lom) {
 			cluster.FreeLOM(lom)
 			continue
@@ -481,18 +521,28 @@ func (j *lruJ) yieldTerm() error {
 	return nil
 }
 
-// sort buckets by size
-func (j *lruJ) sortBsize(bcks []cmn.Bck) {
-	sized := make([]struct {
-		b cmn.Bck
-		v uint64
-	}, len(bcks))
+// sort buckets by eviction priority and then by size
+func (j *lruJ) sortBcks(bcks []cmn.Bck) {
+	var (
+		bowner = j.ini.T.Bowner()
+		sized  = make([]struct {
+			b cmn.Bck
+			v uint64
+			p int
+		}, len(bcks))
+	)
 	for i := range bcks {
 		path := j.mi.MakePathCT(&bcks[i], fs.ObjectType)
 		sized[i].b = bcks[i]
 		sized[i].v, _ = ios.DirSizeOnDisk(path, false /*withNonDirPrefix*/)
+		if b := meta.CloneBck(&bcks[i]); b.Init(bowner) == nil {
+			sized[i].p = b.Props.LRU.Priority
+		}
 	}
 	sort.Slice(sized, func(i, j int) bool {
+		if sized[i].p != sized[j].p {
+			return sized[i].p > sized[j].p
+		}
 		return sized[i].v > sized[j].v
 	})
 	for i := range bcks {
@@ -509,6 +559,7 @@ func (j *lruJ) allow() (ok bool, err error) {
 		return
 	}
 	ok = b.Props.LRU.Enabled && b.Allow(apc.AceObjDELETE) == nil
+	j.lconf = b.Props.LRU
 	return
 }
 
@@ -516,10 +567,16 @@ func (j *lruJ) allow() (ok bool, err error) {
 // min-heap //
 //////////////
 
-func (h minHeap) Len() int           { return len(h) }
-func (h minHeap) Less(i, j int) bool { return h[i].Atime().Before(h[j].Atime()) }
-func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
-func (h *minHeap) Push(x any)        { *h = append(*h, x.(*cluster.LOM)) }
+func (h minHeap) Len() int      { return len(h) }
+func (h minHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)   { *h = append(*h, x.(*lruItem)) }
+
+func (h minHeap) Less(i, j int) bool {
+	if h[i].key != h[j].key {
+		return h[i].key < h[j].key
+	}
+	return h[i].lom.AtimeUnix() < h[j].lom.AtimeUnix()
+}
 func (h *minHeap) Pop() any {
 	old := *h
 	n := len(old)
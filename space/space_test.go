@@ -135,6 +135,35 @@ var _ = Describe("space evict/cleanup tests", func() {
 				}
 			})
 
+			It("should not evict pinned files", func() {
+				const numberOfFiles = 6
+
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+
+				pinnedFiles := []fileMetadata{
+					{getRandomFileName(3), fileSize},
+					{getRandomFileName(4), fileSize},
+					{getRandomFileName(5), fileSize},
+				}
+				saveRandomFilesWithMetadata(filesPath, pinnedFiles)
+				for _, file := range pinnedFiles {
+					setPinned(path.Join(filesPath, file.name))
+				}
+				time.Sleep(1 * time.Second)
+				saveRandomFiles(filesPath, 3)
+
+				space.RunLRU(ini)
+
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(3))
+
+				pinnedNames := namesFromFilesMetadatas(pinnedFiles)
+				for _, name := range files {
+					Expect(cos.StringInSlice(name.Name(), pinnedNames)).To(BeTrue())
+				}
+			})
+
 			It("should evict files of different sizes", func() {
 				const totalSize = 32 * cos.MiB
 				if testing.Short() {
@@ -201,6 +230,133 @@ var _ = Describe("space evict/cleanup tests", func() {
 			})
 		})
 
+		Describe("eviction policies", func() {
+			const numberOfFiles = 6
+			var (
+				ini    *space.IniLRU
+				bprops *cmn.Bprops
+			)
+			BeforeEach(func() {
+				ini = newIniLRU(t)
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+				bprops, _ = t.Bowner().Get().Get(meta.NewBck(bucketName, apc.AIS, cmn.NsGlobal))
+			})
+
+			It("should evict least frequently used files", func() {
+				bprops.LRU.Policy = apc.EvictLFU
+
+				// older but frequently accessed
+				hotFiles := []fileMetadata{
+					{getRandomFileName(0), fileSize},
+					{getRandomFileName(1), fileSize},
+					{getRandomFileName(2), fileSize},
+				}
+				saveRandomFilesWithMetadata(filesPath, hotFiles)
+				for _, file := range hotFiles {
+					setHits(path.Join(filesPath, file.name), 5)
+				}
+				time.Sleep(1 * time.Second)
+				saveRandomFiles(filesPath, 3)
+
+				space.RunLRU(ini)
+
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(3))
+				hotNames := namesFromFilesMetadatas(hotFiles)
+				for _, name := range files {
+					Expect(cos.StringInSlice(name.Name(), hotNames)).To(BeTrue())
+				}
+			})
+
+			It("should evict files that were cold-GET more than TTL ago", func() {
+				bprops.LRU.Policy = apc.EvictTTL
+				bprops.LRU.TTL = cos.Duration(time.Hour)
+
+				saveRandomFiles(filesPath, 3)
+				time.Sleep(1 * time.Second)
+
+				// more recently accessed but expired
+				expired := []fileMetadata{
+					{getRandomFileName(3), fileSize},
+					{getRandomFileName(4), fileSize},
+					{getRandomFileName(5), fileSize},
+				}
+				saveRandomFilesWithMetadata(filesPath, expired)
+				for _, file := range expired {
+					setColdGet(path.Join(filesPath, file.name), time.Now().Add(-2*time.Hour))
+				}
+				// mtime does not matter (e.g., reset by mirroring or resilvering)
+				for _, file := range expired {
+					Expect(os.Chtimes(path.Join(filesPath, file.name), time.Now(), time.Now())).NotTo(HaveOccurred())
+				}
+
+				space.RunLRU(ini)
+
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(3))
+				expiredNames := namesFromFilesMetadatas(expired)
+				for _, name := range files {
+					Expect(cos.StringInSlice(name.Name(), expiredNames)).To(BeFalse())
+				}
+			})
+
+			It("should evict least recently used files when none expired", func() {
+				bprops.LRU.Policy = apc.EvictTTL
+				bprops.LRU.TTL = cos.Duration(time.Hour)
+
+				oldFiles := []fileMetadata{
+					{getRandomFileName(0), fileSize},
+					{getRandomFileName(1), fileSize},
+					{getRandomFileName(2), fileSize},
+				}
+				saveRandomFilesWithMetadata(filesPath, oldFiles)
+				time.Sleep(1 * time.Second)
+				saveRandomFiles(filesPath, 3)
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				for _, file := range files {
+					setColdGet(path.Join(filesPath, file.Name()), time.Now())
+				}
+
+				space.RunLRU(ini)
+
+				files, err = os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(3))
+				oldNames := namesFromFilesMetadatas(oldFiles)
+				for _, name := range files {
+					Expect(cos.StringInSlice(name.Name(), oldNames)).To(BeFalse())
+				}
+			})
+
+			It("should evict buckets with higher priority first", func() {
+				another, _ := t.Bowner().Get().Get(meta.NewBck(bucketNameAnother, apc.AIS, cmn.NsGlobal))
+				another.LRU.Priority = 1
+
+				saveRandomFiles(filesPath, numberOfFiles/2)
+				time.Sleep(1 * time.Second)
+				saveRandomFiles(fpAnother, numberOfFiles/2)
+
+				// capacity usage that reflects evictions
+				ini.GetFSStats = getFSStatsFromDirs(numberOfFiles, filesPath, fpAnother)
+				ini.Buckets = []cmn.Bck{
+					{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal},
+					bckAnother,
+				}
+				ini.Force = true
+				space.RunLRU(ini)
+
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(numberOfFiles / 2))
+				filesAnother, err := os.ReadDir(fpAnother)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(filesAnother)).To(BeZero())
+			})
+		})
+
 		Describe("not evict files", func() {
 			var ini *space.IniLRU
 			BeforeEach(func() {
@@ -314,6 +470,21 @@ func getMockGetFSStats(currentFilesNum int) func(string) (uint64, uint64, int64,
 	}
 }
 
+// same as above but computing usage from the (remaining) number of files
+func getFSStatsFromDirs(initialFilesNum int, dirs ...string) func(string) (uint64, uint64, int64, error) {
+	blocks := uint64(float64(initialFilesNum*fileSize/blockSize) / initialDiskUsagePct)
+	return func(string) (uint64, uint64, int64, error) {
+		var num int
+		for _, dir := range dirs {
+			files, err := os.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			num += len(files)
+		}
+		btaken := uint64(num * fileSize / blockSize)
+		return blocks, blocks - btaken, blockSize, nil
+	}
+}
+
 func newTargetLRUMock() *mock.TargetMock {
 	// Bucket owner mock, required for LOM
 	var (
@@ -402,6 +573,35 @@ func saveRandomFile(filename string, size int64) {
 	Expect(lom.Persist()).NotTo(HaveOccurred())
 }
 
+func setHits(filename string, hits int) {
+	lom := &cluster.LOM{}
+	err := lom.InitFQN(filename, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+	for i := 0; i < hits; i++ {
+		lom.IncHits()
+	}
+	Expect(lom.Persist()).NotTo(HaveOccurred())
+}
+
+func setColdGet(filename string, tm time.Time) {
+	lom := &cluster.LOM{}
+	err := lom.InitFQN(filename, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+	lom.SetColdGetUnix(tm.UnixNano())
+	Expect(lom.Persist()).NotTo(HaveOccurred())
+}
+
+func setPinned(filename string) {
+	lom := &cluster.LOM{}
+	err := lom.InitFQN(filename, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+	lom.SetPinned(true)
+	Expect(lom.Persist()).NotTo(HaveOccurred())
+}
+
 func saveRandomFilesWithMetadata(filesPath string, files []fileMetadata) {
 	for _, file := range files {
 		saveRandomFile(path.Join(filesPath, file.name), file.size)
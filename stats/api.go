@@ -73,6 +73,7 @@ type (
 		K8sPodName     string         `json:"k8s_pod_name"` // (via ais-k8s/operator `MY_POD` env var)
 		MemCPUInfo     apc.MemCPUInfo `json:"sys_info"`
 		SmapVersion    int64          `json:"smap_version,string"`
+		CertExpires    int64          `json:"tls_cert_expires,omitempty"` // HTTPS only: certificate's NotAfter (unix nano)
 	}
 )
 
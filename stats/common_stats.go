@@ -69,6 +69,8 @@ const (
 	ErrHTTPWriteCount = errPrefix + "http.write.n"
 	ErrDownloadCount  = errPrefix + "dl.n"
 	ErrPutMirrorCount = errPrefix + "put.mirror.n"
+	ErrPutReplCount   = errPrefix + "put.repl.n"
+	ErrDelReplCount   = errPrefix + "del.repl.n"
 
 	// KindLatency
 	GetLatency       = "get.ns"
@@ -77,6 +79,18 @@ const (
 
 	// KindSpecial
 	Uptime = "up.ns.time"
+
+	// KindGauge
+	CertExpires = "tls.cert.expires" // HTTPS only: seconds until the node's TLS certificate expires
+
+	// intra-cluster mTLS (see config.net.intra_tls); handshakes and bytes are counted
+	// on the dialing (client) side of each intra-cluster connection
+	IntraTLSHandshakeCount    = "tls.intra.handshake.n"
+	ErrIntraTLSHandshakeCount = errPrefix + "tls.intra.handshake.n"
+	IntraTLSHandshakeLatency  = "tls.intra.handshake.ns"
+	IntraTLSSentSize          = "tls.intra.out.size"
+	IntraTLSRecvSize          = "tls.intra.in.size"
+	IntraCertExpires          = "tls.intra.cert.expires" // seconds until the node's (intra-cluster) certificate expires
 )
 
 // interfaces
@@ -150,7 +164,7 @@ var (
 )
 
 // sample name ais.ip-10-0-2-19.root.log.INFO.20180404-031540.2249
-var logtypes = []string{".INFO.", ".WARNING.", ".ERROR."}
+var logtypes = []string{".INFO.", ".WARNING.", ".ERROR.", ".AUDIT."}
 
 var ignoreIdle = []string{"kalive", Uptime, "disk."}
 
@@ -320,6 +334,8 @@ func (s *coreStats) update(nv cos.NamedVal64) {
 	case KindThroughput:
 		ratomic.AddInt64(&v.Value, nv.Value)
 		ratomic.AddInt64(&v.cumulative, nv.Value)
+	case KindGauge:
+		ratomic.StoreInt64(&v.Value, nv.Value) // (gauges are set, not added)
 	case KindCounter, KindSize:
 		ratomic.AddInt64(&v.Value, nv.Value)
 		// - non-empty suffix forces an immediate Tx with no aggregation (see below);
@@ -610,6 +626,8 @@ func (r *runner) regCommon(node *meta.Snode) {
 	r.reg(node, ErrHTTPWriteCount, KindCounter)
 	r.reg(node, ErrDownloadCount, KindCounter)
 	r.reg(node, ErrPutMirrorCount, KindCounter)
+	r.reg(node, ErrPutReplCount, KindCounter)
+	r.reg(node, ErrDelReplCount, KindCounter)
 
 	// latency
 	r.reg(node, GetLatency, KindLatency)
@@ -618,6 +636,20 @@ func (r *runner) regCommon(node *meta.Snode) {
 
 	// special uptime
 	r.reg(node, Uptime, KindSpecial)
+
+	// TLS certificate
+	config := cmn.GCO.Get()
+	if config.Net.HTTP.UseHTTPS {
+		r.reg(node, CertExpires, KindGauge)
+	}
+	if config.Net.IntraTLS.Enabled {
+		r.reg(node, IntraTLSHandshakeCount, KindCounter)
+		r.reg(node, ErrIntraTLSHandshakeCount, KindCounter)
+		r.reg(node, IntraTLSHandshakeLatency, KindLatency)
+		r.reg(node, IntraTLSSentSize, KindSize)
+		r.reg(node, IntraTLSRecvSize, KindSize)
+		r.reg(node, IntraCertExpires, KindGauge)
+	}
 }
 
 // NOTE naming convention: ".n" for the count and ".ns" for duration (nanoseconds)
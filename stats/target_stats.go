@@ -47,6 +47,11 @@ const (
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
 
+	// readahead (see bucket property 'readahead'): sequential GETs that were (hit)
+	// or were not (miss) served from objects already cached in the cluster
+	ReadaheadHitCount  = "readahead.hit.n"
+	ReadaheadMissCount = "readahead.miss.n"
+
 	// intra-cluster transmit & receive
 	StreamsOutObjCount = transport.OutObjCount
 	StreamsOutObjSize  = transport.OutObjSize
@@ -204,6 +209,9 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(node, VerChangeCount, KindCounter)
 	r.reg(node, VerChangeSize, KindSize)
 
+	r.reg(node, ReadaheadHitCount, KindCounter)
+	r.reg(node, ReadaheadMissCount, KindCounter)
+
 	r.reg(node, PutLatency, KindLatency)
 	r.reg(node, AppendLatency, KindLatency)
 	r.reg(node, GetRedirLatency, KindLatency)
@@ -52,6 +52,7 @@ const (
 type (
 	streamer interface {
 		compressed() bool
+		compression() (algo string, adaptive bool)
 		dryrun()
 		terminate(error, string) (string, error)
 		doRequest() error
@@ -205,6 +206,7 @@ func (s *streamBase) GetStats() (stats Stats) {
 	stats.Offset.Store(s.stats.Offset.Load())
 	stats.Size.Store(s.stats.Size.Load())
 	stats.CompressedSize.Store(s.stats.CompressedSize.Load())
+	stats.RawNum.Store(s.stats.RawNum.Load())
 	return
 }
 
@@ -79,7 +79,7 @@ func NewDataMover(t cluster.Target, trname string, recvCB transport.RecvObj, owt
 	switch extra.Compression {
 	case "":
 		dm.compression = apc.CompressNever
-	case apc.CompressAlways, apc.CompressNever:
+	case apc.CompressAlways, apc.CompressNever, apc.CompressAdaptive:
 		dm.compression = extra.Compression
 	default:
 		return nil, fmt.Errorf("invalid compression %q", extra.Compression)
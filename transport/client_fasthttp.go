@@ -8,6 +8,7 @@
 package transport
 
 import (
+	"context"
 	"io"
 	"net"
 	"net/http"
@@ -51,7 +52,18 @@ func NewIntraDataClient() Client {
 		ReadBufferSize:  rbuf,
 		WriteBufferSize: wbuf,
 	}
-	if config.Net.HTTP.UseHTTPS {
+	if g.dialTLS != nil {
+		// NOTE: fasthttp won't handshake again (the returned conn is TLS already)
+		dial := g.dialTLS(func(ctx context.Context, network, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		})
+		cl.Dial = func(addr string) (net.Conn, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return dial(ctx, "tcp", addr)
+		}
+	} else if config.Net.HTTP.UseHTTPS {
 		tlsConfig, err := cmn.NewTLS(config.Net.HTTP.ToTLS())
 		if err != nil {
 			cos.ExitLog(err)
@@ -68,7 +80,11 @@ func (s *streamBase) do(body io.Reader) (err error) {
 	req.SetRequestURI(s.dstURL)
 	req.SetBodyStream(body, -1)
 	if s.streamer.compressed() {
-		req.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+		algo, adaptive := s.streamer.compression()
+		req.Header.Set(apc.HdrCompress, algo)
+		if adaptive {
+			req.Header.Set(apc.HdrCompressMode, apc.CompressAdaptive)
+		}
 	}
 	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
 	req.Header.Set(cos.HdrUserAgent, ua)
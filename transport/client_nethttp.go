@@ -47,9 +47,14 @@ func NewIntraDataClient() (client *http.Client) {
 		WriteBufferSize: wbuf,
 		ReadBufferSize:  rbuf,
 	}
-	if config.Net.HTTP.UseHTTPS {
+	switch {
+	case g.dialTLS != nil:
+		transport := cmn.NewTransport(cargs)
+		transport.DialTLSContext = g.dialTLS(transport.DialContext)
+		client = &http.Client{Transport: transport}
+	case config.Net.HTTP.UseHTTPS:
 		client = cmn.NewClientTLS(cargs, config.Net.HTTP.ToTLS())
-	} else {
+	default:
 		client = cmn.NewClient(cargs)
 	}
 	return
@@ -64,7 +69,11 @@ func (s *streamBase) do(body io.Reader) (err error) {
 		return
 	}
 	if s.streamer.compressed() {
-		request.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+		algo, adaptive := s.streamer.compression()
+		request.Header.Set(apc.HdrCompress, algo)
+		if adaptive {
+			request.Header.Set(apc.HdrCompressMode, apc.CompressAdaptive)
+		}
 	}
 	request.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
 	request.Header.Set(cos.HdrUserAgent, ua)
@@ -0,0 +1,214 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v3"
+)
+
+// Stream compression: lz4 (default) or zstd (config.transport.compressor).
+//
+// With apc.CompressAlways the entire request body is a single compressed stream.
+// With apc.CompressAdaptive the body is a sequence of chunks, each prefixed with
+// (chunk type, length): either a complete compressed frame, or raw bytes - data of
+// the objects deemed incompressible, by their extensions or by sampling (entropy of)
+// the first data read. Object headers always get compressed.
+
+// adaptive chunk types and header
+const (
+	chunkCompressed = byte(iota + 1)
+	chunkRaw
+
+	sizeofChunkHdr = 5
+	maxFrameSize   = cos.MiB // max compressed frame (and chunk) size
+)
+
+const (
+	minEntropySample = 512
+	maxEntropy       = 7.2 // bits per byte; higher means (likely) incompressible
+)
+
+// known-compressed (lowercase) extensions
+var incompressibleExts = map[string]struct{}{
+	".7z": {}, ".avi": {}, ".br": {}, ".bz2": {}, ".flac": {}, ".gif": {}, ".gz": {}, ".heic": {},
+	".jpeg": {}, ".jpg": {}, ".lz4": {}, ".m4a": {}, ".mkv": {}, ".mov": {}, ".mp3": {}, ".mp4": {},
+	".ogg": {}, ".png": {}, ".rar": {}, ".tgz": {}, ".txz": {}, ".webm": {}, ".webp": {}, ".xz": {},
+	".zip": {}, ".zst": {},
+}
+
+type (
+	// compressing writer: lz4.Writer or zstd.Encoder
+	zwriter interface {
+		io.WriteCloser
+		Flush() error
+		Reset(w io.Writer)
+	}
+	lz4Writer struct {
+		*lz4.Writer
+		blockMaxSize  int
+		frameChecksum bool
+	}
+	// decompressing reader: lz4.Reader or zstd.Decoder
+	zreader interface {
+		io.Reader
+		Reset(r io.Reader) error
+	}
+	lz4Reader struct {
+		*lz4.Reader
+	}
+	// Rx: adaptive (apc.CompressAdaptive) body => object headers and data
+	chunkReader struct {
+		body io.Reader
+		zr   zreader
+		cur  io.Reader // current chunk: zr or &lr
+		lr   io.LimitedReader
+		hdr  [sizeofChunkHdr]byte
+	}
+)
+
+// interface guard
+var (
+	_ zwriter = (*lz4Writer)(nil)
+	_ zwriter = (*zstd.Encoder)(nil)
+	_ zreader = (*lz4Reader)(nil)
+	_ zreader = (*zstd.Decoder)(nil)
+)
+
+func compressor(config *cmn.Config) string {
+	if config.Transport.Compressor == "" {
+		return apc.LZ4Compression
+	}
+	return config.Transport.Compressor
+}
+
+func newZwriter(algo string, config *cmn.Config) zwriter {
+	if algo == apc.ZstdCompression {
+		zw, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+		debug.AssertNoErr(err) // (valid options)
+		return zw
+	}
+	return &lz4Writer{
+		Writer:        lz4.NewWriter(nil),
+		blockMaxSize:  int(config.Transport.LZ4BlockMaxSize),
+		frameChecksum: config.Transport.LZ4FrameChecksum,
+	}
+}
+
+func newZreader(algo string) (zreader, error) {
+	switch algo {
+	case apc.LZ4Compression:
+		return &lz4Reader{lz4.NewReader(nil)}, nil
+	case apc.ZstdCompression:
+		return zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", algo)
+	}
+}
+
+func closeZreader(zr zreader) {
+	if dec, ok := zr.(*zstd.Decoder); ok {
+		dec.Close()
+	} else {
+		zr.Reset(nil)
+	}
+}
+
+// lz4 framing spec at http://fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
+func (zw *lz4Writer) Reset(w io.Writer) {
+	zw.Writer.Reset(w)
+	zw.Header.BlockChecksum = false
+	zw.Header.NoChecksum = !zw.frameChecksum
+	zw.Header.BlockMaxSize = zw.blockMaxSize
+}
+
+func (zr *lz4Reader) Reset(r io.Reader) error {
+	zr.Reader.Reset(r)
+	return nil
+}
+
+// by extension or else by sampling (NOTE: the decision is made once per object - see cmprStream)
+func incompressible(oname string, sample []byte) bool {
+	ext := strings.ToLower(filepath.Ext(oname))
+	if _, ok := incompressibleExts[ext]; ok {
+		return true
+	}
+	if len(sample) < minEntropySample {
+		return false
+	}
+	return entropy(sample) > maxEntropy
+}
+
+// Shannon entropy in bits per byte
+func entropy(b []byte) (h float64) {
+	var counts [256]int
+	for _, c := range b {
+		counts[c]++
+	}
+	total := float64(len(b))
+	for _, cnt := range counts {
+		if cnt == 0 {
+			continue
+		}
+		p := float64(cnt) / total
+		h -= p * math.Log2(p)
+	}
+	return
+}
+
+func putChunkHdr(hdr []byte, typ byte, size int) {
+	hdr[0] = typ
+	binary.BigEndian.PutUint32(hdr[1:], uint32(size))
+}
+
+/////////////////
+// chunkReader //
+/////////////////
+
+func (cr *chunkReader) Read(b []byte) (n int, err error) {
+	for {
+		if cr.cur != nil {
+			n, err = cr.cur.Read(b)
+			if err != io.EOF {
+				return
+			}
+			if cr.lr.N > 0 {
+				return n, fmt.Errorf("premature end of compressed chunk (%d bytes remaining)", cr.lr.N)
+			}
+			cr.cur, err = nil, nil
+			if n > 0 {
+				return
+			}
+		}
+		// next chunk
+		if _, err = io.ReadFull(cr.body, cr.hdr[:]); err != nil {
+			return 0, err // io.EOF at the chunk boundary is the end of the body
+		}
+		cr.lr = io.LimitedReader{R: cr.body, N: int64(binary.BigEndian.Uint32(cr.hdr[1:]))}
+		switch cr.hdr[0] {
+		case chunkRaw:
+			cr.cur = &cr.lr
+		case chunkCompressed:
+			if err = cr.zr.Reset(&cr.lr); err != nil {
+				return 0, err
+			}
+			cr.cur = cr.zr
+		default:
+			return 0, fmt.Errorf("invalid chunk type %d", cr.hdr[0])
+		}
+	}
+}
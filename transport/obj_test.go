@@ -14,6 +14,7 @@ package transport_test
 // go test -v -run=Multi -tags=debug
 
 import (
+	"bytes"
 	"encoding/binary"
 	"flag"
 	"fmt"
@@ -517,6 +518,90 @@ func TestCompressedOne(t *testing.T) {
 	printNetworkStats()
 }
 
+// lz4 and zstd, always and adaptive: compressible and incompressible objects
+// (including known-compressed extensions) must be received intact
+func TestCompressionModes(t *testing.T) {
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	for _, algo := range apc.SupportedCompressors {
+		for _, mode := range []string{apc.CompressAlways, apc.CompressAdaptive} {
+			t.Run(algo+"/"+mode, func(t *testing.T) { testCompressionMode(t, ts.URL, algo, mode) })
+		}
+	}
+}
+
+func testCompressionMode(t *testing.T, url, algo, mode string) {
+	var (
+		trname   = "cmpr-" + algo + "-" + mode
+		objs     = make(map[string][]byte, 64)
+		received atomic.Int64
+		random   = newRand(mono.NanoTime())
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.Transport.LZ4BlockMaxSize = 256 * cos.KiB
+	config.Transport.Compressor = algo
+	cmn.GCO.CommitUpdate(config)
+	tassert.CheckFatal(t, config.Transport.Validate())
+
+	for i := 0; i < 64; i++ {
+		var (
+			oname = "obj-" + strconv.Itoa(i)
+			size  = random.Intn(cos.MiB) + 1
+			b     = make([]byte, size)
+		)
+		switch i % 4 {
+		case 0, 1:
+			for off := 0; off < size; off += copy(b[off:], text) {
+			}
+		case 2:
+			random.Read(b)
+		case 3:
+			random.Read(b)
+			oname += ".jpg"
+		}
+		if i%9 == 0 {
+			b = nil // header-only
+		}
+		objs[oname] = b
+	}
+	recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		b, err := io.ReadAll(objReader)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, string(b) == string(objs[hdr.ObjName]), "%s: content mismatch", hdr.ObjName)
+		received.Inc()
+		return nil
+	}
+	err := transport.Handle(trname, recv)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	stream := transport.NewObjStream(transport.NewIntraDataClient(), url+transport.ObjURLPath(trname), cos.GenTie(),
+		&transport.Extra{Compression: mode})
+	for oname, b := range objs {
+		hdr := transport.ObjHdr{ObjName: oname}
+		hdr.ObjAttrs.Size = int64(len(b))
+		obj := &transport.Obj{Hdr: hdr}
+		if len(b) > 0 {
+			obj.Reader = io.NopCloser(bytes.NewReader(b))
+		}
+		stream.Send(obj)
+	}
+	stream.Fin()
+
+	stats := stream.GetStats()
+	tassert.Errorf(t, received.Load() == int64(len(objs)), "received %d, expected %d", received.Load(), len(objs))
+	tassert.Errorf(t, stats.CompressionRatio() > 1, "expecting compression ratio > 1, got %.2f", stats.CompressionRatio())
+	if mode == apc.CompressAdaptive {
+		tassert.Errorf(t, stats.RawNum.Load() > 0, "expecting incompressible objects to be sent uncompressed")
+	} else {
+		tassert.Errorf(t, stats.RawNum.Load() == 0, "not expecting uncompressed objects (%d)", stats.RawNum.Load())
+	}
+	tlog.Logf("%s: num=%d, raw=%d, compression-ratio=%.2f\n", stream, stats.Num.Load(), stats.RawNum.Load(),
+		stats.CompressionRatio())
+}
+
 func TestDryRun(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
@@ -893,3 +978,50 @@ func (rrc *randReaderCtx) sentCallback(hdr *transport.ObjHdr, _ io.ReadCloser, _
 	rrc.posted[rrc.idx] = nil
 	rrc.mu.Unlock()
 }
+
+// adaptive compression: a (compressible) object must be delivered without waiting
+// for the next one to arrive (or for the stream to terminate)
+func TestCompressAdaptiveBoundary(t *testing.T) {
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	var (
+		trname = "cmpr-adaptive-boundary"
+		rch    = make(chan string, 4)
+		b      = make([]byte, 64*cos.KiB)
+	)
+	for off := 0; off < len(b); off += copy(b[off:], text) {
+	}
+	recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		_, err = io.Copy(io.Discard, objReader)
+		tassert.CheckFatal(t, err)
+		rch <- hdr.ObjName
+		return nil
+	}
+	err := transport.Handle(trname, recv)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	stream := transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname), cos.GenTie(),
+		&transport.Extra{Compression: apc.CompressAdaptive})
+	for i := 0; i < 3; i++ {
+		oname := "obj-" + strconv.Itoa(i)
+		hdr := transport.ObjHdr{ObjName: oname}
+		if i != 1 {
+			hdr.ObjAttrs.Size = int64(len(b))
+		}
+		obj := &transport.Obj{Hdr: hdr}
+		if hdr.ObjAttrs.Size > 0 {
+			obj.Reader = io.NopCloser(bytes.NewReader(b))
+		}
+		stream.Send(obj)
+		select {
+		case name := <-rch:
+			tassert.Errorf(t, name == oname, "received %q, expected %q", name, oname)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("%s: timed out waiting for %q", stream, oname)
+		}
+	}
+	stream.Fin()
+}
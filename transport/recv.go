@@ -26,7 +26,6 @@ import (
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/OneOfOne/xxhash"
-	"github.com/pierrec/lz4/v3"
 )
 
 const sessionIsOld = time.Hour
@@ -86,10 +85,10 @@ var (
 // main Rx objects
 func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	var (
-		reader    io.Reader = r.Body
-		lz4Reader *lz4.Reader
-		trname    = path.Base(r.URL.Path)
-		mm        = memsys.PageMM()
+		reader io.Reader = r.Body
+		zr     zreader
+		trname = path.Base(r.URL.Path)
+		mm     = memsys.PageMM()
 	)
 	// Rx handler
 	h, err := oget(trname)
@@ -112,10 +111,20 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	// compression
-	if compressionType := r.Header.Get(apc.HdrCompress); compressionType != "" {
-		debug.Assert(compressionType == apc.LZ4Compression)
-		lz4Reader = lz4.NewReader(r.Body)
-		reader = lz4Reader
+	if algo := r.Header.Get(apc.HdrCompress); algo != "" {
+		if zr, err = newZreader(algo); err != nil {
+			cmn.WriteErr(w, r, err)
+			return
+		}
+		if r.Header.Get(apc.HdrCompressMode) == apc.CompressAdaptive {
+			reader = &chunkReader{body: r.Body, zr: zr}
+		} else {
+			if err = zr.Reset(r.Body); err != nil {
+				cmn.WriteErr(w, r, err)
+				return
+			}
+			reader = zr
+		}
 	}
 
 	stats, uid, loghdr := h.stats(r, trname)
@@ -126,8 +135,8 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	err = it.rxloop(uid, loghdr, mm)
 
 	// cleanup
-	if lz4Reader != nil {
-		lz4Reader.Reset(nil)
+	if zr != nil {
+		closeZreader(zr)
 	}
 	if it.pdu != nil {
 		it.pdu.free(mm)
@@ -10,12 +10,12 @@ import (
 	"io"
 	"runtime"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/memsys"
-	"github.com/pierrec/lz4/v3"
 )
 
 // object stream & private types
@@ -25,15 +25,26 @@ type (
 		cmplCh   chan cmpl // aka SCQ; note that SQ and SCQ together form a FIFO
 		callback ObjSentCB // to free SGLs, close files, etc.
 		sendoff  sendoff
-		lz4s     lz4Stream
+		cmpr     cmprStream
 		streamBase
 	}
-	lz4Stream struct {
-		s             *Stream
-		zw            *lz4.Writer // orig reader => zw
-		sgl           *memsys.SGL // zw => bb => network
-		blockMaxSize  int         // *uncompressed* block max size
-		frameChecksum bool        // true: checksum lz4 frames
+	cmprStream struct {
+		s        *Stream
+		zw       zwriter     // orig reader => zw
+		sgl      *memsys.SGL // zw => bb => network
+		algo     string      // apc.LZ4Compression, etc.
+		adaptive *adaptive   // apc.CompressAdaptive
+	}
+	// adaptive compression: compressed frames and raw (incompressible) data, each prefixed
+	// with chunk header (see compress.go)
+	adaptive struct {
+		cmpr  *cmprStream
+		out   *memsys.SGL // chunks => network
+		buf   []byte      // orig reader => buf => (zw => sgl) or out
+		frame bool        // compressed frame in progress
+		raw   bool        // current object is sent uncompressed
+		eob   bool        // end of object: close the frame in progress (see eoObj)
+		eof   bool
 	}
 	sendoff struct {
 		obj Obj
@@ -76,32 +87,55 @@ func (s *Stream) terminate(err error, reason string) (actReason string, actErr e
 	gc.remove(&s.streamBase)
 
 	if s.compressed() {
-		s.lz4s.sgl.Free()
-		if s.lz4s.zw != nil {
-			s.lz4s.zw.Reset(nil)
+		s.cmpr.sgl.Free()
+		s.cmpr.zw.Reset(nil)
+		if a := s.cmpr.adaptive; a != nil {
+			a.out.Free()
+			g.mm.Free(a.buf)
+		}
+		if verbose {
+			nlog.Infof("%s: compression ratio %.2f", s, s.stats.CompressionRatio())
 		}
 	}
 	return
 }
 
 func (s *Stream) initCompression(extra *Extra) {
-	s.lz4s.s = s
-	s.lz4s.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
-	s.lz4s.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
-	if s.lz4s.blockMaxSize >= memsys.MaxPageSlabSize {
-		s.lz4s.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
+	var (
+		blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
+		cmpr         = &s.cmpr
+	)
+	cmpr.s = s
+	cmpr.algo = compressor(extra.Config)
+	cmpr.zw = newZwriter(cmpr.algo, extra.Config)
+	if blockMaxSize >= memsys.MaxPageSlabSize {
+		cmpr.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
+	} else {
+		cmpr.sgl = g.mm.NewSGL(cos.KiB*64, cos.KiB*64)
+	}
+	if extra.Compression == apc.CompressAdaptive {
+		cmpr.adaptive = &adaptive{cmpr: cmpr, out: g.mm.NewSGL(cos.KiB*64, cos.KiB*64)}
+		cmpr.adaptive.buf, _ = g.mm.AllocSize(cos.KiB * 64)
+	}
+	if cmpr.algo == apc.LZ4Compression {
+		s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, cos.ToSizeIEC(int64(blockMaxSize), 0))
 	} else {
-		s.lz4s.sgl = g.mm.NewSGL(cos.KiB*64, cos.KiB*64)
+		s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, cmpr.algo)
 	}
-	s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, cos.ToSizeIEC(int64(s.lz4s.blockMaxSize), 0))
 }
 
-func (s *Stream) compressed() bool { return s.lz4s.s == s }
+func (s *Stream) compressed() bool { return s.cmpr.s == s }
 func (s *Stream) usePDU() bool     { return s.pdu != nil }
 
+func (s *Stream) compression() (string, bool) { return s.cmpr.algo, s.cmpr.adaptive != nil }
+
 func (s *Stream) resetCompression() {
-	s.lz4s.sgl.Reset()
-	s.lz4s.zw.Reset(nil)
+	s.cmpr.sgl.Reset()
+	s.cmpr.zw.Reset(nil)
+	if a := s.cmpr.adaptive; a != nil {
+		a.out.Reset()
+		a.frame, a.raw, a.eob, a.eof = false, false, false, false
+	}
 }
 
 func (s *Stream) cmplLoop() {
@@ -161,17 +195,14 @@ func (s *Stream) doRequest() error {
 	if !s.compressed() {
 		return s.do(s)
 	}
-	s.lz4s.sgl.Reset()
-	if s.lz4s.zw == nil {
-		s.lz4s.zw = lz4.NewWriter(s.lz4s.sgl)
-	} else {
-		s.lz4s.zw.Reset(s.lz4s.sgl)
+	s.cmpr.sgl.Reset()
+	if a := s.cmpr.adaptive; a != nil {
+		a.out.Reset()
+		a.frame, a.raw, a.eob, a.eof = false, false, false, false
+		return s.do(a)
 	}
-	// lz4 framing spec at http://fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
-	s.lz4s.zw.Header.BlockChecksum = false
-	s.lz4s.zw.Header.NoChecksum = !s.lz4s.frameChecksum
-	s.lz4s.zw.Header.BlockMaxSize = s.lz4s.blockMaxSize
-	return s.do(&s.lz4s)
+	s.cmpr.zw.Reset(s.cmpr.sgl)
+	return s.do(&s.cmpr)
 }
 
 // as io.Reader
@@ -334,6 +365,9 @@ exit:
 	// next completion => SCQ
 	s.cmplCh <- cmpl{err, s.sendoff.obj}
 	s.sendoff = sendoff{ins: inEOB}
+	if a := s.cmpr.adaptive; a != nil {
+		a.eob = true
+	}
 }
 
 func (s *Stream) inSend() bool { return s.sendoff.ins >= inHdr || s.sendoff.ins < inEOB }
@@ -405,57 +439,155 @@ func (s *Stream) idleTick() {
 // Stats //
 ///////////
 
+// original (uncompressed) stream offset over the number of bytes sent, including
+// raw chunks (apc.CompressAdaptive); zero when nothing was sent yet
 func (stats *Stats) CompressionRatio() float64 {
 	bytesRead := stats.Offset.Load()
 	bytesSent := stats.CompressedSize.Load()
+	if bytesSent == 0 {
+		return 0
+	}
 	return float64(bytesRead) / float64(bytesSent)
 }
 
-///////////////
-// lz4Stream //
-///////////////
+////////////////
+// cmprStream //
+////////////////
 
-func (lz4s *lz4Stream) Read(b []byte) (n int, err error) {
+func (cmpr *cmprStream) Read(b []byte) (n int, err error) {
 	var (
-		sendoff = &lz4s.s.sendoff
+		sendoff = &cmpr.s.sendoff
 		last    = sendoff.obj.Hdr.isFin()
 		retry   = maxInReadRetries // insist on returning n > 0 (note that lz4 compresses /blocks/)
 	)
-	if lz4s.sgl.Len() > 0 {
-		lz4s.zw.Flush()
-		n, err = lz4s.sgl.Read(b)
+	if cmpr.sgl.Len() > 0 {
+		cmpr.zw.Flush()
+		n, err = cmpr.sgl.Read(b)
 		if err == io.EOF { // reusing/rewinding this buf multiple times
 			err = nil
 		}
 		goto ex
 	}
 re:
-	n, err = lz4s.s.Read(b)
-	_, _ = lz4s.zw.Write(b[:n])
+	n, err = cmpr.s.Read(b)
+	_, _ = cmpr.zw.Write(b[:n])
 	if last {
-		lz4s.zw.Flush()
+		cmpr.zw.Flush()
 		retry = 0
-	} else if lz4s.s.sendoff.ins == inEOB || err != nil {
-		lz4s.zw.Flush()
+	} else if cmpr.s.sendoff.ins == inEOB || err != nil {
+		cmpr.zw.Flush()
 		retry = 0
 	}
-	n, _ = lz4s.sgl.Read(b)
+	n, _ = cmpr.sgl.Read(b)
 	if n == 0 {
 		if retry > 0 {
 			retry--
 			runtime.Gosched()
 			goto re
 		}
-		lz4s.zw.Flush()
-		n, _ = lz4s.sgl.Read(b)
+		cmpr.zw.Flush()
+		n, _ = cmpr.sgl.Read(b)
 	}
 ex:
-	lz4s.s.stats.CompressedSize.Add(int64(n))
-	if lz4s.sgl.Len() == 0 {
-		lz4s.sgl.Reset()
+	cmpr.s.stats.CompressedSize.Add(int64(n))
+	if cmpr.sgl.Len() == 0 {
+		cmpr.sgl.Reset()
 	}
 	if last && err == nil {
 		err = io.EOF
 	}
 	return
 }
+
+//////////////
+// adaptive //
+//////////////
+
+// as io.Reader: returns framed chunks (compare with cmprStream.Read)
+func (a *adaptive) Read(b []byte) (n int, err error) {
+	for a.out.Len() == 0 {
+		if a.eof {
+			return 0, io.EOF
+		}
+		if err = a.fill(); err != nil {
+			return 0, err
+		}
+	}
+	n, _ = a.out.Read(b)
+	a.cmpr.s.stats.CompressedSize.Add(int64(n))
+	if a.out.Len() == 0 {
+		a.out.Reset()
+	}
+	return
+}
+
+// read the next portion of the original stream and, depending on what it is,
+// either compress it or append it as is (raw chunk)
+func (a *adaptive) fill() error {
+	var (
+		s       = a.cmpr.s
+		sendoff = &s.sendoff
+		data    = sendoff.ins == inData && !sendoff.obj.IsHeaderOnly()
+		first   = data && sendoff.off == 0
+		oname   string
+	)
+	if first {
+		oname = sendoff.obj.Hdr.ObjName // (before eoObj)
+	}
+	n, err := s.Read(a.buf)
+	if n > 0 {
+		if first {
+			// first read of the object's data: decide once per object
+			if a.raw = incompressible(oname, a.buf[:n]); a.raw {
+				s.stats.RawNum.Inc()
+			}
+		}
+		if data && a.raw {
+			a.closeFrame()
+			a.chunk(chunkRaw, a.buf[:n])
+		} else {
+			if !a.frame {
+				a.cmpr.zw.Reset(a.cmpr.sgl)
+				a.frame = true
+			}
+			_, _ = a.cmpr.zw.Write(a.buf[:n])
+		}
+	}
+	// never carry a compressed frame over object boundary - otherwise, the object's tail
+	// (and the object itself) would be delayed until the next one or until the stream terminates
+	switch {
+	case err != nil, a.eob, a.cmpr.sgl.Len() >= maxFrameSize:
+		a.closeFrame()
+	case sendoff.ins == inData && sendoff.obj.IsHeaderOnly():
+		a.closeFrame() // (not to delay header-only objects)
+	}
+	a.eob = false
+	if err == io.EOF {
+		a.eof, err = true, nil
+	}
+	return err
+}
+
+func (a *adaptive) closeFrame() {
+	if !a.frame {
+		return
+	}
+	sgl := a.cmpr.sgl
+	_ = a.cmpr.zw.Close()
+	a.chunk(chunkCompressed, nil)
+	_, _ = sgl.WriteTo(a.out)
+	sgl.Reset()
+	a.frame = false
+}
+
+// chunk header followed by raw payload or, when payload is nil, the compressed frame (above)
+func (a *adaptive) chunk(typ byte, payload []byte) {
+	var hdr [sizeofChunkHdr]byte
+	if payload == nil {
+		putChunkHdr(hdr[:], typ, int(a.cmpr.sgl.Len()))
+	} else {
+		putChunkHdr(hdr[:], typ, len(payload))
+	}
+	_, _ = a.out.Write(hdr[:])
+	_, _ = a.out.Write(payload)
+}
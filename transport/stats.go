@@ -23,6 +23,7 @@ type Stats struct {
 	Size           atomic.Int64 // transferred object size (does not include transport headers)
 	Offset         atomic.Int64 // stream offset, in bytes
 	CompressedSize atomic.Int64 // compressed size (converges to the actual compressed size over time)
+	RawNum         atomic.Int64 // apc.CompressAdaptive: number of objects sent uncompressed
 }
 
 type nopRxStats struct{}
@@ -27,6 +27,7 @@ const (
 type global struct {
 	statsTracker cos.StatsUpdater // aka stats.Trunner
 	mm           *memsys.MMSA
+	dialTLS      func(cmn.DialFunc) cmn.DialFunc // intra-cluster mTLS (see UseIntraTLS)
 }
 
 var (
@@ -63,6 +64,10 @@ func Init(st cos.StatsUpdater, config *cmn.Config) *StreamCollector {
 	return sc
 }
 
+// intra-cluster mTLS (config.net.intra_tls): stream clients dial TLS via the provided wrapper
+// (that also performs the handshake) - must be called prior to creating any
+func UseIntraTLS(dialTLS func(cmn.DialFunc) cmn.DialFunc) { g.dialTLS = dialTLS }
+
 func burst(config *cmn.Config) (burst int) {
 	if burst = config.Transport.Burst; burst == 0 {
 		burst = dfltBurstNum
@@ -17,9 +17,10 @@ import (
 	"github.com/NVIDIA/aistore/fs"
 )
 
-// initializes mountpaths and volume; on SIE (storage integrity error) terminates and exits
+// initializes mountpaths and volume; on SIE (storage integrity error) terminates and exits;
+// returns `reweighted` when mountpath weights (weighted HRW) differ from the ones stored in VMD
 func Init(t cluster.Target, config *cmn.Config,
-	allowSharedDisksAndNoDisks, useLoopbackDevs, ignoreMissingMountpath bool) (created bool) {
+	allowSharedDisksAndNoDisks, useLoopbackDevs, ignoreMissingMountpath bool) (created, reweighted bool) {
 	var (
 		vmd *VMD
 		tid = t.SID()
@@ -77,6 +78,14 @@ func Init(t cluster.Target, config *cmn.Config,
 			vmd.persist()
 		}
 	}
+	if reweighted = vmd.reweighted(); reweighted {
+		nlog.Warningf("%s: mountpath weights changed (%s)", t, vmd)
+		if v, err := NewFromMPI(tid); err != nil {
+			nlog.Errorln(err)
+		} else {
+			vmd = v
+		}
+	}
 	nlog.Infoln(vmd.String())
 	return
 }
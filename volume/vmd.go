@@ -27,6 +27,7 @@ type (
 		Fs      string   `json:"fs"`
 		FsType  string   `json:"fs_type"`
 		FsID    cos.FsID `json:"fs_id"`
+		Weight  uint64   `json:"weight,omitempty"` // weighted HRW (see fs.Hrw)
 		Enabled bool     `json:"enabled"`
 	}
 
@@ -71,6 +72,7 @@ func (vmd *VMD) addMountpath(mi *fs.Mountpath, enabled bool) {
 		Fs:      mi.Fs,
 		FsType:  mi.FsType,
 		FsID:    mi.FsID,
+		Weight:  mi.Weight,
 	}
 }
 
@@ -106,6 +108,16 @@ func (vmd *VMD) equal(other *VMD) bool {
 		vmd.cksum.Equal(other.cksum)
 }
 
+// whether any of the available mountpaths has a different weight (see fs.Hrw)
+func (vmd *VMD) reweighted() bool {
+	for mpath, mi := range fs.GetAvail() {
+		if md, ok := vmd.Mountpaths[mpath]; ok && md.Weight != mi.Weight {
+			return true
+		}
+	}
+	return false
+}
+
 func (vmd *VMD) String() string {
 	if vmd.info != "" {
 		return vmd.info
@@ -119,6 +119,7 @@ var Table = map[string]Descriptor{
 	// (one bucket) | (all buckets)
 	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true, Mountpath: true},
 	apc.ActStoreCleanup: {DisplayName: "cleanup", Scope: ScopeGB, Startable: true, Mountpath: true},
+	apc.ActTiering:      {Scope: ScopeGB, Startable: true, Mountpath: true},
 	apc.ActSummaryBck: {
 		DisplayName: "summary",
 		Scope:       ScopeGB,
@@ -163,6 +164,9 @@ var Table = map[string]Descriptor{
 
 	apc.ActDownload: {Access: apc.AccessRW, Scope: ScopeG, Startable: false, Mountpath: true, Idles: true, AbortRebRes: true},
 
+	// single (large) remote object: cold GET via concurrent range reads
+	apc.ActBlobDl: {Access: apc.AceGET, Scope: ScopeB, Startable: false, RefreshCap: true, AbortRebRes: true},
+
 	// in its own class
 	apc.ActDsort: {
 		DisplayName:    "dsort",
@@ -207,6 +211,20 @@ var Table = map[string]Descriptor{
 		Startable:   true,
 		RefreshCap:  true,
 	},
+	apc.ActPinObjects: {
+		DisplayName: "pin-objects",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   false,
+		Mountpath:   true,
+	},
+	apc.ActUnpinObjects: {
+		DisplayName: "unpin-objects",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   false,
+		Mountpath:   true,
+	},
 
 	// entire bucket (storage svcs)
 	apc.ActECEncode: {
@@ -263,8 +281,24 @@ var Table = map[string]Descriptor{
 		Mountpath:   true,
 		AbortRebRes: true,
 	},
+	apc.ActSyncBck: {
+		DisplayName:    "sync-bucket",
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      false, // via bucket action (see apc.SyncBckMsg)
+		RefreshCap:     true,
+		Mountpath:      true,
+		ConflictRebRes: true,
+	},
 
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Owned: true, Idles: true},
+	apc.ActInventory: {
+		Scope:      ScopeB,
+		Access:     apc.AceObjLIST | apc.AceBckHEAD,
+		Startable:  false, // via bucket action (see cmn.InventoryMsg)
+		RefreshCap: true,
+		Mountpath:  true,
+	},
 
 	// cache management, internal usage
 	apc.ActLoadLomCache:   {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true, Mountpath: true},
@@ -0,0 +1,121 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Schedules are named recurring jobs that get started by the primary proxy as per their
+// respective cron expressions (see cos.ParseCron). Schedules are part of the cluster-wide
+// replicated metadata, so that a newly elected primary takes over where the old one left off.
+//
+// Each schedule starts either:
+// - xaction (`Args`) - same as `api.StartXaction`, or
+// - action (`Action`), whereby:
+//   - apc.ActDsort:    `Action.Value` is dsort request spec (same as `api.StartDsort`)
+//   - apc.ActDownload: `Action.Value` is download request body (same as `api.DownloadWithParam`)
+//   - otherwise:       bucket action, e.g. apc.ActCopyBck (same as `api.CopyBucket`) with `Bck`
+//     and `Query` (e.g., apc.QparamBckTo) specifying the bucket(s)
+
+type (
+	Schedule struct {
+		Name     string      `json:"name"`
+		Cron     string      `json:"cron"`
+		Args     *ArgsMsg    `json:"xargs,omitempty"`
+		Action   *apc.ActMsg `json:"action,omitempty"`
+		Bck      cmn.Bck     `json:"bck"`
+		Query    url.Values  `json:"query,omitempty"`
+		Disabled bool        `json:"disabled,omitempty"`
+
+		// runtime state (updated by primary)
+		Created int64       `json:"created,string"` // unix nano
+		Status  SchedStatus `json:"status"`
+	}
+	SchedStatus struct {
+		LastRun int64  `json:"last_run,string"` // unix nano
+		Runs    int64  `json:"runs,string"`
+		Xid     string `json:"xid,omitempty"` // last started job ID
+		Err     string `json:"err,omitempty"` // last error, if any
+	}
+
+	// cluster-wide
+	Schedules struct {
+		Jobs    map[string]*Schedule `json:"jobs"`
+		Version int64                `json:"version,string"`
+	}
+)
+
+//////////////
+// Schedule //
+//////////////
+
+func (s *Schedule) Validate() error {
+	if err := cos.ValidateNiceID(s.Name, 2, "schedule name"); err != nil {
+		return err
+	}
+	if _, err := cos.ParseCron(s.Cron); err != nil {
+		return err
+	}
+	switch {
+	case s.Args != nil && s.Action != nil:
+		return fmt.Errorf("schedule %q: xaction and action are mutually exclusive", s.Name)
+	case s.Args != nil:
+		s.Args.Kind, _ = GetKindName(s.Args.Kind) // display name => kind
+		if !Table[s.Args.Kind].Startable {
+			return fmt.Errorf("schedule %q: xaction %q is not startable", s.Name, s.Args.Kind)
+		}
+	case s.Action != nil:
+		switch s.Action.Action {
+		case "":
+			return fmt.Errorf("schedule %q: empty action", s.Name)
+		case apc.ActDsort, apc.ActDownload:
+		default:
+			if s.Bck.IsEmpty() {
+				return fmt.Errorf("schedule %q: bucket action %q requires bucket", s.Name, s.Action.Action)
+			}
+		}
+	default:
+		return errors.New("schedule " + s.Name + ": neither xaction nor action specified")
+	}
+	return nil
+}
+
+// the first activation time after the last run (or after the schedule was created)
+func (s *Schedule) Next() (int64, error) {
+	c, err := cos.ParseCron(s.Cron)
+	if err != nil {
+		return 0, err
+	}
+	last := s.Status.LastRun
+	if last == 0 {
+		last = s.Created
+	}
+	next := c.Next(time.Unix(0, last))
+	if next.IsZero() {
+		return 0, fmt.Errorf("schedule %q: cron %q never activates", s.Name, s.Cron)
+	}
+	return next.UnixNano(), nil
+}
+
+func (s *Schedule) String() string { return "schedule[" + s.Name + ", " + s.Cron + "]" }
+
+///////////////
+// Schedules //
+///////////////
+
+func (ss *Schedules) String() string {
+	if ss == nil {
+		return "Sched <nil>"
+	}
+	return fmt.Sprintf("Sched v%d(%d)", ss.Version, len(ss.Jobs))
+}
@@ -26,10 +26,22 @@ type (
 		BckTo   *meta.Bck
 		DP      cluster.DP
 	}
+	EvdArgs struct {
+		Msg   *apc.ListRange
+		Force bool // evict pinned objects as well (n/a when deleting)
+	}
 	DsortArgs struct {
 		BckFrom *meta.Bck
 		BckTo   *meta.Bck
 	}
+	InvArgs struct {
+		Msg   *cmn.InventoryMsg
+		BckTo *meta.Bck
+	}
+	SyncArgs struct {
+		Msg   *apc.SyncBckMsg
+		BckTo *meta.Bck
+	}
 	ECEncodeArgs struct {
 		Phase string
 	}
@@ -44,6 +56,12 @@ type (
 		Tag    string
 		Copies int
 	}
+	// the caller write-locks the object and hands the lock over to the xaction
+	BlobArgs struct {
+		Lom *cluster.LOM
+		Res *cluster.GetReaderResult // the entire remote object, as per GetObjReader(lom, 0, 0)
+		Msg *apc.BlobMsg
+	}
 )
 
 //////////////
@@ -107,6 +125,18 @@ func RenewPromote(t cluster.Target, uuid string, bck *meta.Bck, args *cluster.Pr
 	return RenewBucketXact(apc.ActPromote, bck, Args{T: t, Custom: args, UUID: uuid})
 }
 
+func RenewBlobDl(t cluster.Target, uuid string, args *BlobArgs) RenewRes {
+	return RenewBucketXact(apc.ActBlobDl, args.Lom.Bck(), Args{T: t, Custom: args, UUID: uuid})
+}
+
+func RenewInventory(t cluster.Target, uuid string, bck *meta.Bck, args *InvArgs) RenewRes {
+	return RenewBucketXact(apc.ActInventory, bck, Args{T: t, Custom: args, UUID: uuid})
+}
+
+func RenewSyncBck(t cluster.Target, uuid string, bckFrom *meta.Bck, args *SyncArgs) RenewRes {
+	return RenewBucketXact(apc.ActSyncBck, bckFrom, Args{T: t, Custom: args, UUID: uuid})
+}
+
 func RenewBckLoadLomCache(t cluster.Target, uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{T: t, UUID: uuid})
 }
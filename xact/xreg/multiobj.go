@@ -14,7 +14,12 @@ func RenewPutArchive(t cluster.Target, bckFrom, bckTo *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActArchive, bckFrom, Args{T: t, Custom: bckTo}, bckFrom, bckTo)
 }
 
-func RenewEvictDelete(uuid string, t cluster.Target, kind string, bck *meta.Bck, msg *apc.ListRange) RenewRes {
+func RenewEvictDelete(uuid string, t cluster.Target, kind string, bck *meta.Bck, msg *apc.ListRange, force bool) RenewRes {
+	return RenewBucketXact(kind, bck, Args{T: t, UUID: uuid, Custom: &EvdArgs{Msg: msg, Force: force}})
+}
+
+// kind: (apc.ActPinObjects | apc.ActUnpinObjects)
+func RenewPinUnpin(uuid string, t cluster.Target, kind string, bck *meta.Bck, msg *apc.ListRange) RenewRes {
 	return RenewBucketXact(kind, bck, Args{T: t, UUID: uuid, Custom: msg})
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/xact"
 )
@@ -44,6 +45,11 @@ func RenewStoreCleanup(id string) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewTiering(t cluster.Target, id string, bcks []cmn.Bck) RenewRes {
+	e := dreg.nonbckXacts[apc.ActTiering].New(Args{T: t, UUID: id, Custom: bcks}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewDownloader(t cluster.Target, xid string, bck *meta.Bck) RenewRes {
 	e := dreg.nonbckXacts[apc.ActDownload].New(Args{T: t, UUID: xid, Custom: bck}, nil)
 	return dreg.renew(e, nil)
@@ -150,6 +150,12 @@ func (r *XactArch) Begin(msg *cmn.ArchiveBckMsg, archlom *cluster.LOM) (err erro
 		)
 		if exists && wi.msg.AppendIfExists {
 			s = " append"
+			if wi.archlom.Bprops().ObjLock.Enabled {
+				// fail fast (and see T.FinalizeObj)
+				if err = wi.archlom.CheckLockedPut(); err != nil {
+					return
+				}
+			}
 			lmfh, err = wi.beginAppend()
 		} else {
 			wi.wfh, err = wi.archlom.CreateFile(wi.fqn)
@@ -380,7 +386,7 @@ func (r *XactArch) fini(wi *archwi) (errCode int, err error) {
 	cos.Close(wi.wfh)
 	wi.wfh = nil
 
-	errCode, err = r.p.T.FinalizeObj(wi.archlom, wi.fqn, r) // cmn.OwtFinalize
+	errCode, err = r.p.T.FinalizeObj(wi.archlom, wi.fqn, r, cmn.OwtFinalize)
 	cluster.FreeLOM(wi.archlom)
 	r.ObjsAdd(1, size-wi.appendPos)
 	return
@@ -426,7 +432,8 @@ func (r *XactArch) Snap() (snap *cluster.Snap) {
 
 func (wi *archwi) beginAppend() (lmfh *os.File, err error) {
 	msg := wi.msg
-	if msg.Mime == archive.ExtTar {
+	// (with object lock, never moving the existing shard out of its place)
+	if msg.Mime == archive.ExtTar && !wi.archlom.Bprops().ObjLock.Enabled {
 		if err = wi.openTarForAppend(); err == nil || err != archive.ErrTarIsEmpty {
 			return
 		}
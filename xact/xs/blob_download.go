@@ -0,0 +1,467 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Blob download: cold GET of a single (large) remote object via concurrent range reads
+// into a preallocated workfile. The object is write-locked by the caller - the lock is
+// then owned and eventually released by the xaction. In the meantime, the downloaded
+// contiguous prefix can be read via NewReader (e.g., by the GET that started it all).
+// All range reads are pinned to the ETag and/or version of the initial (entire object) read;
+// a remote object that changes mid-download fails the xaction.
+
+const blobMaxRetries = 3
+
+var errBlobChanged = errors.New("remote object changed during blob download")
+
+type (
+	blobFactory struct {
+		xreg.RenewBase
+		xctn *XactBlobDl
+		args *xreg.BlobArgs
+	}
+	XactBlobDl struct {
+		xact.Base
+		p          *blobFactory
+		lom        *cluster.LOM
+		rc         io.ReadCloser // initial reader (the entire object) - used for chunk #0
+		expCksum   *cos.Cksum
+		etag       string // remote ETag and version (custom metadata) of the initial read
+		version    string
+		ctx        context.Context
+		cancel     context.CancelFunc
+		wfqn       string
+		wfh        *os.File
+		size       int64
+		chunkSize  int64
+		numWorkers int
+		// state (under mu)
+		mu     sync.Mutex
+		cond   sync.Cond
+		done   []bool // downloaded chunks
+		next   int    // next chunk to read
+		first  int    // first chunk that's not yet downloaded
+		prefix int64  // contiguous downloaded bytes
+		err    error
+		fin    bool // downloaded, verified, and finalized
+	}
+	blobReader struct {
+		r   *XactBlobDl
+		fh  *os.File
+		off int64
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactBlobDl)(nil)
+	_ xreg.Renewable = (*blobFactory)(nil)
+	_ io.ReadCloser  = (*blobReader)(nil)
+)
+
+/////////////////
+// blobFactory //
+/////////////////
+
+func (*blobFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &blobFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, args: args.Custom.(*xreg.BlobArgs)}
+	return p
+}
+
+func (p *blobFactory) Start() error {
+	var (
+		args = p.args
+		conf = &args.Lom.Bprops().BlobDl
+		r    = &XactBlobDl{
+			p:          p,
+			rc:         args.Res.R,
+			expCksum:   args.Res.ExpCksum,
+			size:       args.Res.Size,
+			chunkSize:  conf.Chunk(),
+			numWorkers: conf.Workers(),
+		}
+	)
+	if args.Msg != nil {
+		if args.Msg.ChunkSize > 0 {
+			r.chunkSize = args.Msg.ChunkSize
+		}
+		if args.Msg.NumWorkers > 0 {
+			r.numWorkers = args.Msg.NumWorkers
+		}
+	}
+	nchunks := int((r.size + r.chunkSize - 1) / r.chunkSize)
+	r.numWorkers = min(r.numWorkers, nchunks)
+	r.done = make([]bool, nchunks)
+	r.cond.L = &r.mu
+
+	// preallocate
+	r.lom = args.Lom.CloneMD(args.Lom.FQN)
+	r.etag, _ = r.lom.GetCustomKey(cmn.ETag)
+	r.version, _ = r.lom.GetCustomKey(cmn.VersionObjMD)
+	r.wfqn = fs.CSM.Gen(r.lom, fs.WorkfileType, fs.WorkfileBlobDl)
+	wfh, err := r.lom.CreateFileRW(r.wfqn)
+	if err != nil {
+		cluster.FreeLOM(r.lom)
+		return err
+	}
+	if err = wfh.Truncate(r.size); err != nil {
+		wfh.Close()
+		if errV := cos.RemoveFile(r.wfqn); errV != nil {
+			nlog.Errorln(errV)
+		}
+		cluster.FreeLOM(r.lom)
+		return err
+	}
+	r.wfh = wfh
+
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	r.InitBase(p.Args.UUID, apc.ActBlobDl, args.Lom.Bck())
+	p.xctn = r
+	return nil
+}
+
+func (*blobFactory) Kind() string        { return apc.ActBlobDl }
+func (p *blobFactory) Get() cluster.Xact { return p.xctn }
+
+func (*blobFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+////////////////
+// XactBlobDl //
+////////////////
+
+func (r *XactBlobDl) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name(), r.lom.Cname(), "size", r.size, "chunk", r.chunkSize, "workers", r.numWorkers)
+
+	var (
+		wwg     sync.WaitGroup
+		stopped = make(chan struct{})
+	)
+	// wake up all waiters upon abort
+	go func() {
+		select {
+		case <-r.ChanAbort():
+			r.cancel()
+			r.mu.Lock()
+			r.cond.Broadcast()
+			r.mu.Unlock()
+		case <-stopped:
+		}
+	}()
+	wwg.Add(r.numWorkers)
+	for i := 0; i < r.numWorkers; i++ {
+		go r.worker(&wwg)
+	}
+	cksum, err := r.checksum()
+	if err != nil {
+		r.fail(err)
+	}
+	wwg.Wait()
+	close(stopped)
+	r.cancel()
+	r.fini(cksum)
+}
+
+func (r *XactBlobDl) worker(wg *sync.WaitGroup) {
+	defer wg.Done()
+	lom := cluster.AllocLOM(r.lom.ObjName) // (backend GetObjReader modifies custom metadata)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.lom.Bucket()); err != nil {
+		r.fail(err)
+		return
+	}
+	buf, slab := r.p.T.PageMM().AllocSize(min(r.chunkSize, memsys.MaxPageSlabSize))
+	defer slab.Free(buf)
+	for {
+		idx, ok := r.claim()
+		if !ok {
+			return
+		}
+		var (
+			off    = int64(idx) * r.chunkSize
+			length = min(r.chunkSize, r.size-off)
+			err    error
+		)
+		for retry := 0; ; retry++ {
+			err = r.read(lom, idx, off, length, buf)
+			if err == nil || retry >= blobMaxRetries || r.IsAborted() || errors.Is(err, errBlobChanged) {
+				break
+			}
+			nlog.Warningln(r.Name(), "retrying chunk", idx, "err:", err)
+			time.Sleep(time.Second)
+		}
+		if err != nil {
+			r.fail(err)
+			return
+		}
+		r.InObjsAdd(0, length)
+		r.chunkDone(idx)
+	}
+}
+
+func (r *XactBlobDl) claim() (idx int, ok bool) {
+	r.mu.Lock()
+	if r.err == nil && !r.IsAborted() && r.next < len(r.done) {
+		idx, ok = r.next, true
+		r.next++
+	}
+	r.mu.Unlock()
+	return
+}
+
+// read [off, off + length) into the workfile
+func (r *XactBlobDl) read(lom *cluster.LOM, idx int, off, length int64, buf []byte) error {
+	var (
+		rc io.ReadCloser
+		rd io.Reader
+	)
+	if idx == 0 && r.rc != nil {
+		// chunk #0 is always claimed first, and by a single worker
+		rc, r.rc = r.rc, nil
+		rd = io.LimitReader(rc, length)
+	} else {
+		r.pin(lom)
+		res := r.p.T.Backend(lom.Bck()).GetObjReader(r.ctx, lom, off, length)
+		if res.Err != nil {
+			if res.ErrCode == http.StatusPreconditionFailed {
+				return fmt.Errorf("%w: %s: %v", errBlobChanged, r.lom.Cname(), res.Err)
+			}
+			return res.Err
+		}
+		if err := r.validate(lom); err != nil {
+			cos.Close(res.R)
+			return err
+		}
+		rc, rd = res.R, res.R
+	}
+	n, err := cos.CopyBuffer(io.NewOffsetWriter(r.wfh, off), rd, buf)
+	cos.Close(rc)
+	if err == nil && n != length {
+		err = fmt.Errorf("%s: chunk #%d [%d, %d): read %d bytes", r, idx, off, off+length, n)
+	}
+	return err
+}
+
+// range reads are conditional on the initial ETag and version (see cluster.Backend)
+func (r *XactBlobDl) pin(lom *cluster.LOM) {
+	if r.etag != "" {
+		lom.SetCustomKey(cmn.ETag, r.etag)
+	}
+	if r.version != "" {
+		lom.SetCustomKey(cmn.VersionObjMD, r.version)
+	}
+}
+
+// (for backends that don't support conditional range reads)
+func (r *XactBlobDl) validate(lom *cluster.LOM) error {
+	if etag, _ := lom.GetCustomKey(cmn.ETag); r.etag != "" && etag != r.etag {
+		return fmt.Errorf("%w: %s: ETag %q vs %q", errBlobChanged, r.lom.Cname(), etag, r.etag)
+	}
+	if ver, _ := lom.GetCustomKey(cmn.VersionObjMD); r.version != "" && ver != r.version {
+		return fmt.Errorf("%w: %s: version %q vs %q", errBlobChanged, r.lom.Cname(), ver, r.version)
+	}
+	return nil
+}
+
+func (r *XactBlobDl) chunkDone(idx int) {
+	r.mu.Lock()
+	r.done[idx] = true
+	for r.first < len(r.done) && r.done[r.first] {
+		r.first++
+	}
+	r.prefix = min(int64(r.first)*r.chunkSize, r.size)
+	r.cond.Broadcast()
+	r.mu.Unlock()
+}
+
+func (r *XactBlobDl) fail(err error) {
+	r.mu.Lock()
+	if r.err == nil {
+		r.err = err
+	}
+	r.cond.Broadcast()
+	r.mu.Unlock()
+}
+
+// wait for the downloaded prefix to grow beyond `off`;
+// readers, in addition, are held off the very last byte until the object is verified and finalized
+func (r *XactBlobDl) wait(off int64, reader bool) (limit int64, err error) {
+	var aborted bool
+	r.mu.Lock()
+	for {
+		if r.err != nil {
+			err = r.err
+			break
+		}
+		if aborted = r.IsAborted(); aborted {
+			break
+		}
+		limit = r.prefix
+		if reader && !r.fin {
+			limit = min(limit, r.size-1)
+		}
+		if limit > off {
+			break
+		}
+		r.cond.Wait()
+	}
+	r.mu.Unlock()
+	if aborted {
+		if err = r.AbortErr(); err == nil {
+			err = cmn.NewErrAborted(r.Name(), "", nil)
+		}
+	}
+	return
+}
+
+// follow the prefix and compute the checksum of the entire object;
+// when provided by the backend, validate the expected one as well
+func (r *XactBlobDl) checksum() (*cos.CksumHash, error) {
+	var (
+		cksum = cos.NewCksumHash(r.lom.CksumConf().Type)
+		exp   *cos.CksumHash
+		w     io.Writer = cksum.H
+		off   int64
+	)
+	if !r.expCksum.IsEmpty() && r.expCksum.Ty() != cksum.Ty() {
+		exp = cos.NewCksumHash(r.expCksum.Ty())
+		w = cos.NewWriterMulti(cksum.H, exp.H)
+	}
+	buf, slab := r.p.T.PageMM().AllocSize(memsys.MaxPageSlabSize)
+	defer slab.Free(buf)
+	for off < r.size {
+		limit, err := r.wait(off, false)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := cos.CopyBuffer(w, io.NewSectionReader(r.wfh, off, limit-off), buf); err != nil {
+			return nil, err
+		}
+		off = limit
+	}
+	cksum.Finalize()
+	if r.expCksum.IsEmpty() {
+		return cksum, nil
+	}
+	if exp == nil {
+		exp = cksum
+	} else {
+		exp.Finalize()
+	}
+	if !exp.Equal(r.expCksum) {
+		return nil, cos.NewErrDataCksum(&exp.Cksum, r.expCksum, r.lom.Cname())
+	}
+	return cksum, nil
+}
+
+func (r *XactBlobDl) fini(cksum *cos.CksumHash) {
+	if r.rc != nil {
+		cos.Close(r.rc)
+	}
+	err := r.err // (all workers are done)
+	if err == nil && cmn.Rom.Features().IsSet(feat.FsyncPUT) {
+		err = r.wfh.Sync()
+	}
+	if errC := r.wfh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		lom := r.lom
+		lom.SetSize(r.size)
+		lom.SetCksum(cksum.Clone())
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		_, err = r.p.T.FinalizeObj(lom, r.wfqn, r, cmn.OwtGetLock) // (removes workfile on error)
+	} else if errV := cos.RemoveFile(r.wfqn); errV != nil {
+		nlog.Errorln(errV)
+	}
+	if err == nil {
+		r.ObjsAdd(1, r.size)
+	} else {
+		r.AddErr(err)
+	}
+	r.lom.Unlock(true)
+
+	r.mu.Lock()
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	r.fin = err == nil
+	r.cond.Broadcast()
+	r.mu.Unlock()
+
+	cluster.FreeLOM(r.lom)
+	r.Finish()
+}
+
+// read downloaded (contiguous) prefix as it grows;
+// must be called prior to Run()
+func (r *XactBlobDl) NewReader() (io.ReadCloser, error) {
+	fh, err := os.Open(r.wfqn) // (remains valid when renamed or removed)
+	if err != nil {
+		return nil, err
+	}
+	return &blobReader{r: r, fh: fh}, nil
+}
+
+func (r *XactBlobDl) Size() int64 { return r.size }
+
+func (r *XactBlobDl) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+	return
+}
+
+////////////////
+// blobReader //
+////////////////
+
+func (br *blobReader) Read(b []byte) (n int, err error) {
+	r := br.r
+	if br.off >= r.size {
+		return 0, io.EOF
+	}
+	limit, err := r.wait(br.off, true)
+	if err != nil {
+		return 0, err
+	}
+	if int64(len(b)) > limit-br.off {
+		b = b[:limit-br.off]
+	}
+	n, err = br.fh.ReadAt(b, br.off)
+	br.off += int64(n)
+	if errors.Is(err, io.EOF) && br.off < r.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return
+}
+
+func (br *blobReader) Close() error { return br.fh.Close() }
@@ -21,11 +21,16 @@ func Xreg() {
 	xreg.RegBckXact(&evdFactory{kind: apc.ActEvictObjects})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActDeleteObjects})
 	xreg.RegBckXact(&prfFactory{})
+	xreg.RegBckXact(&pinFactory{kind: apc.ActPinObjects})
+	xreg.RegBckXact(&pinFactory{kind: apc.ActUnpinObjects})
 
 	xreg.RegNonBckXact(&nsummFactory{})
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&invFactory{})
+	xreg.RegBckXact(&syncBckFactory{})
+	xreg.RegBckXact(&blobFactory{})
 
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActETLObjects}})
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActCopyObjects}})
@@ -0,0 +1,368 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Bucket inventory (see cmn.InventoryMsg), whereby each target:
+// - lists the objects it owns: walks local mountpaths (ais buckets and cached-only inventory)
+//   or, for remote buckets, keeps the names that map (HRW) to this target - remote pages are listed
+//   only once, by a single designated target (see remtPager) - hence, started via two-phase
+//   transaction where all targets get ready to receive (begin) before any of them starts running (commit);
+// - writes gzipped chunks of up to `chunk_entries` objects each;
+// - promotes (see t.Promote) each chunk and, lastly, its own manifest to the destination bucket.
+
+var invListProps = strings.Join([]string{apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsVersion},
+	apc.LsPropsSepa)
+
+type (
+	invFactory struct {
+		xreg.RenewBase
+		xctn *XactInventory
+	}
+	invChunk struct {
+		iw   *cmn.InvWriter
+		fh   *os.File
+		fqn  string // workfile
+		name string // destination object name
+		cnt  int64
+		idx  int // in manifest.Chunks
+	}
+	XactInventory struct {
+		p        *invFactory
+		msg      *cmn.InventoryMsg
+		bckTo    *meta.Bck
+		chunk    invChunk // current (being written)
+		manifest cmn.InventoryManifest
+		pager    remtPager // remote bucket (see listRemote)
+		xact.BckJog
+		mu         sync.Mutex
+		listRemote bool
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactInventory)(nil)
+	_ xreg.Renewable = (*invFactory)(nil)
+)
+
+////////////////
+// invFactory //
+////////////////
+
+func (*invFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &invFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *invFactory) Start() error {
+	args := p.Args.Custom.(*xreg.InvArgs)
+	r := newInventory(p, args)
+	if r.listRemote {
+		if err := r.initRemote(); err != nil {
+			return err
+		}
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*invFactory) Kind() string        { return apc.ActInventory }
+func (p *invFactory) Get() cluster.Xact { return p.xctn }
+
+func (*invFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+///////////////////
+// XactInventory //
+///////////////////
+
+func newInventory(p *invFactory, args *xreg.InvArgs) (r *XactInventory) {
+	r = &XactInventory{p: p, msg: args.Msg, bckTo: args.BckTo}
+	r.manifest = cmn.InventoryManifest{
+		Bck:     p.Bck.Clone(),
+		Xid:     p.UUID(),
+		Format:  r.msg.Format,
+		Fields:  cmn.InvFields,
+		Chunks:  []cmn.InventoryChunk{},
+		Started: time.Now().UnixNano(),
+		Targets: 1,
+	}
+	r.listRemote = p.Bck.IsRemote() && !r.msg.ObjCached
+	if r.listRemote {
+		r.InitBase(p.UUID(), p.Kind(), p.Bck)
+		r.Config = cmn.GCO.Get()
+		return
+	}
+	opts := &mpather.JgroupOpts{
+		T:        p.T,
+		CTs:      []string{fs.ObjectType},
+		Prefix:   r.msg.Prefix,
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.LoadUnsafe,
+	}
+	opts.Bck.Copy(p.Bck.Bucket())
+	r.BckJog.Init(p.UUID(), p.Kind(), p.Bck, opts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactInventory) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), r.p.Bck.Cname(r.msg.Prefix), "=>", r.bckTo.Cname(r.msg.ObjDir(r.ID())))
+	var err error
+	if r.listRemote {
+		err = r.runRemote()
+	} else {
+		r.BckJog.Run()
+		err = r.BckJog.Wait()
+	}
+	if err == nil {
+		err = r.flush()
+	}
+	if err == nil {
+		err = r.putManifest()
+	}
+	if err != nil {
+		r.discard()
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+// remote bucket: select the lister and, when there are other targets, open streams
+func (r *XactInventory) initRemote() error {
+	return r.pager.init(r, r.p.T, r.p.Bck, r.Config)
+}
+
+// (compare with XactTCB.TxnAbort)
+func (r *XactInventory) TxnAbort(err error) {
+	err = cmn.NewErrAborted(r.Name(), "inventory: txn-abort", err)
+	r.pager.abort(err)
+	r.AddErr(err)
+	r.Base.Finish()
+}
+
+// list (designated lister) or receive remote pages, and keep the entries that this target owns
+func (r *XactInventory) runRemote() error {
+	var (
+		smap  = r.p.T.Sowner().Get()
+		lsmsg = &apc.LsoMsg{Prefix: r.msg.Prefix, Props: invListProps}
+	)
+	err := r.pager.run(r.p.Bck, lsmsg, func(entries cmn.LsoEntries) error {
+		return r.addPage(entries, smap)
+	})
+	r.pager.close(err)
+	return err
+}
+
+func (r *XactInventory) addPage(entries cmn.LsoEntries, smap *meta.Smap) error {
+	var (
+		t   = r.p.T
+		bck = r.p.Bck
+	)
+	for _, en := range entries {
+		si, err := smap.HrwName2T(bck.MakeUname(en.Name))
+		if err != nil {
+			return err
+		}
+		if si.ID() != t.SID() {
+			continue
+		}
+		if err := r.addRemote(en); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *XactInventory) addRemote(en *cmn.LsoEntry) error {
+	var (
+		e   = cmn.InventoryEntry{Name: en.Name, Size: en.Size, Checksum: en.Checksum, Version: en.Version}
+		lom = cluster.AllocLOM(en.Name)
+	)
+	if lom.InitBck(r.p.Bck.Bucket()) == nil && lom.Load(true /*cache it*/, false /*locked*/) == nil {
+		invFromLOM(&e, lom)
+	}
+	cluster.FreeLOM(lom)
+	return r.add(&e)
+}
+
+func (r *XactInventory) visitObj(lom *cluster.LOM, _ []byte) error {
+	e := cmn.InventoryEntry{Name: lom.ObjName}
+	invFromLOM(&e, lom)
+	return r.add(&e)
+}
+
+func invFromLOM(e *cmn.InventoryEntry, lom *cluster.LOM) {
+	e.Size = lom.SizeBytes()
+	e.Checksum = lom.Checksum().Value()
+	e.Version = lom.Version()
+	e.Atime = cos.FormatNanoTime(lom.AtimeUnix(), time.RFC3339Nano)
+	e.Cached = true
+}
+
+func (r *XactInventory) add(e *cmn.InventoryEntry) (err error) {
+	var full *invChunk
+	r.mu.Lock()
+	if r.chunk.iw == nil {
+		err = r.open()
+	}
+	if err == nil {
+		err = r.chunk.iw.Write(e)
+	}
+	if err == nil {
+		r.chunk.cnt++
+		r.manifest.Count++
+		r.manifest.Size += e.Size
+		if r.chunk.cnt >= int64(r.msg.ChunkEntries) {
+			full = &invChunk{}
+			*full, r.chunk = r.chunk, invChunk{}
+		}
+	}
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	r.ObjsAdd(1, e.Size)
+	if full != nil {
+		err = r.promote(full)
+	}
+	return err
+}
+
+// under lock
+func (r *XactInventory) open() (err error) {
+	r.chunk.idx = len(r.manifest.Chunks)
+	r.chunk.name = r.msg.ChunkName(r.ID(), r.p.T.SID(), r.chunk.idx+1)
+	if r.chunk.fqn, err = r.workfile(r.chunk.name); err != nil {
+		return err
+	}
+	if r.chunk.fh, err = cos.CreateFile(r.chunk.fqn); err != nil {
+		return err
+	}
+	r.chunk.iw = cmn.NewInvWriter(r.chunk.fh, r.msg.Format)
+	// reserve the chunk's slot (and number)
+	r.manifest.Chunks = append(r.manifest.Chunks, cmn.InventoryChunk{Name: r.chunk.name})
+	return nil
+}
+
+func (r *XactInventory) workfile(objName string) (string, error) {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.bckTo.Bucket()); err != nil {
+		return "", err
+	}
+	return fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileInventory), nil
+}
+
+// close and promote (the chunk that is no longer current)
+func (r *XactInventory) promote(chunk *invChunk) error {
+	err := chunk.iw.Close()
+	if errC := chunk.fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = r._promote(chunk.name, chunk.fqn)
+	}
+	if err != nil {
+		cos.RemoveFile(chunk.fqn)
+		return err
+	}
+	r.mu.Lock()
+	r.manifest.Chunks[chunk.idx].Count = chunk.cnt
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *XactInventory) _promote(objName, fqn string) error {
+	params := cluster.PromoteParams{
+		Bck: r.bckTo,
+		PromoteArgs: cluster.PromoteArgs{
+			SrcFQN:         fqn,
+			ObjName:        objName,
+			OverwriteDst:   true,
+			DeleteSrc:      true,
+			SrcIsNotFshare: true,
+		},
+	}
+	_, err := r.p.T.Promote(&params)
+	return err
+}
+
+// the last (partially filled) chunk
+func (r *XactInventory) flush() error {
+	if r.chunk.iw == nil {
+		return nil
+	}
+	chunk := r.chunk
+	r.chunk = invChunk{}
+	return r.promote(&chunk)
+}
+
+func (r *XactInventory) putManifest() error {
+	r.manifest.Finished = time.Now().UnixNano()
+
+	name := r.msg.ManifestName(r.ID(), r.p.T.SID())
+	fqn, err := r.workfile(name)
+	if err != nil {
+		return err
+	}
+	b, err := jsoniter.Marshal(&r.manifest)
+	if err != nil {
+		return err
+	}
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	_, err = fh.Write(b)
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = r._promote(name, fqn)
+	}
+	if err != nil {
+		cos.RemoveFile(fqn)
+	}
+	return err
+}
+
+// upon error or abort: remove the current (incomplete) chunk
+func (r *XactInventory) discard() {
+	r.mu.Lock()
+	if r.chunk.fh != nil {
+		cos.Close(r.chunk.fh)
+		cos.RemoveFile(r.chunk.fqn)
+		r.chunk = invChunk{}
+	}
+	r.mu.Unlock()
+}
+
+func (r *XactInventory) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
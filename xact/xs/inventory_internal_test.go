@@ -0,0 +1,101 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+func newInvTestXact(t *testing.T, tmock *mock.TargetMock, smap *meta.Smap, sid string, src, dst *meta.Bck) *XactInventory {
+	var (
+		tgt  = newPgTarget(tmock, smap, sid)
+		msg  = &cmn.InventoryMsg{ToBck: dst.Clone()}
+		args = &xreg.InvArgs{Msg: msg, BckTo: dst}
+	)
+	if err := msg.Validate(src.Bucket()); err != nil {
+		t.Fatal(err)
+	}
+	p := &invFactory{RenewBase: xreg.RenewBase{Args: xreg.Args{T: tgt, UUID: "inv-xid", Custom: args}, Bck: src}}
+	r := newInventory(p, args)
+	if !r.listRemote {
+		t.Fatalf("%s: expected to list remote %s", r, src)
+	}
+	return r
+}
+
+// remote pages are listed only once - by the designated lister - and each target
+// keeps (and writes into its chunk) only the names that it owns
+func TestInventoryListOnce(t *testing.T) {
+	var (
+		src   = meta.NewBck("inv-src", apc.AWS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		dst   = meta.NewBck("inv-dst", apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		tmock = mock.NewTarget(mock.NewBaseBownerMock(src, dst))
+	)
+	pgInit(t, src, dst)
+
+	t.Run("single target", func(t *testing.T) {
+		smap := newPgSmap(1)
+		r := newInvTestXact(t, tmock, smap, "t0", src, dst)
+		defer r.discard()
+		if err := r.initRemote(); err != nil {
+			t.Fatal(err)
+		}
+		if !r.pager.lister || r.pager.dm != nil {
+			t.Fatalf("expected the only target to list (with no streams): lister=%t", r.pager.lister)
+		}
+		if err := r.runRemote(); err != nil {
+			t.Fatal(err)
+		}
+		if calls := r.p.T.(*pgTarget).backend.calls; calls != pgTestPages {
+			t.Errorf("expected %d list-objects calls, got %d", pgTestPages, calls)
+		}
+		if r.manifest.Count != pgTestObjs {
+			t.Errorf("expected %d entries, got %d", pgTestObjs, r.manifest.Count)
+		}
+	})
+
+	t.Run("multiple targets", func(t *testing.T) {
+		smap := newPgSmap(3)
+		tsi, err := smap.HrwName2T(src.MakeUname("inv-xid"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		owned := pgOwned(t, smap, src)
+		var total int64
+		for sid := range smap.Tmap {
+			r := newInvTestXact(t, tmock, smap, sid, src, dst)
+			defer r.discard()
+			r.pager = remtPager{xctn: r, t: r.p.T, config: r.Config, lister: sid == tsi.ID()}
+			if !r.pager.lister {
+				r.pager.pageCh = pgPageCh(1)
+			}
+			if err := r.runRemote(); err != nil {
+				t.Fatal(err)
+			}
+			expected := 0
+			if r.pager.lister {
+				expected = pgTestPages
+			}
+			if calls := r.p.T.(*pgTarget).backend.calls; calls != expected {
+				t.Errorf("%s (lister: %t): expected %d list-objects calls, got %d", sid, r.pager.lister, expected, calls)
+			}
+			if r.manifest.Count != owned[sid] {
+				t.Errorf("%s: expected %d (owned) entries, got %d", sid, owned[sid], r.manifest.Count)
+			}
+			total += r.manifest.Count
+		}
+		if total != pgTestObjs {
+			t.Errorf("expected %d entries in total (each owned by exactly one target), got %d", pgTestObjs, total)
+		}
+	})
+}
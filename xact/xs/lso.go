@@ -26,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
@@ -591,6 +592,9 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 		return err
 	}
 	entry.Flags |= apc.EntryIsArchive // the parent archive
+	if msg.IsFlagSet(apc.LsArchSamples) {
+		return r.emitSamples(entry, archList)
+	}
 	for _, archEntry := range archList {
 		e := &cmn.LsoEntry{
 			Name:  path.Join(entry.Name, archEntry.Name),
@@ -607,6 +611,24 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// ls arch samples: one entry per WebDataset sample (total size of all its files)
+func (r *LsoXact) emitSamples(entry *cmn.LsoEntry, archList []*archive.Entry) error {
+	for _, sample := range shard.Samples(archList) {
+		e := &cmn.LsoEntry{
+			Name:  path.Join(entry.Name, sample.Key),
+			Flags: entry.Flags | apc.EntryInArch,
+			Size:  sample.Size,
+		}
+		select {
+		case r.walk.pageCh <- e:
+			/* do nothing */
+		case <-r.walk.stopCh.Listen():
+			return errStopped
+		}
+	}
+	return nil
+}
+
 func (r *LsoXact) Snap() (snap *cluster.Snap) {
 	snap = &cluster.Snap{}
 	r.ToSnap(snap)
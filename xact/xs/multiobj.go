@@ -23,7 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
-// Assorted multi-object (list/range templated) xactions: evict, delete, prefetch multiple objects
+// Assorted multi-object (list/range templated) xactions: evict, delete, prefetch, pin (unpin) multiple objects
 //
 // Supported range syntax includes:
 //   1. bash-extension style: `file-{0..100}`
@@ -63,14 +63,16 @@ type (
 type (
 	evdFactory struct {
 		xreg.RenewBase
-		xctn *evictDelete
-		msg  *apc.ListRange
-		kind string
+		xctn  *evictDelete
+		msg   *apc.ListRange
+		kind  string
+		force bool
 	}
 	evictDelete struct {
 		lriterator
 		xact.Base
 		config *cmn.Config
+		force  bool // evict pinned objects as well
 	}
 	prfFactory struct {
 		xreg.RenewBase
@@ -82,6 +84,18 @@ type (
 		xact.Base
 		config *cmn.Config
 	}
+	pinFactory struct {
+		xreg.RenewBase
+		xctn *pinUnpin
+		msg  *apc.ListRange
+		kind string
+	}
+	pinUnpin struct {
+		lriterator
+		xact.Base
+		config *cmn.Config
+		pin    bool
+	}
 
 	TestXFactory struct{ prfFactory } // tests only
 )
@@ -90,12 +104,15 @@ type (
 var (
 	_ cluster.Xact = (*evictDelete)(nil)
 	_ cluster.Xact = (*prefetch)(nil)
+	_ cluster.Xact = (*pinUnpin)(nil)
 
 	_ xreg.Renewable = (*evdFactory)(nil)
 	_ xreg.Renewable = (*prfFactory)(nil)
+	_ xreg.Renewable = (*pinFactory)(nil)
 
 	_ lrwi = (*evictDelete)(nil)
 	_ lrwi = (*prefetch)(nil)
+	_ lrwi = (*pinUnpin)(nil)
 )
 
 ////////////////
@@ -253,14 +270,16 @@ func (r *lriterator) do(lom *cluster.LOM, wi lrwi, smap *meta.Smap) error {
 //////////////////
 
 func (p *evdFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
-	msg := args.Custom.(*apc.ListRange)
+	custom := args.Custom.(*xreg.EvdArgs)
+	msg := custom.Msg
 	debug.Assert(!msg.IsList() || !msg.HasTemplate())
-	np := &evdFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, kind: p.kind, msg: msg}
+	np := &evdFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, kind: p.kind, msg: msg, force: custom.Force}
 	return np
 }
 
 func (p *evdFactory) Start() error {
 	p.xctn = newEvictDelete(&p.Args, p.kind, p.Bck, p.msg)
+	p.xctn.force = p.force
 	return nil
 }
 
@@ -289,12 +308,20 @@ func (r *evictDelete) Run(*sync.WaitGroup) {
 }
 
 func (r *evictDelete) do(lom *cluster.LOM, lrit *lriterator) {
-	errCode, err := r.t.DeleteObject(lom, r.Kind() == apc.ActEvictObjects)
+	var (
+		errCode int
+		err     error
+	)
+	if r.Kind() == apc.ActEvictObjects {
+		errCode, err = r.t.EvictObject(lom, r.force) // (pinned check under write lock)
+	} else {
+		errCode, err = r.t.DeleteObject(lom, false /*evict*/)
+	}
 	if err == nil { // done
 		r.ObjsAdd(1, lom.SizeBytes(true))
 		return
 	}
-	if errCode == http.StatusNotFound || cmn.IsErrObjNought(err) {
+	if errCode == http.StatusNotFound || cmn.IsErrObjNought(err) || cmn.IsErrObjLocked(err) || cmn.IsErrObjPinned(err) {
 		if lrit.lrp == lrpList {
 			goto eret // unlike range and prefix
 		}
@@ -413,3 +440,74 @@ func (r *prefetch) Snap() (snap *cluster.Snap) {
 	snap.IdleX = r.IsIdle()
 	return
 }
+
+/////////////////
+// pin & unpin //
+/////////////////
+
+func (p *pinFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.ListRange)
+	debug.Assert(!msg.IsList() || !msg.HasTemplate())
+	np := &pinFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, kind: p.kind, msg: msg}
+	return np
+}
+
+func (p *pinFactory) Start() error {
+	p.xctn = newPinUnpin(&p.Args, p.kind, p.Bck, p.msg)
+	return nil
+}
+
+func (p *pinFactory) Kind() string      { return p.kind }
+func (p *pinFactory) Get() cluster.Xact { return p.xctn }
+
+func (*pinFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+func newPinUnpin(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.ListRange) (pu *pinUnpin) {
+	pu = &pinUnpin{config: cmn.GCO.Get(), pin: kind == apc.ActPinObjects}
+	pu.lriterator.init(pu, xargs.T, msg)
+	pu.InitBase(xargs.UUID, kind, bck)
+	return
+}
+
+func (r *pinUnpin) Run(*sync.WaitGroup) {
+	smap := r.t.Sowner().Get()
+	if r.msg.IsList() {
+		_ = r.iterList(r, smap)
+	} else {
+		_ = r.rangeOrPref(r, smap)
+	}
+	r.Finish()
+}
+
+// NOTE: only objects that are present ("cached") can be pinned - the pinned state is
+// stored in (and gets removed with) the object's metadata
+func (r *pinUnpin) do(lom *cluster.LOM, lrit *lriterator) {
+	lom.Lock(true)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	if err == nil && lom.IsPinned() != r.pin {
+		lom.SetPinned(r.pin)
+		err = lom.Persist()
+	}
+	lom.Unlock(true)
+	if err == nil {
+		r.ObjsAdd(1, lom.SizeBytes())
+		return
+	}
+	if cmn.IsErrObjNought(err) && lrit.lrp != lrpList {
+		return // not cached - nothing to do
+	}
+	r.AddErr(err)
+	if r.config.FastV(5, cos.SmoduleXs) {
+		nlog.Warningln(err)
+	}
+}
+
+func (r *pinUnpin) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
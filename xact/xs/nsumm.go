@@ -250,6 +250,7 @@ func (r *XactNsumm) cloneRes(dst, src *cmn.BsummResult) {
 
 	dst.ObjCount.Present = ratomic.LoadUint64(&src.ObjCount.Present)
 	dst.TotalSize.PresentObjs = ratomic.LoadUint64(&src.TotalSize.PresentObjs)
+	dst.TotalSize.PinnedObjs = ratomic.LoadUint64(&src.TotalSize.PinnedObjs)
 
 	if r.listRemote {
 		dst.ObjCount.Remote = ratomic.LoadUint64(&src.ObjCount.Remote)
@@ -291,6 +292,9 @@ func (r *XactNsumm) visitObj(lom *cluster.LOM, _ []byte) error {
 		ratomic.CompareAndSwapInt64(&res.ObjSize.Max, cmax, size)
 	}
 	ratomic.AddUint64(&res.TotalSize.PresentObjs, uint64(size))
+	if lom.IsPinned() {
+		ratomic.AddUint64(&res.TotalSize.PinnedObjs, uint64(size))
+	}
 
 	// generic stats (same as base.LomAdd())
 	r.ObjsAdd(1, size)
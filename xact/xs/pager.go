@@ -0,0 +1,253 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/transport"
+	"github.com/NVIDIA/aistore/transport/bundle"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// remtPager: remote bucket is listed only once - by a single designated target (the lister)
+// that broadcasts each page to all other targets; every target (including the lister) then
+// visits the entire page and keeps what it owns.
+// Used by xactions that list remote buckets on all targets (inventory, sync) - and that must,
+// therefore, start via two-phase transaction where all targets register receive handlers
+// (begin) before any of them starts listing (commit).
+// Each listing ends with opcodeDone or opcodeAbrt; consecutive listings (of the same or
+// different buckets) reuse the same streams and must be executed in the same order by all targets.
+// (compare with LsoXact that pages remote buckets on demand)
+
+type remtPager struct {
+	xctn   cluster.Xact
+	t      cluster.Target
+	config *cmn.Config
+	dm     *bundle.DataMover // lister => other targets
+	pageCh chan *LsoRsp      // receive side (nil: done)
+	stopCh cos.StopCh        // receive side: no longer listening
+	lister bool              // designated to list remote pages
+	abrt   bool              // lister: sent opcodeAbrt
+}
+
+// select the lister and, when there are other targets, open streams
+// (the lister is the same for all listings - HRW of the xaction ID and the `bck`)
+func (pg *remtPager) init(xctn cluster.Xact, t cluster.Target, bck *meta.Bck, config *cmn.Config) (err error) {
+	var (
+		tsi  *meta.Snode
+		smap = t.Sowner().Get()
+	)
+	pg.xctn, pg.t, pg.config = xctn, t, config
+	if tsi, err = smap.HrwName2T(bck.MakeUname(xctn.ID())); err != nil {
+		return
+	}
+	pg.lister = tsi.ID() == t.SID()
+	if smap.CountActiveTs() < 2 {
+		return
+	}
+	if !pg.lister {
+		if smap.GetActiveNode(t.SID()) == nil {
+			return // owns nothing
+		}
+		pg.pageCh = make(chan *LsoRsp, remtPageChSize)
+		pg.stopCh.Init()
+	}
+	dmxtra := bundle.Extra{Multiplier: 1, Config: config}
+	trname := xctn.Kind() + "-" + xctn.ID()
+	pg.dm, err = bundle.NewDataMover(t, trname, pg.recv, cmn.OwtPut, dmxtra)
+	if err != nil {
+		return
+	}
+	if err = pg.dm.RegRecv(); err != nil {
+		return
+	}
+	pg.dm.SetXact(xctn)
+	pg.dm.Open()
+	return
+}
+
+// list remote `bck` (lister) or receive its pages (all other targets), and visit each page
+func (pg *remtPager) run(bck *meta.Bck, lsmsg *apc.LsoMsg, visit func(cmn.LsoEntries) error) (err error) {
+	switch {
+	case pg.lister:
+		err = pg.listPages(bck, lsmsg, visit)
+		if pg.dm != nil {
+			pg.sendTerm(err)
+		}
+	case pg.pageCh != nil:
+		err = pg.recvPages(visit)
+	}
+	return
+}
+
+// upon termination of the xaction
+func (pg *remtPager) close(err error) {
+	if pg.dm == nil {
+		return
+	}
+	if pg.lister {
+		if err != nil && !pg.abrt {
+			pg.sendTerm(err) // e.g., failed in between listings
+		}
+		pg.dm.Close(nil) // gracefully, to deliver the last opcode
+	} else {
+		pg.stopCh.Close()
+		pg.dm.Close(err)
+	}
+	pg.dm.UnregRecv()
+}
+
+// upon txn abort (instead of close)
+func (pg *remtPager) abort(err error) {
+	if pg.dm == nil {
+		return
+	}
+	if !pg.lister {
+		pg.stopCh.Close()
+	}
+	pg.dm.CloseIf(err)
+	pg.dm.UnregRecv()
+}
+
+func (pg *remtPager) listPages(bck *meta.Bck, lsmsg *apc.LsoMsg, visit func(cmn.LsoEntries) error) error {
+	for {
+		if err := pg.xctn.AbortErr(); err != nil {
+			return err
+		}
+		lst := &cmn.LsoResult{Entries: allocLsoEntries()}
+		if _, err := pg.t.Backend(bck).ListObjects(bck, lsmsg, lst); err != nil {
+			freeLsoEntries(lst.Entries)
+			return err
+		}
+		if pg.dm != nil {
+			if err := pg.bcast(bck, lst); err != nil {
+				// (the respective target(s) will time out)
+				nlog.Warningln(pg.xctn.Name(), "failed to broadcast page:", err)
+			}
+		}
+		err := visit(lst.Entries)
+		freeLsoEntries(lst.Entries)
+		if err != nil {
+			return err
+		}
+		if lsmsg.ContinuationToken = lst.ContinuationToken; lsmsg.ContinuationToken == "" {
+			return nil
+		}
+	}
+}
+
+func (pg *remtPager) recvPages(visit func(cmn.LsoEntries) error) error {
+	timeout := pg.config.Timeout.SendFile.D()
+	for {
+		select {
+		case rsp := <-pg.pageCh:
+			if rsp == nil {
+				return nil // done
+			}
+			if rsp.Err != nil {
+				return rsp.Err
+			}
+			if err := visit(rsp.Lst.Entries); err != nil {
+				return err
+			}
+		case <-time.After(timeout):
+			return fmt.Errorf("%s: timed out waiting for the next page (%v)", pg.xctn.Name(), timeout)
+		case <-pg.xctn.ChanAbort():
+			return pg.xctn.AbortErr()
+		}
+	}
+}
+
+// (compare with LsoXact.bcast)
+func (pg *remtPager) bcast(bck *meta.Bck, page *cmn.LsoResult) error {
+	var (
+		sgl = pg.t.PageMM().NewSGL(0)
+		mw  = msgp.NewWriter(sgl)
+	)
+	err := page.EncodeMsg(mw)
+	if err == nil {
+		err = mw.Flush()
+	}
+	if err != nil {
+		sgl.Free()
+		return err
+	}
+	o := transport.AllocSend()
+	{
+		o.Hdr.Bck = bck.Clone()
+		o.Hdr.ObjName = pg.xctn.Name()
+		o.Hdr.Opaque = cos.UnsafeB(pg.xctn.ID())
+		o.Hdr.ObjAttrs.Size = sgl.Len()
+	}
+	o.Callback, o.CmplArg = pg.sentCb, sgl
+	return pg.dm.Bcast(o, memsys.NewReader(sgl))
+}
+
+func (*remtPager) sentCb(_ *transport.ObjHdr, _ io.ReadCloser, arg any, _ error) {
+	arg.(*memsys.SGL).Free()
+}
+
+// (compare with streamingX.sendTerm)
+func (pg *remtPager) sendTerm(err error) {
+	o := transport.AllocSend()
+	o.Hdr.SID = pg.t.SID()
+	o.Hdr.Opaque = cos.UnsafeB(pg.xctn.ID())
+	if err == nil {
+		o.Hdr.Opcode = opcodeDone
+	} else {
+		o.Hdr.Opcode = opcodeAbrt
+		o.Hdr.ObjName = err.Error()
+		pg.abrt = true
+	}
+	pg.dm.Bcast(o, nil)
+}
+
+func (pg *remtPager) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+	defer transport.DrainAndFreeReader(objReader)
+	if pg.pageCh == nil {
+		// (e.g., two listers upon Smap change)
+		return fmt.Errorf("%s: unexpected page from %s", pg.xctn.Name(), meta.Tname(hdr.SID))
+	}
+	var rsp *LsoRsp
+	switch {
+	case err != nil && !cos.IsEOF(err):
+		rsp = &LsoRsp{Err: err}
+	case hdr.Opcode == opcodeDone:
+		// rsp == nil: done
+	case hdr.Opcode == opcodeAbrt:
+		err = fmt.Errorf("%s: aborted by %s: %w", pg.xctn.Name(), meta.Tname(hdr.SID), errors.New(hdr.ObjName))
+		rsp = &LsoRsp{Err: err}
+	default:
+		var (
+			page      = &cmn.LsoResult{}
+			buf, slab = pg.t.PageMM().AllocSize(cmn.MsgpLsoBufSize)
+		)
+		err = page.DecodeMsg(msgp.NewReaderBuf(objReader, buf))
+		slab.Free(buf)
+		if err != nil {
+			rsp = &LsoRsp{Err: err}
+		} else {
+			rsp = &LsoRsp{Lst: page}
+		}
+	}
+	select {
+	case pg.pageCh <- rsp:
+	case <-pg.stopCh.Listen():
+		// no longer listening (failed or finished)
+	}
+	return err
+}
@@ -0,0 +1,144 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// test helpers: remote bucket(s) listed via remtPager
+
+const (
+	pgTestObjs     = 100
+	pgTestPageSize = 30
+	pgTestPages    = (pgTestObjs + pgTestPageSize - 1) / pgTestPageSize
+)
+
+type (
+	// remote bucket(s) with a fixed list of objects; counts list-objects calls
+	pgBackend struct {
+		cluster.BackendProvider
+		calls int
+	}
+	pgSowner struct {
+		smap *meta.Smap
+	}
+	// target that lists via pgBackend
+	pgTarget struct {
+		*mock.TargetMock
+		sowner  *pgSowner
+		backend *pgBackend
+		sid     string
+	}
+)
+
+func (b *pgBackend) ListObjects(_ *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	var start int
+	if msg.ContinuationToken != "" {
+		fmt.Sscanf(msg.ContinuationToken, "%d", &start)
+	}
+	b.calls++
+	end := min(start+pgTestPageSize, pgTestObjs)
+	// reuse (pooled) entries - same as the actual backends
+	for i := len(lst.Entries); i < end-start; i++ {
+		lst.Entries = append(lst.Entries, &cmn.LsoEntry{})
+	}
+	lst.Entries = lst.Entries[:end-start]
+	for i, en := range lst.Entries {
+		en.Name, en.Size = pgTestName(start+i), int64(start+i)
+	}
+	lst.ContinuationToken = ""
+	if end < pgTestObjs {
+		lst.ContinuationToken = fmt.Sprintf("%d", end)
+	}
+	return 0, nil
+}
+
+func (o *pgSowner) Get() *meta.Smap                           { return o.smap }
+func (*pgSowner) Listeners() meta.SmapListeners               { return nil }
+func (t *pgTarget) SID() string                               { return t.sid }
+func (t *pgTarget) Sowner() meta.Sowner                       { return t.sowner }
+func (t *pgTarget) Backend(*meta.Bck) cluster.BackendProvider { return t.backend }
+
+func newPgTarget(tmock *mock.TargetMock, smap *meta.Smap, sid string) *pgTarget {
+	return &pgTarget{TargetMock: tmock, sowner: &pgSowner{smap}, backend: &pgBackend{}, sid: sid}
+}
+
+func pgTestName(i int) string { return fmt.Sprintf("obj-%03d", i) }
+
+func newPgSmap(numTargets int) *meta.Smap {
+	smap := &meta.Smap{Tmap: make(meta.NodeMap), Pmap: make(meta.NodeMap)}
+	for i := 0; i < numTargets; i++ {
+		tsi := &meta.Snode{}
+		tsi.Init(fmt.Sprintf("t%d", i), apc.Target)
+		smap.Tmap.Add(tsi)
+	}
+	return smap
+}
+
+// number of test objects (names) that map to each target in the `key` bucket
+func pgOwned(t *testing.T, smap *meta.Smap, key *meta.Bck) map[string]int64 {
+	owned := make(map[string]int64, len(smap.Tmap))
+	for i := 0; i < pgTestObjs; i++ {
+		owner, err := smap.HrwName2T(key.MakeUname(pgTestName(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		owned[owner.ID()]++
+	}
+	return owned
+}
+
+// the pages that the lister would broadcast - `num` listings, each followed by opcodeDone
+func pgPageCh(num int) chan *LsoRsp {
+	var (
+		b     = &pgBackend{}
+		pages []*cmn.LsoResult
+	)
+	for token := ""; ; {
+		lst := &cmn.LsoResult{}
+		b.ListObjects(nil, &apc.LsoMsg{ContinuationToken: token}, lst)
+		pages = append(pages, lst)
+		if token = lst.ContinuationToken; token == "" {
+			break
+		}
+	}
+	ch := make(chan *LsoRsp, num*(len(pages)+1))
+	for i := 0; i < num; i++ {
+		for _, page := range pages {
+			ch <- &LsoRsp{Lst: page}
+		}
+		ch <- nil
+	}
+	return ch
+}
+
+// single mountpath; buckets; (receive) timeout
+func pgInit(t *testing.T, bcks ...*meta.Bck) {
+	config := cmn.GCO.BeginUpdate()
+	config.Timeout.SendFile = cos.Duration(time.Minute)
+	cmn.GCO.CommitUpdate(config)
+
+	fs.TestNew(nil)
+	fs.Add(t.TempDir(), "daeID")
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	for _, bck := range bcks {
+		if err := fs.CreateBucket(bck.Bucket(), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,470 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Sync destination bucket with the source (see apc.SyncBckMsg), whereby each target:
+// 1. takes care of the source objects that it owns (HRW), visiting them either locally
+//    (ais bucket) or via remote listing (skipping names that map to other targets) - remote
+//    buckets are listed only once, by a single designated target (see remtPager); hence,
+//    started via two-phase transaction (begin: all targets get ready to receive, commit: run);
+// 2. compares each source object with its destination counterpart (see syncAttrs.equal);
+//    the latter is either looked up in this target's share of the remote destination listing
+//    or, if the destination is an ais bucket, HEAD-ed at its (local or remote) location;
+// 3. copies new and modified objects (see t.CopyObject);
+// 4. optionally, deletes extraneous destination objects: those that this target owns
+//    in the destination and that are not present in the source.
+//
+// Source and destination must be different buckets; both can be ais, remote ais, or Cloud.
+
+var syncListProps = strings.Join([]string{apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsVersion},
+	apc.LsPropsSepa)
+
+type (
+	syncBckFactory struct {
+		xreg.RenewBase
+		xctn *XactSyncBck
+	}
+	// object's properties to compare
+	syncAttrs struct {
+		cksumTy string // ais checksum
+		cksum   string
+		src     string // Cloud provider that the ETag and version (below) come from
+		etag    string
+		ver     string // remote version (ais own versions are per-bucket sequence numbers - not comparable)
+		size    int64
+	}
+	syncStats struct {
+		newCnt, newSize atomic.Int64
+		modCnt, modSize atomic.Int64
+		sameCnt         atomic.Int64
+		delCnt, delSize atomic.Int64
+	}
+	XactSyncBck struct {
+		p      *syncBckFactory
+		msg    *apc.SyncBckMsg
+		bckTo  *meta.Bck
+		config *cmn.Config
+		slab   *memsys.Slab
+		dst    map[string]*syncAttrs // remote destination: this target's share (by source HRW)
+		pager  remtPager             // remote source and/or destination
+		stats  syncStats
+		mu     sync.Mutex
+		xact.Base
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactSyncBck)(nil)
+	_ xreg.Renewable = (*syncBckFactory)(nil)
+)
+
+////////////////////
+// syncBckFactory //
+////////////////////
+
+func (*syncBckFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &syncBckFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *syncBckFactory) Start() error {
+	slab, err := p.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	if err != nil {
+		return err
+	}
+	args := p.Args.Custom.(*xreg.SyncArgs)
+	r := newSyncBck(p, args, slab)
+	if p.Bck.IsRemote() || r.bckTo.IsRemote() {
+		if err := r.pager.init(r, p.T, p.Bck, r.config); err != nil {
+			return err
+		}
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*syncBckFactory) Kind() string        { return apc.ActSyncBck }
+func (p *syncBckFactory) Get() cluster.Xact { return p.xctn }
+
+func (*syncBckFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+/////////////////
+// XactSyncBck //
+/////////////////
+
+func newSyncBck(p *syncBckFactory, args *xreg.SyncArgs, slab *memsys.Slab) (r *XactSyncBck) {
+	r = &XactSyncBck{p: p, msg: args.Msg, bckTo: args.BckTo, slab: slab, config: cmn.GCO.Get()}
+	r.InitBase(p.UUID(), p.Kind(), p.Bck)
+	return
+}
+
+func (r *XactSyncBck) Run(*sync.WaitGroup) {
+	var (
+		err     error
+		bckFrom = r.p.Bck
+	)
+	nlog.Infoln(r.Name(), bckFrom.Cname(r.msg.Prefix), "=>", r.bckTo, "delete:", r.msg.Delete, "dry-run:", r.msg.DryRun)
+
+	// NOTE: all targets must execute the same sequence of remote listings (steps 1 through 3)
+	// 1. remote destination: this target's share
+	if r.bckTo.IsRemote() {
+		r.dst = make(map[string]*syncAttrs, 1024)
+		err = r.list(r.bckTo, bckFrom /*HRW key*/, func(en *cmn.LsoEntry) error {
+			r.dst[en.Name] = r.remoteAttrs(r.bckTo, en)
+			return nil
+		})
+	}
+	// 2. compare and copy
+	if err == nil {
+		if bckFrom.IsRemote() {
+			err = r.list(bckFrom, bckFrom, r.syncRemote)
+		} else {
+			err = r.walk(bckFrom, r.syncLocal)
+		}
+	}
+	r.dst = nil
+	// 3. extraneous
+	if err == nil && r.msg.Delete {
+		err = r.delExtra()
+	}
+	r.pager.close(err)
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+// (compare with XactTCB.TxnAbort)
+func (r *XactSyncBck) TxnAbort(err error) {
+	err = cmn.NewErrAborted(r.Name(), "sync: txn-abort", err)
+	r.pager.abort(err)
+	r.AddErr(err)
+	r.Base.Finish()
+}
+
+// list (designated lister) or receive remote pages, and visit the entries whose names
+// map (HRW) to this target in the `key` bucket
+func (r *XactSyncBck) list(bck, key *meta.Bck, visit func(*cmn.LsoEntry) error) error {
+	var (
+		t     = r.p.T
+		smap  = t.Sowner().Get()
+		lsmsg = &apc.LsoMsg{Prefix: r.msg.Prefix, Props: syncListProps}
+	)
+	return r.pager.run(bck, lsmsg, func(entries cmn.LsoEntries) error {
+		for _, en := range entries {
+			si, err := smap.HrwName2T(key.MakeUname(en.Name))
+			if err == nil && si.ID() == t.SID() {
+				err = visit(en)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// walk ais bucket (local mountpaths)
+func (r *XactSyncBck) walk(bck *meta.Bck, visit func(*cluster.LOM, []byte) error) error {
+	opts := &mpather.JgroupOpts{
+		T:        r.p.T,
+		CTs:      []string{fs.ObjectType},
+		Prefix:   r.msg.Prefix,
+		VisitObj: visit,
+		Slab:     r.slab,
+		DoLoad:   mpather.LoadUnsafe,
+	}
+	opts.Bck.Copy(bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts, r.config, "")
+	jg.Run()
+	select {
+	case errCause := <-r.ChanAbort():
+		jg.Stop()
+		return cmn.NewErrAborted(r.Name(), "sync-walk", errCause)
+	case <-jg.ListenFinished():
+		return jg.Stop()
+	}
+}
+
+func (r *XactSyncBck) syncLocal(lom *cluster.LOM, buf []byte) error {
+	return r.syncObj(lom, localAttrs(lom), buf)
+}
+
+func (r *XactSyncBck) syncRemote(en *cmn.LsoEntry) error {
+	lom := cluster.AllocLOM(en.Name)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.p.Bck.Bucket()); err != nil {
+		return err
+	}
+	return r.syncObj(lom, r.remoteAttrs(r.p.Bck, en), nil)
+}
+
+func (r *XactSyncBck) syncObj(lom *cluster.LOM, src *syncAttrs, buf []byte) error {
+	var (
+		dst *syncAttrs
+		err error
+	)
+	if r.dst != nil {
+		r.mu.Lock()
+		dst = r.dst[lom.ObjName]
+		r.mu.Unlock()
+	} else if dst, err = r.lookup(lom.ObjName); err != nil {
+		return err
+	}
+	switch {
+	case dst == nil:
+		r.stats.newCnt.Inc()
+		r.stats.newSize.Add(src.size)
+	case src.equal(dst):
+		r.stats.sameCnt.Inc()
+		return nil
+	default:
+		r.stats.modCnt.Inc()
+		r.stats.modSize.Add(src.size)
+	}
+	if r.msg.DryRun {
+		return nil
+	}
+	return r.copyObj(lom, buf)
+}
+
+// ais destination: HEAD the object at its location
+func (r *XactSyncBck) lookup(objName string) (*syncAttrs, error) {
+	var (
+		t    = r.p.T
+		smap = t.Sowner().Get()
+		lom  = cluster.AllocLOM(objName)
+	)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.bckTo.Bucket()); err != nil {
+		return nil, err
+	}
+	tsi, err := smap.HrwName2T(lom.Uname())
+	if err != nil {
+		return nil, err
+	}
+	if tsi.ID() == t.SID() {
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+			if cmn.IsErrObjNought(err) {
+				err = nil
+			}
+			return nil, err
+		}
+		return localAttrs(lom), nil
+	}
+	oa, errCode, err := t.HeadObjAttrsT2T(lom, tsi)
+	if err != nil {
+		if errCode == http.StatusNotFound || cmn.IsNotExist(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	return localAttrs(oa), nil
+}
+
+func (r *XactSyncBck) copyObj(lom *cluster.LOM, buf []byte) error {
+	params := cluster.AllocCpObjParams()
+	{
+		params.BckTo = r.bckTo
+		params.Buf = buf
+		params.Xact = r
+	}
+	_, err := r.p.T.CopyObject(lom, params, false /*dry-run*/)
+	cluster.FreeCpObjParams(params)
+	switch {
+	case err == nil:
+	case cos.IsErrOOS(err):
+		return cmn.NewErrAborted(r.Name(), "sync", err)
+	case cmn.IsErrObjNought(err):
+		// deleted in the meantime
+	default:
+		r.AddErr(err)
+		if r.config.FastV(5, cos.SmoduleXs) {
+			nlog.Warningln(r.Name(), err)
+		}
+	}
+	return nil
+}
+
+// delete destination objects that this target owns and that are not present in the source
+func (r *XactSyncBck) delExtra() error {
+	var (
+		src     map[string]struct{} // remote source: names that map (HRW) to this target in the destination
+		bckFrom = r.p.Bck
+	)
+	if bckFrom.IsRemote() {
+		src = make(map[string]struct{}, 1024)
+		err := r.list(bckFrom, r.bckTo /*HRW key*/, func(en *cmn.LsoEntry) error {
+			src[en.Name] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	exists := func(objName string) bool {
+		if src != nil {
+			_, ok := src[objName]
+			return ok
+		}
+		return r.existsLocal(objName)
+	}
+	if r.bckTo.IsRemote() {
+		return r.list(r.bckTo, r.bckTo, func(en *cmn.LsoEntry) error {
+			if exists(en.Name) {
+				return nil
+			}
+			lom := cluster.AllocLOM(en.Name)
+			defer cluster.FreeLOM(lom)
+			if err := lom.InitBck(r.bckTo.Bucket()); err != nil {
+				return err
+			}
+			r.delObj(lom, en.Size)
+			return nil
+		})
+	}
+	return r.walk(r.bckTo, func(lom *cluster.LOM, _ []byte) error {
+		if !exists(lom.ObjName) {
+			r.delObj(lom, lom.SizeBytes())
+		}
+		return nil
+	})
+}
+
+// ais source: check the object at its location
+func (r *XactSyncBck) existsLocal(objName string) bool {
+	var (
+		t    = r.p.T
+		smap = t.Sowner().Get()
+		lom  = cluster.AllocLOM(objName)
+	)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.p.Bck.Bucket()); err != nil {
+		return true // (not deleting)
+	}
+	tsi, err := smap.HrwName2T(lom.Uname())
+	if err != nil {
+		return true // ditto
+	}
+	if tsi.ID() == t.SID() {
+		return !cmn.IsErrObjNought(lom.Load(false /*cache it*/, false /*locked*/))
+	}
+	return t.HeadObjT2T(lom, tsi)
+}
+
+func (r *XactSyncBck) delObj(lom *cluster.LOM, size int64) {
+	r.stats.delCnt.Inc()
+	r.stats.delSize.Add(size)
+	if r.msg.DryRun {
+		return
+	}
+	errCode, err := r.p.T.DeleteObject(lom, false /*evict*/)
+	if err == nil || errCode == http.StatusNotFound || cmn.IsErrObjNought(err) {
+		return
+	}
+	r.AddErr(err)
+	if r.config.FastV(5, cos.SmoduleXs) {
+		nlog.Warningln(r.Name(), err)
+	}
+}
+
+func (*XactSyncBck) remoteAttrs(bck *meta.Bck, en *cmn.LsoEntry) *syncAttrs {
+	attrs := &syncAttrs{size: en.Size}
+	if bck.IsRemoteAIS() {
+		attrs.cksumTy, attrs.cksum = bck.Props.Cksum.Type, en.Checksum
+	} else {
+		attrs.src, attrs.etag, attrs.ver = bck.Provider, en.Checksum, en.Version
+	}
+	return attrs
+}
+
+// local object or the one HEAD-ed at its (remote) location: in addition to the ais checksum,
+// the ETag and version that the object (or its source) had in the Cloud - see custom metadata
+func localAttrs(oah cos.OAH) *syncAttrs {
+	attrs := &syncAttrs{size: oah.SizeBytes()}
+	if cksum := oah.Checksum(); !cksum.IsEmpty() {
+		attrs.cksumTy, attrs.cksum = cksum.Ty(), cksum.Value()
+	}
+	if src, ok := oah.GetCustomKey(cmn.SourceObjMD); ok && src != apc.AIS {
+		attrs.src = src
+		attrs.etag, _ = oah.GetCustomKey(cmn.ETag)
+		if attrs.ver, _ = oah.GetCustomKey(cmn.VersionObjMD); attrs.ver == "" {
+			attrs.ver = oah.Version() // (remote version when the object is from a Cloud bucket)
+		}
+	}
+	return attrs
+}
+
+func (r *XactSyncBck) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	snap.SrcBck, snap.DstBck = r.p.Bck.Clone(), r.bckTo.Clone()
+	snap.Ext = &apc.SyncBckStats{
+		NewCnt:  r.stats.newCnt.Load(),
+		NewSize: r.stats.newSize.Load(),
+		ModCnt:  r.stats.modCnt.Load(),
+		ModSize: r.stats.modSize.Load(),
+		SameCnt: r.stats.sameCnt.Load(),
+		DelCnt:  r.stats.delCnt.Load(),
+		DelSize: r.stats.delSize.Load(),
+		DryRun:  r.msg.DryRun,
+	}
+	return
+}
+
+///////////////
+// syncAttrs //
+///////////////
+
+// Source and destination objects (that have the same name) are considered equal if they have
+// the same size and:
+//   - the same checksum, when both have checksums of the same type (e.g., xxhash vs. xxhash);
+//   - otherwise, the same ETag or, if ETags are not available, the same version - iff both come
+//     from the same Cloud provider (via remote listing or as part of the object's custom metadata).
+//
+// When there's nothing to compare (e.g., xxhash vs. S3 ETag), the objects are not considered
+// equal - same size is not enough.
+func (a *syncAttrs) equal(b *syncAttrs) bool {
+	if a.size != b.size {
+		return false
+	}
+	if a.cksum != "" && b.cksum != "" && a.cksumTy == b.cksumTy && a.cksumTy != cos.ChecksumNone {
+		return a.cksum == b.cksum
+	}
+	if a.src == "" || a.src != b.src {
+		return false
+	}
+	if a.etag != "" && b.etag != "" {
+		return a.etag == b.etag
+	}
+	if a.ver != "" && b.ver != "" {
+		return a.ver == b.ver
+	}
+	return false
+}
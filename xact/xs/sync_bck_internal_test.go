@@ -0,0 +1,199 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+func TestSyncAttrsEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  syncAttrs
+		equal bool
+	}{
+		{
+			name:  "different size",
+			a:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x"},
+			b:     syncAttrs{size: 11, cksumTy: cos.ChecksumXXHash, cksum: "x"},
+			equal: false,
+		},
+		{
+			name:  "same checksum",
+			a:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x"},
+			b:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x"},
+			equal: true,
+		},
+		{
+			name:  "different checksum",
+			a:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x"},
+			b:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "y"},
+			equal: false,
+		},
+		{
+			name:  "nothing to compare (modified)",
+			a:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x"},
+			b:     syncAttrs{size: 10, src: apc.AWS, etag: "etag", ver: "v1"},
+			equal: false,
+		},
+		{
+			name:  "same ETag (listed vs. stored in custom metadata)",
+			a:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x", src: apc.AWS, etag: "etag"},
+			b:     syncAttrs{size: 10, src: apc.AWS, etag: "etag", ver: "v1"},
+			equal: true,
+		},
+		{
+			name:  "different ETag",
+			a:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x", src: apc.AWS, etag: "etag1"},
+			b:     syncAttrs{size: 10, src: apc.AWS, etag: "etag2"},
+			equal: false,
+		},
+		{
+			name:  "same ETag, different providers",
+			a:     syncAttrs{size: 10, src: apc.GCP, etag: "etag"},
+			b:     syncAttrs{size: 10, src: apc.AWS, etag: "etag"},
+			equal: false,
+		},
+		{
+			name:  "different version",
+			a:     syncAttrs{size: 10, src: apc.GCP, ver: "1"},
+			b:     syncAttrs{size: 10, src: apc.GCP, ver: "2"},
+			equal: false,
+		},
+		{
+			name:  "same version",
+			a:     syncAttrs{size: 10, cksumTy: cos.ChecksumXXHash, cksum: "x", src: apc.GCP, ver: "1"},
+			b:     syncAttrs{size: 10, src: apc.GCP, ver: "1"},
+			equal: true,
+		},
+		{
+			name:  "ETag takes precedence over version",
+			a:     syncAttrs{size: 10, src: apc.AWS, etag: "etag", ver: "1"},
+			b:     syncAttrs{size: 10, src: apc.AWS, etag: "etag", ver: "2"},
+			equal: true,
+		},
+		{
+			name:  "size only",
+			a:     syncAttrs{size: 10},
+			b:     syncAttrs{size: 10},
+			equal: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if eq := test.a.equal(&test.b); eq != test.equal {
+				t.Errorf("expected equal=%t, got %t", test.equal, eq)
+			}
+			if eq := test.b.equal(&test.a); eq != test.equal {
+				t.Errorf("(symmetric) expected equal=%t, got %t", test.equal, eq)
+			}
+		})
+	}
+}
+
+func TestSyncLocalAttrs(t *testing.T) {
+	// e.g., HEAD-ed at the destination (see lookup)
+	oa := &cmn.ObjAttrs{Size: 10, Ver: "v2", Cksum: cos.NewCksum(cos.ChecksumXXHash, "x")}
+	oa.SetCustomKey(cmn.SourceObjMD, apc.AWS)
+	oa.SetCustomKey(cmn.ETag, "etag")
+	a := localAttrs(oa)
+	if a.src != apc.AWS || a.etag != "etag" || a.ver != "v2" || a.cksum != "x" {
+		t.Fatalf("unexpected %+v", a)
+	}
+	if !a.equal(&syncAttrs{size: 10, src: apc.AWS, etag: "etag"}) {
+		t.Error("expected equal")
+	}
+
+	oa.SetCustomKey(cmn.VersionObjMD, "v1")
+	if a = localAttrs(oa); a.ver != "v1" {
+		t.Errorf("expected remote version from custom metadata, got %q", a.ver)
+	}
+
+	// ais object: own version is not comparable
+	oa = &cmn.ObjAttrs{Size: 10, Ver: "3"}
+	if a = localAttrs(oa); a.ver != "" || a.src != "" {
+		t.Errorf("unexpected %+v", a)
+	}
+}
+
+// remote source and destination are listed only once (each time) - by the designated lister;
+// each target compares only the source objects that it owns
+func TestSyncBckListOnce(t *testing.T) {
+	var (
+		src   = meta.NewBck("sync-src", apc.AWS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		dst   = meta.NewBck("sync-dst", apc.GCP, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		tmock = mock.NewTarget(mock.NewBaseBownerMock(src, dst))
+		smap  = newPgSmap(3)
+		owned = pgOwned(t, smap, src)
+	)
+	pgInit(t, src, dst)
+	slab, err := memsys.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsi, err := smap.HrwName2T(src.MakeUname("sync-xid"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		del      bool
+		listings int // remote listings per target
+	}{
+		{name: "sync", del: false, listings: 2},
+		{name: "sync and delete", del: true, listings: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var total int64
+			for sid := range smap.Tmap {
+				var (
+					args = &xreg.SyncArgs{Msg: &apc.SyncBckMsg{Delete: test.del, DryRun: true}, BckTo: dst}
+					p    = &syncBckFactory{RenewBase: xreg.RenewBase{
+						Args: xreg.Args{T: newPgTarget(tmock, smap, sid), UUID: "sync-xid", Custom: args},
+						Bck:  src,
+					}}
+					r = newSyncBck(p, args, slab)
+				)
+				r.pager = remtPager{xctn: r, t: p.T, config: r.config, lister: sid == tsi.ID()}
+				if !r.pager.lister {
+					r.pager.pageCh = pgPageCh(test.listings)
+				}
+				r.Run(nil)
+				if err := r.Err(); err != nil {
+					t.Fatal(err)
+				}
+				expected := 0
+				if r.pager.lister {
+					expected = test.listings * pgTestPages
+				}
+				if calls := p.T.(*pgTarget).backend.calls; calls != expected {
+					t.Errorf("%s (lister: %t): expected %d list-objects calls, got %d", sid, r.pager.lister, expected, calls)
+				}
+				// (different providers: nothing to compare - all modified)
+				if cnt := r.stats.modCnt.Load(); cnt != owned[sid] {
+					t.Errorf("%s: expected %d (owned) modified objects, got %d", sid, owned[sid], cnt)
+				}
+				if cnt := r.stats.delCnt.Load(); cnt != 0 {
+					t.Errorf("%s: expected no deletions, got %d", sid, cnt)
+				}
+				total += r.stats.modCnt.Load()
+			}
+			if total != pgTestObjs {
+				t.Errorf("expected %d objects in total (each owned by exactly one target), got %d", pgTestObjs, total)
+			}
+		})
+	}
+}
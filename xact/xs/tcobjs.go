@@ -82,9 +82,10 @@ func (p *tcoFactory) Start() (err error) {
 	r.DemandBase.Init(p.UUID(), p.Kind(), p.Bck, xact.IdleDefault)
 
 	var sizePDU int32
-	if p.kind == apc.ActETLObjects {
+	if p.kind == apc.ActETLObjects || p.args.DP != nil {
 		// unlike apc.ActCopyObjects (where we know the size)
 		// apc.ActETLObjects (transform) generates arbitrary sizes where we use PDU-based transport
+		// (ditto when copying shards while filtering out samples)
 		sizePDU = memsys.DefaultBufSize
 	}
 	if err = p.newDM(p.Args.UUID /*trname*/, r.recv, r.config, sizePDU); err != nil {